@@ -0,0 +1,364 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+const (
+	CriticalReadModeSingle = "Single"
+	CriticalReadModeHedged = "Hedged"
+	CriticalReadModeQuorum = "Quorum"
+
+	// criticalReadLatencyWindow is the number of recent calls the hedging delay of a read is computed from.
+	criticalReadLatencyWindow = 100
+	// criticalReadMinLatencySamples is the number of calls that must be recorded before a read is hedged.
+	criticalReadMinLatencySamples = 20
+)
+
+var (
+	PromMultiNodeHedgedReads = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "multi_node_hedged_reads",
+		Help: "The number of critical reads sent to a second node because the first one was slow to answer",
+	}, []string{"network", "chainId", "call"})
+	PromMultiNodeReadDisagreements = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "multi_node_read_disagreements",
+		Help: "The number of quorum reads in which the node returned a different result than the quorum",
+	}, []string{"network", "chainId", "nodeName"})
+)
+
+// CriticalReadConfig configures how CriticalReader sends the reads.
+type CriticalReadConfig interface {
+	// Mode is one of CriticalReadModeSingle, CriticalReadModeHedged or CriticalReadModeQuorum.
+	Mode() string
+	// HedgePercentile is the percentile of the latency of recent calls after which hedged reads are sent to a second node.
+	HedgePercentile() uint8
+	// QuorumNodes is the number of nodes quorum reads are sent to.
+	QuorumNodes() uint32
+	// QuorumAgreement is the number of nodes that must return the same result for a quorum read to succeed.
+	QuorumAgreement() uint32
+}
+
+// CriticalReader sends reads that must not be stalled by a slow node or corrupted by a lying node, like
+// TransactionSender does for transactions. Depending on its mode, a read is sent:
+//   - Single: to the active node only.
+//   - Hedged: to the active node, and also to a second node if the first one did not answer after a percentile of the
+//     latency of the recent calls. The first successful result wins.
+//   - Quorum: to several nodes, and enough of them must return the same result. Nodes returning another result are
+//     reported as disagreeing.
+type CriticalReader[CHAIN_ID types.ID, RPC any] struct {
+	chainID     CHAIN_ID
+	chainFamily string
+	lggr        logger.SugaredLogger
+	multiNode   *MultiNode[CHAIN_ID, RPC]
+	cfg         CriticalReadConfig
+
+	latenciesMu sync.Mutex
+	latencies   map[string]*latencyWindow
+}
+
+// NewCriticalReader returns a CriticalReader of multiNode. A nil cfg sends the reads to the active node only.
+func NewCriticalReader[CHAIN_ID types.ID, RPC any](
+	lggr logger.Logger,
+	chainID CHAIN_ID,
+	chainFamily string,
+	multiNode *MultiNode[CHAIN_ID, RPC],
+	cfg CriticalReadConfig,
+) *CriticalReader[CHAIN_ID, RPC] {
+	return &CriticalReader[CHAIN_ID, RPC]{
+		chainID:     chainID,
+		chainFamily: chainFamily,
+		lggr:        logger.Sugared(lggr).Named("CriticalReader").With("chainID", chainID.String()),
+		multiNode:   multiNode,
+		cfg:         cfg,
+		latencies:   map[string]*latencyWindow{},
+	}
+}
+
+func (r *CriticalReader[CHAIN_ID, RPC]) mode() string {
+	if r.cfg == nil {
+		return CriticalReadModeSingle
+	}
+	return r.cfg.Mode()
+}
+
+// CriticalRead sends the read named call with r, see CriticalReader. key returns the value results are compared by in
+// Quorum mode. Errors are compared by their message, so that nodes agreeing on a failure, e.g. a reverted call, return
+// it.
+func CriticalRead[CHAIN_ID types.ID, RPC any, T any](
+	ctx context.Context,
+	r *CriticalReader[CHAIN_ID, RPC],
+	call string,
+	read func(ctx context.Context, rpc RPC) (T, error),
+	key func(T) string,
+) (T, error) {
+	switch r.mode() {
+	case CriticalReadModeHedged:
+		return hedgedRead(ctx, r, call, read)
+	case CriticalReadModeQuorum:
+		return quorumRead(ctx, r, call, read, key)
+	default:
+		rpc, err := r.multiNode.SelectRPC()
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+		return read(ctx, rpc)
+	}
+}
+
+// CriticalReadAt is CriticalRead for a read at blockNumber. Nodes legitimately disagree about the latest block while a
+// new one propagates, so in Quorum mode a read at the latest block, with a nil blockNumber, is pinned to the lowest latest
+// block of the nodes it is sent to.
+func CriticalReadAt[CHAIN_ID types.ID, RPC any, T any](
+	ctx context.Context,
+	r *CriticalReader[CHAIN_ID, RPC],
+	call string,
+	blockNumber *big.Int,
+	read func(ctx context.Context, rpc RPC, blockNumber *big.Int) (T, error),
+	key func(T) string,
+) (T, error) {
+	if blockNumber != nil || r.mode() != CriticalReadModeQuorum {
+		return CriticalRead(ctx, r, call, func(ctx context.Context, rpc RPC) (T, error) {
+			return read(ctx, rpc, blockNumber)
+		}, key)
+	}
+
+	var zero T
+	nodes, err := r.selectQuorumNodes(call)
+	if err != nil {
+		return zero, err
+	}
+	var pinned int64
+	for _, n := range nodes {
+		if _, chainInfo := n.StateAndLatest(); chainInfo.BlockNumber > 0 && (pinned == 0 || chainInfo.BlockNumber < pinned) {
+			pinned = chainInfo.BlockNumber
+		}
+	}
+	if pinned == 0 {
+		return zero, fmt.Errorf("%s quorum read at the latest block requires the nodes to report their latest block", call)
+	}
+	return quorumReadNodes(ctx, r, call, nodes, func(ctx context.Context, rpc RPC) (T, error) {
+		return read(ctx, rpc, big.NewInt(pinned))
+	}, key)
+}
+
+type readResult[CHAIN_ID types.ID, RPC any, T any] struct {
+	node  Node[CHAIN_ID, RPC]
+	value T
+	err   error
+}
+
+func hedgedRead[CHAIN_ID types.ID, RPC any, T any](
+	ctx context.Context,
+	r *CriticalReader[CHAIN_ID, RPC],
+	call string,
+	read func(ctx context.Context, rpc RPC) (T, error),
+) (T, error) {
+	nodes, err := r.multiNode.selectNodes(2)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	delay, ok := r.hedgeDelay(call)
+	if len(nodes) < 2 || !ok {
+		return timedRead(ctx, r, call, nodes[0], read)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	// Buffered, so that the reads that lost the race do not block once this returns
+	results := make(chan readResult[CHAIN_ID, RPC, T], len(nodes))
+	send := func(n Node[CHAIN_ID, RPC]) {
+		go func() {
+			value, err := timedRead(ctx, r, call, n, read)
+			results <- readResult[CHAIN_ID, RPC, T]{node: n, value: value, err: err}
+		}()
+	}
+
+	send(nodes[0])
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	pending, hedged := 1, false
+	for {
+		select {
+		case <-timer.C:
+			r.lggr.Debugw("Hedging read to a second node", "call", call, "delay", delay, "node", nodes[0].String(), "hedgeNode", nodes[1].String())
+			PromMultiNodeHedgedReads.WithLabelValues(r.chainFamily, r.chainID.String(), call).Inc()
+			send(nodes[1])
+			pending++
+			hedged = true
+		case res := <-results:
+			pending--
+			if !hedged || res.err == nil || pending == 0 {
+				return res.value, res.err
+			}
+		}
+	}
+}
+
+func quorumRead[CHAIN_ID types.ID, RPC any, T any](
+	ctx context.Context,
+	r *CriticalReader[CHAIN_ID, RPC],
+	call string,
+	read func(ctx context.Context, rpc RPC) (T, error),
+	key func(T) string,
+) (T, error) {
+	nodes, err := r.selectQuorumNodes(call)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return quorumReadNodes(ctx, r, call, nodes, read, key)
+}
+
+// selectQuorumNodes returns the nodes a quorum read is sent to.
+func (r *CriticalReader[CHAIN_ID, RPC]) selectQuorumNodes(call string) ([]Node[CHAIN_ID, RPC], error) {
+	agreement := int(r.cfg.QuorumAgreement())
+	nodes, err := r.multiNode.selectNodes(int(r.cfg.QuorumNodes()))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) < agreement {
+		return nil, fmt.Errorf("%s quorum read requires %d alive nodes to agree, but only %d are alive", call, agreement, len(nodes))
+	}
+	return nodes, nil
+}
+
+func quorumReadNodes[CHAIN_ID types.ID, RPC any, T any](
+	ctx context.Context,
+	r *CriticalReader[CHAIN_ID, RPC],
+	call string,
+	nodes []Node[CHAIN_ID, RPC],
+	read func(ctx context.Context, rpc RPC) (T, error),
+	key func(T) string,
+) (T, error) {
+	var zero T
+	agreement := int(r.cfg.QuorumAgreement())
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make(chan readResult[CHAIN_ID, RPC, T], len(nodes))
+	for _, n := range nodes {
+		go func() {
+			value, err := timedRead(ctx, r, call, n, read)
+			results <- readResult[CHAIN_ID, RPC, T]{node: n, value: value, err: err}
+		}()
+	}
+
+	resultKey := func(res readResult[CHAIN_ID, RPC, T]) string {
+		if res.err != nil {
+			return "error: " + res.err.Error()
+		}
+		return "result: " + key(res.value)
+	}
+	var received []readResult[CHAIN_ID, RPC, T]
+	votes := map[string]int{}
+	for range nodes {
+		res := <-results
+		received = append(received, res)
+		k := resultKey(res)
+		votes[k]++
+		if votes[k] >= agreement {
+			reportDisagreements(r, call, k, received, resultKey)
+			return res.value, res.err
+		}
+	}
+
+	reportDisagreements(r, call, "", received, resultKey)
+	errs := make([]error, 0, len(received))
+	for _, res := range received {
+		errs = append(errs, fmt.Errorf("%s: %s", res.node.String(), resultKey(res)))
+	}
+	return zero, fmt.Errorf("%s quorum read did not reach agreement of %d nodes: %w", call, agreement, errors.Join(errs...))
+}
+
+// reportDisagreements reports the nodes whose result differed from the quorum result, or all of them if there is no
+// quorum result, as a sign of their health.
+func reportDisagreements[CHAIN_ID types.ID, RPC any, T any](
+	r *CriticalReader[CHAIN_ID, RPC],
+	call string,
+	quorumKey string,
+	received []readResult[CHAIN_ID, RPC, T],
+	resultKey func(readResult[CHAIN_ID, RPC, T]) string,
+) {
+	for _, res := range received {
+		if k := resultKey(res); k != quorumKey {
+			r.lggr.Warnw("Node disagreed with the quorum read", "call", call, "node", res.node.String(), "result", k, "quorumResult", quorumKey)
+			PromMultiNodeReadDisagreements.WithLabelValues(r.chainFamily, r.chainID.String(), res.node.Name()).Inc()
+		}
+	}
+}
+
+// timedRead sends the read to the node, and records its latency for hedging unless the read was canceled.
+func timedRead[CHAIN_ID types.ID, RPC any, T any](
+	ctx context.Context,
+	r *CriticalReader[CHAIN_ID, RPC],
+	call string,
+	n Node[CHAIN_ID, RPC],
+	read func(ctx context.Context, rpc RPC) (T, error),
+) (T, error) {
+	start := time.Now()
+	value, err := read(ctx, n.RPC())
+	if ctx.Err() == nil {
+		r.recordLatency(call, time.Since(start))
+	}
+	return value, err
+}
+
+func (r *CriticalReader[CHAIN_ID, RPC]) recordLatency(call string, latency time.Duration) {
+	r.latenciesMu.Lock()
+	defer r.latenciesMu.Unlock()
+	w, ok := r.latencies[call]
+	if !ok {
+		w = &latencyWindow{}
+		r.latencies[call] = w
+	}
+	w.record(latency)
+}
+
+// hedgeDelay returns the configured percentile of the latency of the recent calls, or false if too few were recorded.
+func (r *CriticalReader[CHAIN_ID, RPC]) hedgeDelay(call string) (time.Duration, bool) {
+	r.latenciesMu.Lock()
+	defer r.latenciesMu.Unlock()
+	w, ok := r.latencies[call]
+	if !ok || len(w.samples) < criticalReadMinLatencySamples {
+		return 0, false
+	}
+	return w.percentile(r.cfg.HedgePercentile()), true
+}
+
+// latencyWindow keeps the latency of the last criticalReadLatencyWindow calls.
+type latencyWindow struct {
+	samples []time.Duration
+	next    int
+}
+
+func (w *latencyWindow) record(latency time.Duration) {
+	if len(w.samples) < criticalReadLatencyWindow {
+		w.samples = append(w.samples, latency)
+		return
+	}
+	w.samples[w.next] = latency
+	w.next = (w.next + 1) % criticalReadLatencyWindow
+}
+
+// percentile returns the nearest-rank percentile p of the samples.
+func (w *latencyWindow) percentile(p uint8) time.Duration {
+	sorted := slices.Clone(w.samples)
+	slices.Sort(sorted)
+	rank := (len(sorted)*int(p) + 99) / 100
+	return sorted[max(rank, 1)-1]
+}
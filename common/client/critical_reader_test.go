@@ -0,0 +1,185 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+type criticalReadConfig struct {
+	mode            string
+	hedgePercentile uint8
+	quorumNodes     uint32
+	quorumAgreement uint32
+}
+
+func (c criticalReadConfig) Mode() string            { return c.mode }
+func (c criticalReadConfig) HedgePercentile() uint8  { return c.hedgePercentile }
+func (c criticalReadConfig) QuorumNodes() uint32     { return c.quorumNodes }
+func (c criticalReadConfig) QuorumAgreement() uint32 { return c.quorumAgreement }
+
+// readRPC answers reads with result after delay, or blocks until the read is canceled if delay is negative.
+type readRPC struct {
+	result string
+	err    error
+	delay  time.Duration
+	latest int64
+}
+
+func (r *readRPC) read(ctx context.Context) (string, error) {
+	if r.delay < 0 {
+		<-ctx.Done()
+		return "", ctx.Err()
+	}
+	select {
+	case <-time.After(r.delay):
+		return r.result, r.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+func newCriticalReaderForTest(t *testing.T, cfg CriticalReadConfig, rpcs ...*readRPC) *CriticalReader[types.ID, *readRPC] {
+	chainID := types.RandomID()
+	var nodes []Node[types.ID, *readRPC]
+	for i, rpc := range rpcs {
+		node := newMockNode[types.ID, *readRPC](t)
+		node.On("State").Return(nodeStateAlive).Maybe()
+		node.On("Order").Return(int32(i)).Maybe()
+		node.On("RPC").Return(rpc).Maybe()
+		node.On("Name").Return(fmt.Sprintf("node_%d", i)).Maybe()
+		node.On("String").Return(fmt.Sprintf("node_%d", i)).Maybe()
+		node.On("StateAndLatest").Return(nodeStateAlive, ChainInfo{BlockNumber: rpc.latest}).Maybe()
+		nodes = append(nodes, node)
+	}
+	mn := NewMultiNode[types.ID, *readRPC](logger.Test(t), NodeSelectionModePriorityLevel, 0, nodes, nil, chainID, "test", 0)
+	return NewCriticalReader(logger.Test(t), chainID, "test", mn, cfg)
+}
+
+func criticalReadForTest(ctx context.Context, r *CriticalReader[types.ID, *readRPC]) (string, error) {
+	return CriticalRead(ctx, r, "read", func(ctx context.Context, rpc *readRPC) (string, error) {
+		return rpc.read(ctx)
+	}, func(s string) string { return s })
+}
+
+func TestCriticalReader_Single(t *testing.T) {
+	t.Parallel()
+
+	r := newCriticalReaderForTest(t, nil, &readRPC{result: "first"}, &readRPC{result: "second"})
+	result, err := criticalReadForTest(tests.Context(t), r)
+	require.NoError(t, err)
+	assert.Equal(t, "first", result)
+}
+
+func TestCriticalReader_Hedged(t *testing.T) {
+	t.Parallel()
+	ctx := tests.Context(t)
+
+	// the warm up latency sets the hedge delay, well above the latency of an immediate answer
+	first, second := &readRPC{result: "first", delay: 10 * time.Millisecond}, &readRPC{result: "second"}
+	r := newCriticalReaderForTest(t, criticalReadConfig{mode: CriticalReadModeHedged, hedgePercentile: 90}, first, second)
+
+	for i := 0; i < criticalReadMinLatencySamples; i++ {
+		result, err := criticalReadForTest(ctx, r)
+		require.NoError(t, err)
+		assert.Equal(t, "first", result, "reads are not hedged until enough latencies are recorded")
+	}
+
+	t.Run("sends to a second node once the first one is slow", func(t *testing.T) {
+		first.delay = -1
+		result, err := criticalReadForTest(ctx, r)
+		require.NoError(t, err)
+		assert.Equal(t, "second", result)
+	})
+
+	t.Run("returns the error of the first node if it answers before the hedge", func(t *testing.T) {
+		first.delay, first.err = 0, errors.New("reverted")
+		_, err := criticalReadForTest(ctx, r)
+		require.ErrorContains(t, err, "reverted")
+	})
+
+	t.Run("returns an error once both nodes failed", func(t *testing.T) {
+		first.delay, first.err = -1, nil
+		second.err = errors.New("unavailable")
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+		_, err := criticalReadForTest(ctx, r)
+		require.Error(t, err)
+	})
+}
+
+func TestCriticalReader_Quorum(t *testing.T) {
+	t.Parallel()
+	ctx := tests.Context(t)
+	cfg := criticalReadConfig{mode: CriticalReadModeQuorum, quorumNodes: 3, quorumAgreement: 2}
+
+	t.Run("returns the result the quorum agrees on", func(t *testing.T) {
+		r := newCriticalReaderForTest(t, cfg, &readRPC{result: "lie"}, &readRPC{result: "truth", delay: 10 * time.Millisecond}, &readRPC{result: "truth", delay: 20 * time.Millisecond})
+		result, err := criticalReadForTest(ctx, r)
+		require.NoError(t, err)
+		assert.Equal(t, "truth", result)
+		assert.InDelta(t, 1, testutil.ToFloat64(PromMultiNodeReadDisagreements.WithLabelValues("test", r.chainID.String(), "node_0")), 0)
+	})
+
+	t.Run("returns the error the quorum agrees on", func(t *testing.T) {
+		r := newCriticalReaderForTest(t, cfg, &readRPC{err: errors.New("reverted")}, &readRPC{err: errors.New("reverted")}, &readRPC{result: "truth"})
+		_, err := criticalReadForTest(ctx, r)
+		require.EqualError(t, err, "reverted")
+	})
+
+	t.Run("fails without agreement", func(t *testing.T) {
+		r := newCriticalReaderForTest(t, cfg, &readRPC{result: "a"}, &readRPC{result: "b"}, &readRPC{result: "c"})
+		_, err := criticalReadForTest(ctx, r)
+		require.ErrorContains(t, err, "read quorum read did not reach agreement of 2 nodes")
+	})
+
+	t.Run("fails without enough alive nodes", func(t *testing.T) {
+		r := newCriticalReaderForTest(t, cfg, &readRPC{result: "a"})
+		_, err := criticalReadForTest(ctx, r)
+		require.ErrorContains(t, err, "requires 2 alive nodes to agree, but only 1 are alive")
+	})
+
+	t.Run("pins reads of the latest block to the lowest latest block of the nodes", func(t *testing.T) {
+		r := newCriticalReaderForTest(t, cfg, &readRPC{latest: 12}, &readRPC{latest: 10}, &readRPC{latest: 11})
+		readAt := func(ctx context.Context, rpc *readRPC, blockNumber *big.Int) (string, error) {
+			if blockNumber == nil {
+				// each node answers with its own latest block
+				return strconv.FormatInt(rpc.latest, 10), nil
+			}
+			return blockNumber.String(), nil
+		}
+		result, err := CriticalReadAt(ctx, r, "read", nil, readAt, func(s string) string { return s })
+		require.NoError(t, err)
+		assert.Equal(t, "10", result)
+
+		result, err = CriticalReadAt(ctx, r, "read", big.NewInt(5), readAt, func(s string) string { return s })
+		require.NoError(t, err)
+		assert.Equal(t, "5", result)
+	})
+}
+
+func TestLatencyWindow(t *testing.T) {
+	t.Parallel()
+
+	var w latencyWindow
+	for i := 1; i <= criticalReadLatencyWindow+10; i++ {
+		w.record(time.Duration(i) * time.Millisecond)
+	}
+	assert.Len(t, w.samples, criticalReadLatencyWindow)
+	assert.Equal(t, 11*time.Millisecond, w.percentile(1))
+	assert.Equal(t, 100*time.Millisecond, w.percentile(90))
+	assert.Equal(t, 110*time.Millisecond, w.percentile(100))
+}
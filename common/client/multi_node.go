@@ -1,9 +1,11 @@
 package client
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"math/big"
+	"slices"
 	"sync"
 	"time"
 
@@ -64,6 +66,10 @@ func NewMultiNode[
 	deathDeclarationDelay time.Duration,
 ) *MultiNode[CHAIN_ID, RPC] {
 	nodeSelector := newNodeSelector(selectionMode, primaryNodes)
+	if selectionMode == NodeSelectionModeLatencyWeighted && leaseDuration <= 0 {
+		// Scores change with every call, so the best node must be selected again periodically
+		leaseDuration = latencyWeightedLeaseDuration
+	}
 	// Prometheus' default interval is 15s, set this to under 7.5s to avoid
 	// aliasing (see: https://en.wikipedia.org/wiki/Nyquist_frequency)
 	const reportInterval = 6500 * time.Millisecond
@@ -139,6 +145,17 @@ func (c *MultiNode[CHAIN_ID, RPC]) NodeStates() map[string]string {
 	return states
 }
 
+// NodeScores returns the call stats of the primary nodes whose RPC keeps them, see CallStatsReporter.
+func (c *MultiNode[CHAIN_ID, RPC]) NodeScores() map[string]NodeScore {
+	scores := map[string]NodeScore{}
+	for _, n := range c.primaryNodes {
+		if reporter, ok := any(n.RPC()).(CallStatsReporter); ok {
+			scores[n.Name()] = reporter.CallStats()
+		}
+	}
+	return scores
+}
+
 // Start starts every node in the pool
 //
 // Nodes handle their own redialing and runloops, so this function does not
@@ -226,6 +243,24 @@ func (c *MultiNode[CHAIN_ID, RPC]) selectNode() (node Node[CHAIN_ID, RPC], err e
 	return c.activeNode, err
 }
 
+// selectNodes returns the active node, followed by up to n-1 other alive primary nodes in order of priority.
+func (c *MultiNode[CHAIN_ID, RPC]) selectNodes(n int) ([]Node[CHAIN_ID, RPC], error) {
+	active, err := c.selectNode()
+	if err != nil {
+		return nil, err
+	}
+	var others []Node[CHAIN_ID, RPC]
+	for _, node := range c.primaryNodes {
+		if node != active && node.State() == nodeStateAlive {
+			others = append(others, node)
+		}
+	}
+	slices.SortStableFunc(others, func(a, b Node[CHAIN_ID, RPC]) int {
+		return cmp.Compare(a.Order(), b.Order())
+	})
+	return append([]Node[CHAIN_ID, RPC]{active}, others[:min(max(n-1, 0), len(others))]...), nil
+}
+
 // LatestChainInfo - returns number of live nodes available in the pool, so we can prevent the last alive node in a pool from being marked as out-of-sync.
 // Return highest ChainInfo most recently received by the alive nodes.
 // E.g. If Node A's the most recent block is 10 and highest 15 and for Node B it's - 12 and 14. This method will return 12.
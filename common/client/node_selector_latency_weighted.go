@@ -0,0 +1,143 @@
+package client
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+const (
+	// callStatsDecay is the weight of the latest call in the rolling averages of CallStats.
+	callStatsDecay = 0.1
+	// latencyWeightedMinSamples is the number of calls a node must have made before its score is trusted.
+	latencyWeightedMinSamples = 5
+	// latencyWeightedSwitchRatio is how much better the score of another node must be to switch from the
+	// selected one, to avoid flapping between nodes with similar scores.
+	latencyWeightedSwitchRatio = 0.8
+	// latencyWeightedLeaseDuration is how often MultiNode selects the best node again if LeaseDuration is not set.
+	latencyWeightedLeaseDuration = 30 * time.Second
+	// latencyWeightedMaxErrorRate caps the error rate used in the score, so that the score stays finite.
+	latencyWeightedMaxErrorRate = 0.99
+)
+
+// NodeScore is a snapshot of the rolling stats of the calls made to a node.
+type NodeScore struct {
+	// Latency is the exponentially weighted moving average of the latency of the calls.
+	Latency time.Duration
+	// ErrorRate is the exponentially weighted moving average of the failed calls, between 0 and 1.
+	ErrorRate float64
+	// Samples is the number of calls recorded.
+	Samples uint64
+}
+
+// Score returns the expected time to get a successful response from the node. Lower is better.
+func (s NodeScore) Score() float64 {
+	return s.Latency.Seconds() / (1 - min(s.ErrorRate, latencyWeightedMaxErrorRate))
+}
+
+// CallStats keeps a rolling EWMA of the latency and error rate of the calls made to an RPC.
+// The zero value is ready to use, and it is thread-safe.
+type CallStats struct {
+	mu    sync.RWMutex
+	score NodeScore
+}
+
+// Record adds a call that took latency, and failed if the RPC did not answer it.
+func (c *CallStats) Record(latency time.Duration, failed bool) {
+	var errored float64
+	if failed {
+		errored = 1
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.score.Samples == 0 {
+		c.score.Latency = latency
+		c.score.ErrorRate = errored
+	} else {
+		c.score.Latency = time.Duration(callStatsDecay*float64(latency) + (1-callStatsDecay)*float64(c.score.Latency))
+		c.score.ErrorRate = callStatsDecay*errored + (1-callStatsDecay)*c.score.ErrorRate
+	}
+	c.score.Samples++
+}
+
+// Score returns a snapshot of the stats.
+func (c *CallStats) Score() NodeScore {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.score
+}
+
+type latencyWeightedNodeSelector[
+	CHAIN_ID types.ID,
+	RPC any,
+] struct {
+	nodes []Node[CHAIN_ID, RPC]
+
+	mu       sync.Mutex
+	selected Node[CHAIN_ID, RPC]
+}
+
+func NewLatencyWeightedNodeSelector[
+	CHAIN_ID types.ID,
+	RPC any,
+](nodes []Node[CHAIN_ID, RPC]) NodeSelector[CHAIN_ID, RPC] {
+	return &latencyWeightedNodeSelector[CHAIN_ID, RPC]{nodes: nodes}
+}
+
+// Select returns the alive node with the best score. It keeps the node it selected last while it is alive, unless
+// another node scores clearly better. Nodes without enough calls recorded are only selected if no alive node has a
+// score, in which case it falls back to the node with the highest priority.
+func (s *latencyWeightedNodeSelector[CHAIN_ID, RPC]) Select() Node[CHAIN_ID, RPC] {
+	var alive []Node[CHAIN_ID, RPC]
+	var best Node[CHAIN_ID, RPC]
+	bestScore := math.Inf(1)
+	selectedScore := math.Inf(1)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, n := range s.nodes {
+		if n.State() != nodeStateAlive {
+			continue
+		}
+		alive = append(alive, n)
+		score, ok := nodeScore(n)
+		if !ok {
+			continue
+		}
+		if n == s.selected {
+			selectedScore = score.Score()
+		}
+		if score.Score() < bestScore || (score.Score() == bestScore && n.Order() < best.Order()) {
+			best, bestScore = n, score.Score()
+		}
+	}
+
+	switch {
+	case best == nil:
+		best = firstOrHighestPriority(alive)
+	case !math.IsInf(selectedScore, 1) && bestScore >= selectedScore*latencyWeightedSwitchRatio:
+		best = s.selected
+	}
+	s.selected = best
+	return best
+}
+
+func (s *latencyWeightedNodeSelector[CHAIN_ID, RPC]) Name() string {
+	return NodeSelectionModeLatencyWeighted
+}
+
+// nodeScore returns the score of the node, if its RPC keeps call stats and recorded enough calls.
+func nodeScore[
+	CHAIN_ID types.ID,
+	RPC any,
+](n Node[CHAIN_ID, RPC]) (NodeScore, bool) {
+	reporter, ok := any(n.RPC()).(CallStatsReporter)
+	if !ok {
+		return NodeScore{}, false
+	}
+	score := reporter.CallStats()
+	return score, score.Samples >= latencyWeightedMinSamples
+}
@@ -0,0 +1,128 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+type callStatsRPC struct {
+	stats CallStats
+}
+
+func (r *callStatsRPC) CallStats() NodeScore {
+	return r.stats.Score()
+}
+
+func (r *callStatsRPC) record(n int, latency time.Duration, failed bool) {
+	for i := 0; i < n; i++ {
+		r.stats.Record(latency, failed)
+	}
+}
+
+func TestLatencyWeightedNodeSelectorName(t *testing.T) {
+	selector := newNodeSelector[types.ID, *callStatsRPC](NodeSelectionModeLatencyWeighted, nil)
+	assert.Equal(t, selector.Name(), NodeSelectionModeLatencyWeighted)
+}
+
+func TestCallStats(t *testing.T) {
+	t.Parallel()
+
+	var stats CallStats
+	assert.Equal(t, NodeScore{}, stats.Score())
+
+	stats.Record(100*time.Millisecond, false)
+	assert.Equal(t, NodeScore{Latency: 100 * time.Millisecond, Samples: 1}, stats.Score())
+
+	stats.Record(200*time.Millisecond, true)
+	score := stats.Score()
+	assert.Equal(t, 110*time.Millisecond, score.Latency)
+	assert.InDelta(t, 0.1, score.ErrorRate, 1e-9)
+	assert.Equal(t, uint64(2), score.Samples)
+	assert.InDelta(t, 0.11/0.9, score.Score(), 1e-9)
+
+	t.Run("errors only", func(t *testing.T) {
+		var stats CallStats
+		stats.Record(time.Second, true)
+		assert.InDelta(t, 100, stats.Score().Score(), 1e-9, "the score stays finite")
+	})
+}
+
+func TestLatencyWeightedNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	newNode := func(t *testing.T, state nodeState, order int32, rpc *callStatsRPC) *mockNode[types.ID, *callStatsRPC] {
+		node := newMockNode[types.ID, *callStatsRPC](t)
+		node.On("State").Return(state).Maybe()
+		node.On("Order").Return(order).Maybe()
+		node.On("RPC").Return(rpc).Maybe()
+		return node
+	}
+
+	t.Run("selects the alive node with the best score", func(t *testing.T) {
+		slow, fast, unreachable := &callStatsRPC{}, &callStatsRPC{}, &callStatsRPC{}
+		slow.record(10, 300*time.Millisecond, false)
+		fast.record(10, 50*time.Millisecond, false)
+		unreachable.record(10, 10*time.Millisecond, false)
+		nodes := []Node[types.ID, *callStatsRPC]{
+			newNode(t, nodeStateAlive, 1, slow),
+			newNode(t, nodeStateAlive, 2, fast),
+			newNode(t, nodeStateUnreachable, 1, unreachable),
+		}
+		selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+
+	t.Run("accounts for errors", func(t *testing.T) {
+		fastFailing, slow := &callStatsRPC{}, &callStatsRPC{}
+		fastFailing.record(10, 50*time.Millisecond, true)
+		slow.record(10, 200*time.Millisecond, false)
+		nodes := []Node[types.ID, *callStatsRPC]{
+			newNode(t, nodeStateAlive, 1, fastFailing),
+			newNode(t, nodeStateAlive, 1, slow),
+		}
+		selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+
+	t.Run("falls back to priority without scores", func(t *testing.T) {
+		a, b := &callStatsRPC{}, &callStatsRPC{}
+		b.record(latencyWeightedMinSamples-1, time.Millisecond, false)
+		nodes := []Node[types.ID, *callStatsRPC]{
+			newNode(t, nodeStateAlive, 2, a),
+			newNode(t, nodeStateAlive, 1, b),
+			newNode(t, nodeStateOutOfSync, 0, &callStatsRPC{}),
+		}
+		selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+
+	t.Run("keeps the selected node unless another one is clearly better", func(t *testing.T) {
+		a, b := &callStatsRPC{}, &callStatsRPC{}
+		a.record(10, 100*time.Millisecond, false)
+		b.record(10, 110*time.Millisecond, false)
+		nodes := []Node[types.ID, *callStatsRPC]{
+			newNode(t, nodeStateAlive, 1, a),
+			newNode(t, nodeStateAlive, 1, b),
+		}
+		selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes)
+		assert.Same(t, nodes[0], selector.Select())
+
+		a.record(3, 120*time.Millisecond, false)
+		assert.Same(t, nodes[0], selector.Select(), "similar scores do not switch")
+
+		a.record(20, 500*time.Millisecond, false)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+
+	t.Run("none alive", func(t *testing.T) {
+		nodes := []Node[types.ID, *callStatsRPC]{
+			newNode(t, nodeStateUnreachable, 1, &callStatsRPC{}),
+		}
+		selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes)
+		assert.Nil(t, selector.Select())
+	})
+}
@@ -41,6 +41,13 @@ type RPCClient[
 	GetInterceptedChainInfo() (latest, highestUserObservations ChainInfo)
 }
 
+// CallStatsReporter is optionally implemented by RPCs that keep rolling stats of the calls made to them, which are
+// used by the LatencyWeighted NodeSelector.
+type CallStatsReporter interface {
+	// CallStats - returns a snapshot of the rolling latency and error rate of the calls made to the RPC.
+	CallStats() NodeScore
+}
+
 // Head is the interface required by the NodeClient
 type Head interface {
 	BlockNumber() int64
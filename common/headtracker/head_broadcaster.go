@@ -30,7 +30,7 @@ func (set callbackSet[H, BLOCK_HASH]) values() []HeadTrackable[H, BLOCK_HASH] {
 // Chain implementations should notify head events to the core txm via this interface.
 type HeadTrackable[H types.Head[BLOCK_HASH], BLOCK_HASH types.Hashable] interface {
 	// OnNewLongestChain sends a new head when it becomes available. Subscribers can recursively trace the parent
-	// of the head to the finalized block back.
+	// of the head to the safe and finalized blocks back.
 	OnNewLongestChain(ctx context.Context, head H)
 }
 
@@ -2,6 +2,7 @@ package headtracker
 
 import (
 	"context"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/v2/common/types"
 )
@@ -20,4 +21,20 @@ type HeadSaver[H types.Head[BLOCK_HASH], BLOCK_HASH types.Hashable] interface {
 	Chain(hash BLOCK_HASH) H
 	// MarkFinalized - marks matching block and all it's direct ancestors as finalized
 	MarkFinalized(ctx context.Context, latestFinalized H) error
+	// MarkSafe - marks matching block and all it's direct ancestors as safe
+	MarkSafe(ctx context.Context, latestSafe H) error
+	// SaveReorg persists a reorg of the canonical chain detected by the HeadTracker.
+	SaveReorg(ctx context.Context, reorg Reorg[BLOCK_HASH]) error
+}
+
+// Reorg is a change of the canonical chain detected by the HeadTracker: the blocks FromBlock to ToBlock of the chain of
+// OldHeadHash were replaced by the chain of NewHeadHash.
+type Reorg[BLOCK_HASH types.Hashable] struct {
+	// Depth is the number of blocks replaced.
+	Depth       int64
+	OldHeadHash BLOCK_HASH
+	NewHeadHash BLOCK_HASH
+	FromBlock   int64
+	ToBlock     int64
+	DetectedAt  time.Time
 }
@@ -28,6 +28,12 @@ var (
 		Name: "head_tracker_very_old_head",
 		Help: "Counter is incremented every time we get a head that is much lower than the highest seen head ('much lower' is defined as a block that is EVM.FinalityDepth or greater below the highest seen head)",
 	}, []string{"evmChainID"})
+
+	promReorgDepth = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "head_tracker_reorg_depth",
+		Help:    "The number of blocks replaced by each reorg of the canonical chain",
+		Buckets: []float64{1, 2, 3, 5, 10, 20, 50, 100, 200, 500},
+	}, []string{"evmChainID"})
 )
 
 // HeadsBufferSize - The buffer is used when heads sampling is disabled, to ensure the callback is run for every head
@@ -42,6 +48,9 @@ type HeadTracker[H types.Head[BLOCK_HASH], BLOCK_HASH types.Hashable] interface
 	// LatestAndFinalizedBlock - returns latest and latest finalized blocks.
 	// NOTE: Returns latest finalized block as is, ignoring the FinalityTagBypass feature flag.
 	LatestAndFinalizedBlock(ctx context.Context) (latest, finalized H, err error)
+	// LatestSafeBlock - returns latest safe block marked by the HeadTracker, which is the latest finalized block on
+	// chains without a safe tag. Falls back to requesting it from the RPC until a safe block is marked.
+	LatestSafeBlock(ctx context.Context) (safe H, err error)
 }
 
 type headTracker[
@@ -66,6 +75,9 @@ type headTracker[
 	broadcastMB  *mailbox.Mailbox[HTH]
 	headListener HeadListener[HTH, BLOCK_HASH]
 	getNilHead   func() HTH
+
+	// canonicalHead is the last head backfilled, only accessed by the backfill loop.
+	canonicalHead HTH
 }
 
 // NewHeadTracker instantiates a new HeadTracker using HeadSaver to persist new block numbers.
@@ -202,7 +214,30 @@ func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) Backfill(ctx context.Context, hea
 			latestFinalized.BlockNumber(), headWithChain.BlockNumber(), ht.htConfig.MaxAllowedFinalityDepth())
 	}
 
-	return ht.backfill(ctx, headWithChain, latestFinalized)
+	if err = ht.backfill(ctx, headWithChain, latestFinalized); err != nil {
+		return err
+	}
+
+	ht.markSafe(ctx, headWithChain, latestFinalized)
+	return nil
+}
+
+// markSafe marks the latest safe block of the chain of headWithChain, and all its ancestors, as safe.
+func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) markSafe(ctx context.Context, headWithChain, latestFinalized HTH) {
+	latestSafe, err := ht.calculateLatestSafe(ctx, latestFinalized, ht.htConfig.FinalityTagBypass())
+	if err != nil {
+		ht.log.Debugw("failed to calculate latest safe block", "err", err)
+		return
+	}
+
+	if latestSafe.BlockNumber() > headWithChain.BlockNumber() {
+		// the safe block is not part of the backfilled chain yet, it will be marked with a later head
+		return
+	}
+
+	if err = ht.headSaver.MarkSafe(ctx, latestSafe); err != nil {
+		ht.log.Debugw("failed to mark block as safe", "err", err)
+	}
 }
 
 func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) LatestChain() HTH {
@@ -309,6 +344,8 @@ func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) backfillLoop(ctx context.Context)
 						ht.log.Warnw("Unexpected error while backfilling heads", "err", err)
 					} else if ctx.Err() != nil {
 						break
+					} else {
+						ht.detectReorg(ctx, head)
 					}
 				}
 			}
@@ -316,6 +353,60 @@ func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) backfillLoop(ctx context.Context)
 	}
 }
 
+// detectReorg compares the backfilled chain of head with the previous canonical chain, and saves a reorg if head does
+// not descend from the previous canonical head.
+func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) detectReorg(ctx context.Context, head HTH) {
+	prevHead := ht.canonicalHead
+	// reload the chains, as backfill may have linked new ancestors to them
+	newChain := ht.headSaver.Chain(head.BlockHash())
+	if !newChain.IsValid() {
+		return
+	}
+	ht.canonicalHead = newChain
+	if !prevHead.IsValid() {
+		return
+	}
+	oldChain := ht.headSaver.Chain(prevHead.BlockHash())
+	if !oldChain.IsValid() {
+		return
+	}
+
+	lca := min(oldChain.BlockNumber(), newChain.BlockNumber())
+	for ; ; lca-- {
+		oldHead, err := oldChain.HeadAtHeight(lca)
+		if err != nil {
+			ht.log.Debugw("Failed to find common ancestor of the previous and new canonical chains", "prevHead", prevHead.BlockHash(), "head", head.BlockHash(), "err", err)
+			return
+		}
+		newHead, err := newChain.HeadAtHeight(lca)
+		if err != nil {
+			ht.log.Debugw("Failed to find common ancestor of the previous and new canonical chains", "prevHead", prevHead.BlockHash(), "head", head.BlockHash(), "err", err)
+			return
+		}
+		if oldHead.BlockHash() == newHead.BlockHash() {
+			break
+		}
+	}
+	if lca == oldChain.BlockNumber() {
+		return
+	}
+
+	reorg := Reorg[BLOCK_HASH]{
+		Depth:       oldChain.BlockNumber() - lca,
+		OldHeadHash: oldChain.BlockHash(),
+		NewHeadHash: newChain.BlockHash(),
+		FromBlock:   lca + 1,
+		ToBlock:     oldChain.BlockNumber(),
+		DetectedAt:  time.Now(),
+	}
+	promReorgDepth.WithLabelValues(ht.chainID.String()).Observe(float64(reorg.Depth))
+	ht.log.Infow("Detected reorg of the canonical chain", "depth", reorg.Depth, "oldHead", reorg.OldHeadHash,
+		"newHead", reorg.NewHeadHash, "fromBlock", reorg.FromBlock, "toBlock", reorg.ToBlock)
+	if err := ht.headSaver.SaveReorg(ctx, reorg); err != nil {
+		ht.log.Errorw("Failed to save reorg", "err", err)
+	}
+}
+
 // LatestAndFinalizedBlock - returns latest and latest finalized blocks.
 // NOTE: Returns latest finalized block as is, ignoring the FinalityTagBypass feature flag.
 // TODO: BCI-3321 use cached values instead of making RPC requests
@@ -344,6 +435,28 @@ func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) LatestAndFinalizedBlock(ctx conte
 	return
 }
 
+// LatestSafeBlock - returns latest safe block marked on the latest chain by the HeadTracker, which is the latest
+// finalized block on chains without a safe tag. Until a safe block is marked, like before the first head is tracked,
+// it is requested from the RPC instead.
+func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) LatestSafeBlock(ctx context.Context) (safe HTH, err error) {
+	if latestChain := ht.headSaver.LatestChain(); latestChain.IsValid() {
+		if latestSafe, ok := latestChain.LatestSafeHead().(HTH); ok && latestSafe.IsValid() {
+			return latestSafe, nil
+		}
+	}
+
+	_, finalized, err := ht.LatestAndFinalizedBlock(ctx)
+	if err != nil {
+		return
+	}
+
+	safe, err = ht.calculateLatestSafe(ctx, finalized, false)
+	if err != nil {
+		err = fmt.Errorf("failed to calculate latest safe block: %w", err)
+	}
+	return
+}
+
 func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) getHeadAtHeight(ctx context.Context, chainHeadHash BLOCK_HASH, blockHeight int64) (HTH, error) {
 	chainHead := ht.headSaver.Chain(chainHeadHash)
 	if chainHead.IsValid() {
@@ -394,6 +507,37 @@ func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) calculateLatestFinalized(ctx cont
 	return ht.getHeadAtHeight(ctx, currentHead.BlockHash(), finalizedBlockNumber)
 }
 
+// calculateLatestSafe - returns latest safe block. Without the finality tag the chain has no safe tag, and the latest
+// finalized block is returned. A safe block lower than latestFinalized is also replaced by latestFinalized, as a
+// finalized block is safe.
+func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) calculateLatestSafe(ctx context.Context, latestFinalized HTH, finalityTagBypass bool) (HTH, error) {
+	if !ht.config.FinalityTagEnabled() || finalityTagBypass {
+		return latestFinalized, nil
+	}
+
+	latestSafe, err := ht.client.LatestSafeBlock(ctx)
+	if err != nil {
+		return latestSafe, fmt.Errorf("failed to get latest safe block: %w", err)
+	}
+
+	if !latestSafe.IsValid() {
+		return latestSafe, fmt.Errorf("failed to get valid latest safe block")
+	}
+
+	if ht.config.FinalizedBlockOffset() > 0 {
+		safeBlockNumber := max(latestSafe.BlockNumber()-int64(ht.config.FinalizedBlockOffset()), 0)
+		latestSafe, err = ht.getHeadAtHeight(ctx, latestSafe.BlockHash(), safeBlockNumber)
+		if err != nil {
+			return latestSafe, err
+		}
+	}
+
+	if latestSafe.BlockNumber() < latestFinalized.BlockNumber() {
+		return latestFinalized, nil
+	}
+	return latestSafe, nil
+}
+
 // backfill fetches all missing heads up until the latestFinalizedHead
 func (ht *headTracker[HTH, S, ID, BLOCK_HASH]) backfill(ctx context.Context, head, latestFinalizedHead HTH) (err error) {
 	headBlockNumber := head.BlockNumber()
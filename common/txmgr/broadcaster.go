@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/jpillora/backoff"
@@ -58,10 +59,18 @@ var (
 			float64(2 * time.Minute),
 		},
 	}, []string{"chainID"})
+	promNumSimulationFailedTxs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_num_simulation_failed_transactions",
+		Help: "Number of transactions that were not broadcast because their simulation reverted",
+	}, []string{"chainID"})
 )
 
 var ErrTxRemoved = errors.New("tx removed")
 
+// ErrTxSimulationFailed is wrapped by the errors of the TransmitCheckers simulating transactions, when the simulation
+// reverts. Such transactions are marked as simulation_failed instead of fatally errored.
+var ErrTxSimulationFailed = errors.New("transaction reverted during simulation")
+
 type ProcessUnstartedTxs[ADDR types.Hashable] func(ctx context.Context, fromAddress ADDR) (retryable bool, err error)
 
 // TransmitCheckerFactory creates a transmit checker based on a spec.
@@ -121,6 +130,7 @@ type Broadcaster[
 	txmgrtypes.TxAttemptBuilder[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	sequenceTracker txmgrtypes.SequenceTracker[ADDR, SEQ]
 	resumeCallback  ResumeCallback
+	latestBlockNum  atomic.Int64
 	chainID         CHAIN_ID
 	chainType       string
 	config          txmgrtypes.BroadcasterChainConfig
@@ -192,6 +202,7 @@ func NewBroadcaster[
 		sequenceTracker:  sequenceTracker,
 	}
 
+	b.latestBlockNum.Store(-1)
 	b.processUnstartedTxsImpl = b.processUnstartedTxs
 	return b
 }
@@ -260,6 +271,12 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SetR
 	eb.resumeCallback = callback
 }
 
+// SetLatestBlockNum should be called on every new highest block number, so that transactions past their deadline block
+// are cancelled instead of being sent.
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) SetLatestBlockNum(latestBlockNum int64) {
+	eb.latestBlockNum.Store(latestBlockNum)
+}
+
 func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Name() string {
 	return eb.lggr.Name()
 }
@@ -465,6 +482,10 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 	err = checker.Check(checkCtx, lgr, *etx, attempt)
 	if errors.Is(err, context.Canceled) {
 		lgr.Warn("Transmission checker timed out, sending anyway")
+	} else if errors.Is(err, ErrTxSimulationFailed) {
+		etx.Error = null.StringFrom(err.Error())
+		lgr.Warnw("Transaction simulation reverted, not sending transaction.", "err", err)
+		return eb.saveSimulationFailedTransaction(lgr, etx), true
 	} else if err != nil {
 		etx.Error = null.StringFrom(err.Error())
 		lgr.Warnw("Transmission checker failed, fatally erroring transaction.", "err", err)
@@ -671,17 +692,28 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) vali
 }
 
 // Finds next transaction in the queue, assigns a sequence, and moves it to "in_progress" state ready for broadcast.
+// Transactions already past their deadline are cancelled on the way, without being assigned a sequence.
 // Returns nil if no transactions are in queue
 func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) nextUnstartedTransactionWithSequence(fromAddress ADDR) (*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
 	ctx, cancel := eb.chStop.NewCtx()
 	defer cancel()
-	etx, err := eb.txStore.FindNextUnstartedTransactionFromAddress(ctx, fromAddress, eb.chainID)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			// Finish. No more transactions left to process. Hoorah!
-			return nil, nil
+	var etx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
+	for {
+		var err error
+		etx, err = eb.txStore.FindNextUnstartedTransactionFromAddress(ctx, fromAddress, eb.chainID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				// Finish. No more transactions left to process. Hoorah!
+				return nil, nil
+			}
+			return nil, fmt.Errorf("findNextUnstartedTransactionFromAddress failed: %w", err)
+		}
+		if !etx.Deadline.Passed(time.Now(), eb.latestBlockNum.Load()) {
+			break
+		}
+		if err = eb.saveCancelledTransaction(ctx, etx); err != nil {
+			return nil, err
 		}
-		return nil, fmt.Errorf("findNextUnstartedTransactionFromAddress failed: %w", err)
 	}
 
 	sequence, err := eb.sequenceTracker.GetNextSequence(ctx, etx.FromAddress)
@@ -756,22 +788,69 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) save
 	// Now we have an errored pipeline even though the tx succeeded. This case
 	// is relatively benign and probably nobody will ever run into it in
 	// practice, but something to be aware of.
-	if etx.PipelineTaskRunID.Valid && eb.resumeCallback != nil && etx.SignalCallback && !etx.CallbackCompleted {
-		err := eb.resumeCallback(ctx, etx.PipelineTaskRunID.UUID, nil, fmt.Errorf("fatal error while sending transaction: %s", etx.Error.String))
-		if errors.Is(err, sql.ErrNoRows) {
-			lgr.Debugw("callback missing or already resumed", "etxID", etx.ID)
-		} else if err != nil {
-			return fmt.Errorf("failed to resume pipeline: %w", err)
-		} else {
-			// Mark tx as having completed callback
-			if err := eb.txStore.UpdateTxCallbackCompleted(ctx, etx.PipelineTaskRunID.UUID, eb.chainID); err != nil {
-				return err
-			}
-		}
+	if err := eb.resumeFailedTaskRun(ctx, lgr, etx, fmt.Errorf("fatal error while sending transaction: %s", etx.Error.String)); err != nil {
+		return err
 	}
 	return eb.txStore.UpdateTxFatalErrorAndDeleteAttempts(ctx, etx)
 }
 
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) saveSimulationFailedTransaction(lgr logger.Logger, etx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error {
+	ctx, cancel := eb.chStop.NewCtx()
+	defer cancel()
+	if etx.State != TxUnstarted {
+		return fmt.Errorf("can only transition to simulation_failed from unstarted, transaction is currently %s", etx.State)
+	}
+	if !etx.Error.Valid {
+		return errors.New("expected error field to be set")
+	}
+	promNumSimulationFailedTxs.WithLabelValues(eb.chainID.String()).Inc()
+	// Same as for fatally errored transactions, this is not done transactionally
+	if err := eb.resumeFailedTaskRun(ctx, lgr, etx, errors.New(etx.Error.String)); err != nil {
+		return err
+	}
+	if err := eb.txStore.UpdateTxSimulationFailed(ctx, etx); errors.Is(err, ErrTxRemoved) {
+		lgr.Debugw("tx removed", "txID", etx.ID, "subject", etx.Subject)
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// saveCancelledTransaction cancels an unstarted transaction past its deadline, and resumes its pending task run with
+// ErrTxCancelled.
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) saveCancelledTransaction(ctx context.Context, etx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error {
+	lgr := etx.GetLogger(eb.lggr)
+	lgr.Warnw("Transaction past its deadline, cancelling it before it is sent.", "deadline", etx.Deadline, "blockNum", eb.latestBlockNum.Load())
+	// Same as for fatally errored transactions, this is not done transactionally
+	if err := eb.resumeFailedTaskRun(ctx, lgr, etx, ErrTxCancelled); err != nil {
+		return err
+	}
+	if err := eb.txStore.UpdateTxUnstartedToCancelled(ctx, etx); errors.Is(err, ErrTxRemoved) {
+		lgr.Debugw("tx removed", "txID", etx.ID, "subject", etx.Subject)
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to cancel transaction past its deadline: %w", err)
+	}
+	return nil
+}
+
+// resumeFailedTaskRun resumes the pending task run of etx, if any, with taskErr.
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) resumeFailedTaskRun(ctx context.Context, lgr logger.Logger, etx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], taskErr error) error {
+	if !etx.PipelineTaskRunID.Valid || eb.resumeCallback == nil || !etx.SignalCallback || etx.CallbackCompleted {
+		return nil
+	}
+	err := eb.resumeCallback(ctx, etx.PipelineTaskRunID.UUID, nil, taskErr)
+	if errors.Is(err, sql.ErrNoRows) {
+		lgr.Debugw("callback missing or already resumed", "etxID", etx.ID)
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to resume pipeline: %w", err)
+	}
+	// Mark tx as having completed callback
+	return eb.txStore.UpdateTxCallbackCompleted(ctx, etx.PipelineTaskRunID.UUID, eb.chainID)
+}
+
 func observeTimeUntilBroadcast[CHAIN_ID types.ID](chainID CHAIN_ID, createdAt, broadcastAt time.Time) {
 	duration := float64(broadcastAt.Sub(createdAt))
 	promTimeUntilBroadcast.WithLabelValues(chainID.String()).Observe(duration)
@@ -45,6 +45,10 @@ var (
 		Name: "tx_manager_gas_bump_exceeds_limit",
 		Help: "Number of times gas bumping failed from exceeding the configured limit. Any counts of this type indicate a serious problem.",
 	}, []string{"chainID"})
+	promNumPrivateTxFallbacks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_num_private_tx_fallbacks",
+		Help: "Total number of privately submitted transactions broadcast publicly after not being included within the fallback blocks.",
+	}, []string{"chainID"})
 	promNumConfirmedTxs = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "tx_manager_num_confirmed_transactions",
 		Help: "Total number of confirmed transactions. Note that this can err to be too high since transactions are counted on each confirmation, which can happen multiple times per transaction in the case of re-orgs",
@@ -78,6 +82,9 @@ var (
 	}, []string{"chainID"})
 )
 
+// ErrTxCancelled is the error the task runs of the transactions cancelled after their deadline are resumed with.
+var ErrTxCancelled = errors.New("transaction cancelled after its deadline")
+
 // Confirmer is a broad service which performs four different tasks in sequence on every new longest chain
 // Step 1: Mark that all currently pending transaction attempts were broadcast before this block
 // Step 2: Check pending transactions for confirmation and confirmed transactions for re-org
@@ -277,6 +284,18 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) pro
 	}
 	ec.lggr.Debugw("Finished ProcessStuckTransactions", "headNum", head.BlockNumber(), "time", time.Since(mark), "id", "confirmer")
 
+	mark = time.Now()
+	if err := ec.ProcessPastDeadlineTxs(ctx, head.BlockNumber()); err != nil {
+		return err
+	}
+	ec.lggr.Debugw("Finished ProcessPastDeadlineTxs", "headNum", head.BlockNumber(), "time", time.Since(mark), "id", "confirmer")
+
+	mark = time.Now()
+	if err := ec.ProcessPrivateTxsPastFallback(ctx, head.BlockNumber()); err != nil {
+		return err
+	}
+	ec.lggr.Debugw("Finished ProcessPrivateTxsPastFallback", "headNum", head.BlockNumber(), "time", time.Since(mark), "id", "confirmer")
+
 	mark = time.Now()
 	if err := ec.RebroadcastWhereNecessary(ctx, head.BlockNumber()); err != nil {
 		return err
@@ -381,7 +400,9 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Pro
 	confirmedTxIDs := make([]int64, 0, len(includedTxs))
 	for _, tx := range includedTxs {
 		// If any attempt in the transaction is marked for purge, the transaction was terminally stuck and should be marked as fatal error
-		if tx.HasPurgeAttempt() {
+		// Transactions with a cancel attempt are confirmed instead, since either attempt may have been included. They are
+		// marked as cancelled once their receipt shows the cancel attempt was
+		if tx.HasPurgeAttempt() && !tx.HasCancelAttempt() {
 			// Setting the purged block num here is ok since we have confirmation the tx has been included
 			ec.stuckTxDetector.SetPurgeBlockNum(tx.FromAddress, head.BlockNumber())
 			purgeTxIDs = append(purgeTxIDs, tx.ID)
@@ -447,7 +468,7 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Pro
 				return
 			}
 			// Resume pending task runs with failure for stuck transactions
-			if err := ec.resumeFailedTaskRuns(ctx, tx); err != nil {
+			if err := ec.resumeFailedTaskRuns(ctx, tx, errors.New(ec.stuckTxDetector.StuckTxFatalError())); err != nil {
 				errMu.Lock()
 				errorList = append(errorList, fmt.Errorf("failed to resume pending task run for transaction: %w", err))
 				errMu.Unlock()
@@ -459,11 +480,90 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Pro
 	return errors.Join(errorList...)
 }
 
-func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) resumeFailedTaskRuns(ctx context.Context, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error {
+// ProcessPastDeadlineTxs finds the unconfirmed transactions past their deadline for each enabled address, and replaces
+// them with an empty attempt sent to the from address with bumped gas to cancel them
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) ProcessPastDeadlineTxs(ctx context.Context, blockNum int64) error {
+	var errorList []error
+	now := time.Now()
+	for _, address := range ec.enabledAddresses {
+		etxs, err := ec.txStore.FindTxsPastDeadline(ctx, address, now, blockNum, ec.chainID)
+		if err != nil {
+			errorList = append(errorList, fmt.Errorf("failed to find transactions past their deadline for address %s: %w", address.String(), err))
+			continue
+		}
+		for _, etx := range etxs {
+			if err := ec.cancelTx(ctx, *etx, blockNum); err != nil {
+				errorList = append(errorList, err)
+				// Cancel attempts must be sent in sequence order, so skip the remaining transactions of this address
+				break
+			}
+		}
+	}
+	return errors.Join(errorList...)
+}
+
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) cancelTx(ctx context.Context, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], blockNum int64) error {
+	lggr := etx.GetLogger(ec.lggr)
+	// Create a cancel attempt for tx
+	cancelAttempt, err := ec.TxAttemptBuilder.NewCancelTxAttempt(ctx, etx, lggr)
+	if err != nil {
+		return fmt.Errorf("failed to create a cancel attempt: %w", err)
+	}
+	// Save cancel attempt
+	if err := ec.txStore.SaveInProgressAttempt(ctx, &cancelAttempt); err != nil {
+		return fmt.Errorf("failed to save cancel attempt: %w", err)
+	}
+	lggr.Warnw("cancelling transaction past its deadline", "etx", etx, "deadline", etx.Deadline, "blockNum", blockNum)
+	// Send cancel attempt
+	if err := ec.handleInProgressAttempt(ctx, lggr, etx, cancelAttempt, blockNum); err != nil {
+		return fmt.Errorf("failed to send cancel attempt: %w", err)
+	}
+	// Pending task runs are resumed once the receipt of the cancel attempt is in, since the transaction may still be included
+	return nil
+}
+
+// ProcessPrivateTxsPastFallback broadcasts publicly the unconfirmed transactions sent through the private relay that are
+// still not included after the configured fallback blocks, since private transactions never appear in the public mempool
+// and the relay may never get them included
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) ProcessPrivateTxsPastFallback(ctx context.Context, blockNum int64) error {
+	fallbackBlocks := ec.txConfig.PrivateSubmissionFallbackBlocks()
+	if fallbackBlocks == 0 {
+		return nil
+	}
+	var errorList []error
+	for _, address := range ec.enabledAddresses {
+		etxs, err := ec.txStore.FindPrivateTxsPastFallback(ctx, address, blockNum-int64(fallbackBlocks), ec.chainID)
+		if err != nil {
+			errorList = append(errorList, fmt.Errorf("failed to find private transactions past fallback for address %s: %w", address.String(), err))
+			continue
+		}
+		for _, etx := range etxs {
+			if err := ec.txStore.UpdateTxPrivateSubmissionFallback(ctx, etx.ID); err != nil {
+				errorList = append(errorList, fmt.Errorf("failed to fall back to public broadcast for transaction %d: %w", etx.ID, err))
+				continue
+			}
+			etx.PrivateSubmission = false
+			promNumPrivateTxFallbacks.WithLabelValues(ec.chainID.String()).Inc()
+			lggr := etx.GetLogger(ec.lggr)
+			lggr.Warnw("Private transaction not included after fallback blocks, broadcasting it publicly", "etx", etx, "fallbackBlocks", fallbackBlocks, "blockNum", blockNum)
+			if len(etx.TxAttempts) == 0 {
+				continue
+			}
+			// The latest attempt is resent as is. Any error is left to the Resender and gas bumping to handle, as for any
+			// other unconfirmed transaction.
+			if errType, err := ec.client.SendTransactionReturnCode(ctx, *etx, etx.TxAttempts[0], lggr); errType != client.Successful {
+				lggr.Warnw("Failed to broadcast private transaction publicly", "attempt", etx.TxAttempts[0], "errType", errType, "err", err)
+			}
+		}
+	}
+	return errors.Join(errorList...)
+}
+
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) resumeFailedTaskRuns(ctx context.Context, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], taskErr error) error {
 	if !etx.PipelineTaskRunID.Valid || ec.resumeCallback == nil || !etx.SignalCallback || etx.CallbackCompleted {
 		return nil
 	}
-	err := ec.resumeCallback(ctx, etx.PipelineTaskRunID.UUID, nil, errors.New(ec.stuckTxDetector.StuckTxFatalError()))
+	err := ec.resumeCallback(ctx, etx.PipelineTaskRunID.UUID, nil, taskErr)
 	if errors.Is(err, sql.ErrNoRows) {
 		ec.lggr.Debugw("callback missing or already resumed", "etxID", etx.ID)
 	} else if err != nil {
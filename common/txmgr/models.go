@@ -12,4 +12,10 @@ const (
 	TxConfirmed               = txmgrtypes.TxState("confirmed")
 	TxConfirmedMissingReceipt = txmgrtypes.TxState("confirmed_missing_receipt")
 	TxFinalized               = txmgrtypes.TxState("finalized")
+	// TxCancelled is the state of the txs replaced by a cancel attempt after their deadline
+	TxCancelled = txmgrtypes.TxState("cancelled")
+	// TxBatched is the state of the txs whose call was aggregated into a batch tx
+	TxBatched = txmgrtypes.TxState("batched")
+	// TxSimulationFailed is the state of the txs that were not broadcast because their simulation reverted
+	TxSimulationFailed = txmgrtypes.TxState("simulation_failed")
 )
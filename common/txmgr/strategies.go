@@ -3,10 +3,12 @@ package txmgr
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/google/uuid"
 
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+	"github.com/smartcontractkit/chainlink/v2/common/types"
 )
 
 var _ txmgrtypes.TxStrategy = SendEveryStrategy{}
@@ -62,3 +64,46 @@ func (s DropOldestStrategy) PruneQueue(ctx context.Context, pruneService txmgrty
 	}
 	return
 }
+
+var _ txmgrtypes.TxBatchStrategy = BatchStrategy{}
+
+// BatchStrategy aggregates the calls of the unstarted transactions of its subject sent to one of its targets into a
+// single transaction to a batch contract, like Multicall3. Transactions are batched as they are queued, so calls are
+// only aggregated while earlier transactions are still waiting to be broadcast.
+// The batch contract is the msg.sender of the aggregated calls. It is either Multicall3, for targets that do not
+// authenticate their caller, or the forwarder the transactions are sent through.
+type BatchStrategy struct {
+	subject       uuid.UUID
+	maxBatchSize  uint32
+	batchContract string
+	targets       []string
+}
+
+// NewBatchStrategy creates a new TxStrategy that aggregates up to maxBatchSize calls to targets into transactions to
+// batchContract.
+func NewBatchStrategy[ADDR types.Hashable](subject uuid.UUID, maxBatchSize uint32, batchContract ADDR, targets []ADDR) BatchStrategy {
+	s := BatchStrategy{subject: subject, maxBatchSize: maxBatchSize, batchContract: batchContract.String()}
+	for _, target := range targets {
+		s.targets = append(s.targets, target.String())
+	}
+	return s
+}
+
+func (s BatchStrategy) Subject() uuid.NullUUID {
+	return uuid.NullUUID{UUID: s.subject, Valid: true}
+}
+
+func (s BatchStrategy) PruneQueue(ctx context.Context, pruneService txmgrtypes.UnstartedTxQueuePruner) ([]int64, error) {
+	return nil, nil
+}
+
+func (s BatchStrategy) BatchTx(ctx context.Context, batcher txmgrtypes.UnstartedTxBatcher, etxID int64, toAddress string) (batchTxID int64, batched bool, err error) {
+	if s.maxBatchSize < 2 || !slices.Contains(s.targets, toAddress) {
+		return 0, false, nil
+	}
+	batchTxID, batched, err = batcher.BatchUnstartedTx(ctx, etxID, s.subject, s.batchContract, s.targets, s.maxBatchSize)
+	if err != nil {
+		return 0, false, fmt.Errorf("BatchStrategy#BatchTx failed: %w", err)
+	}
+	return
+}
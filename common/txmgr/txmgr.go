@@ -109,6 +109,7 @@ type Txm[
 	confirmer          *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]
 	tracker            *Tracker[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]
 	finalizer          txmgrtypes.Finalizer[BLOCK_HASH, HEAD]
+	reconciler         services.Service
 	fwdMgr             txmgrtypes.ForwarderManager[ADDR]
 	txAttemptBuilder   txmgrtypes.TxAttemptBuilder[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
 	newErrorClassifier NewErrorClassifier
@@ -146,6 +147,7 @@ func NewTxm[
 	resender *Resender[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE],
 	tracker *Tracker[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE],
 	finalizer txmgrtypes.Finalizer[BLOCK_HASH, HEAD],
+	reconciler services.Service,
 	newErrorClassifierFunc NewErrorClassifier,
 ) *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE] {
 	b := Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]{
@@ -169,6 +171,7 @@ func NewTxm[
 		tracker:            tracker,
 		newErrorClassifier: newErrorClassifierFunc,
 		finalizer:          finalizer,
+		reconciler:         reconciler,
 	}
 
 	if txCfg.ResendAfterThreshold() <= 0 {
@@ -220,6 +223,12 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Start(ctx
 			b.resender.Start(ctx)
 		}
 
+		if b.reconciler != nil {
+			if err := ms.Start(ctx, b.reconciler); err != nil {
+				return fmt.Errorf("Txm: Reconciler failed to start: %w", err)
+			}
+		}
+
 		if b.fwdMgr != nil {
 			if err := ms.Start(ctx, b.fwdMgr); err != nil {
 				return fmt.Errorf("Txm: ForwarderManager failed to start: %w", err)
@@ -273,6 +282,11 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Close() (m
 		if b.resender != nil {
 			b.resender.Stop()
 		}
+		if b.reconciler != nil {
+			if err := b.reconciler.Close(); err != nil {
+				merr = errors.Join(merr, fmt.Errorf("Txm: failed to stop Reconciler: %w", err))
+			}
+		}
 		if b.fwdMgr != nil {
 			if err := b.fwdMgr.Close(); err != nil {
 				merr = errors.Join(merr, fmt.Errorf("Txm: failed to stop ForwarderManager: %w", err))
@@ -302,6 +316,9 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) HealthRepo
 		services.CopyHealth(report, b.confirmer.HealthReport())
 		services.CopyHealth(report, b.txAttemptBuilder.HealthReport())
 		services.CopyHealth(report, b.finalizer.HealthReport())
+		if b.reconciler != nil {
+			services.CopyHealth(report, b.reconciler.HealthReport())
+		}
 	})
 
 	if b.txConfig.ForwardersEnabled() {
@@ -489,6 +506,7 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) OnNewLonge
 		if b.reaper != nil {
 			b.reaper.SetLatestBlockNum(head.BlockNumber())
 		}
+		b.broadcaster.SetLatestBlockNum(head.BlockNumber())
 		b.txAttemptBuilder.OnNewLongestChain(ctx, head)
 		select {
 		case b.chHeads <- head:
@@ -653,6 +671,17 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) GetTransac
 	if tx == nil {
 		return status, fmt.Errorf("failed to find transaction with IdempotencyKey %s", transactionID)
 	}
+	if tx.State == TxBatched && tx.BatchTxID != nil {
+		// The status of a batched transaction is the one of its batch transaction
+		batchTxs, err := b.txStore.FindTxesByIDs(ctx, []int64{*tx.BatchTxID}, b.chainID)
+		if err != nil {
+			return status, fmt.Errorf("failed to find batch transaction %d of transaction with IdempotencyKey %s: %w", *tx.BatchTxID, transactionID, err)
+		}
+		if len(batchTxs) == 0 {
+			return status, fmt.Errorf("failed to find batch transaction %d of transaction with IdempotencyKey %s", *tx.BatchTxID, transactionID)
+		}
+		tx = batchTxs[0]
+	}
 	switch tx.State {
 	case TxUnconfirmed, TxConfirmedMissingReceipt:
 		// Return pending for ConfirmedMissingReceipt since a receipt is required to consider it as unconfirmed
@@ -662,6 +691,12 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) GetTransac
 		return commontypes.Unconfirmed, nil
 	case TxFinalized:
 		return commontypes.Finalized, nil
+	case TxCancelled:
+		// Cancelled transactions were replaced after their deadline and will never be included
+		return commontypes.Failed, ErrTxCancelled
+	case TxSimulationFailed:
+		// Transactions whose simulation reverted were never broadcast
+		return commontypes.Failed, tx.GetError()
 	case TxFatalError:
 		// Use an ErrorClassifier to determine if the transaction is considered Fatal
 		txErr := b.newErrorClassifier(tx.GetError())
@@ -796,5 +831,17 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) pruneQueue
 		"transactionID", tx.ID,
 	)
 
+	if strategy, ok := txRequest.Strategy.(txmgrtypes.TxBatchStrategy); ok {
+		batchTxID, batched, err := strategy.BatchTx(ctx, b.txStore, tx.ID, tx.ToAddress.String())
+		if err != nil {
+			// The tx is still sent on its own
+			b.logger.Errorw("Failed to batch transaction", "transactionID", tx.ID, "err", err)
+		} else if batched {
+			b.logger.Debugw("Batched transaction", "transactionID", tx.ID, "batchTransactionID", batchTxID)
+			tx.State = TxBatched
+			tx.BatchTxID = &batchTxID
+		}
+	}
+
 	return tx, nil
 }
@@ -55,6 +55,7 @@ type ConfirmerDatabaseConfig interface {
 type ConfirmerTransactionsConfig interface {
 	MaxInFlight() uint32
 	ForwardersEnabled() bool
+	PrivateSubmissionFallbackBlocks() uint32
 }
 
 type ResenderChainConfig interface {
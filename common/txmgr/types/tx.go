@@ -31,6 +31,14 @@ type TxStrategy interface {
 	PruneQueue(ctx context.Context, pruneService UnstartedTxQueuePruner) (ids []int64, err error)
 }
 
+// TxBatchStrategy is implemented by the TxStrategy aggregating the calls of the txs of their subject into batch txs
+type TxBatchStrategy interface {
+	TxStrategy
+	// BatchTx is called after tx insertion, with the service aggregating the unstarted txs. It returns the ID of the
+	// batch tx the call of the tx was added to, if it was.
+	BatchTx(ctx context.Context, batcher UnstartedTxBatcher, etxID int64, toAddress string) (batchTxID int64, batched bool, err error)
+}
+
 type TxAttemptState int8
 
 type TxState string
@@ -93,6 +101,30 @@ type TxRequest[ADDR types.Hashable, TX_HASH types.Hashable] struct {
 
 	// Mark tx requiring callback
 	SignalCallback bool
+
+	// Deadline is when the tx is worth nothing anymore. It is priced to be included by then if the fee estimator can
+	// forecast it, and cancelled if it is not included by then.
+	Deadline TxDeadline
+
+	// PrivateSubmission sends the tx through the configured private relay instead of the public mempool
+	PrivateSubmission bool
+}
+
+// TxDeadline is the time or block number after which a tx is cancelled if it is not included yet. If both are set, the
+// tx is cancelled once either is passed.
+type TxDeadline struct {
+	Time        *time.Time
+	BlockNumber *int64
+}
+
+// IsZero returns true if the deadline is not set.
+func (d TxDeadline) IsZero() bool {
+	return d.Time == nil && d.BlockNumber == nil
+}
+
+// Passed returns true if the deadline is before now or blockNum.
+func (d TxDeadline) Passed(now time.Time, blockNum int64) bool {
+	return (d.Time != nil && d.Time.Before(now)) || (d.BlockNumber != nil && *d.BlockNumber < blockNum)
 }
 
 // TransmitCheckerSpec defines the check that should be performed before a transaction is submitted
@@ -108,6 +140,10 @@ type TransmitCheckerSpec[ADDR types.Hashable] struct {
 	// VRFRequestBlockNumber is the block number in which the provided VRF request has been made.
 	// This should be set iff CheckerType is TransmitCheckerTypeVRFV2.
 	VRFRequestBlockNumber *big.Int `json:",omitempty"`
+
+	// RevertABI is the JSON ABI of the custom errors the transaction may revert with, to decode them when its
+	// simulation reverts. This is only used if CheckerType is TransmitCheckerTypeSimulate.
+	RevertABI string `json:",omitempty"`
 }
 
 // TransmitCheckerType describes the type of check that should be performed before a transaction is
@@ -186,6 +222,8 @@ type TxAttempt[
 	Receipts                []ChainReceipt[TX_HASH, BLOCK_HASH] `json:"-"`
 	TxType                  int
 	IsPurgeAttempt          bool
+	// IsCancelAttempt marks the purge attempts sent to the from address to cancel a tx after its deadline
+	IsCancelAttempt bool
 }
 
 func (a *TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) String() string {
@@ -236,6 +274,14 @@ type Tx[
 	SignalCallback bool
 	// Marks tx callback as signaled
 	CallbackCompleted bool
+
+	// Deadline after which the tx is cancelled if it is not included yet
+	Deadline TxDeadline
+	// BatchTxID is the ID of the batch tx the call of this tx was aggregated into, at index BatchCallIndex
+	BatchTxID      *int64
+	BatchCallIndex *int32
+	// PrivateSubmission marks tx as sent through the private relay until it falls back to public broadcast
+	PrivateSubmission bool
 }
 
 func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetError() error {
@@ -351,6 +397,15 @@ func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) HasPurgeAttempt() bo
 	return false
 }
 
+func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) HasCancelAttempt() bool {
+	for _, attempt := range e.TxAttempts {
+		if attempt.IsCancelAttempt {
+			return true
+		}
+	}
+	return false
+}
+
 // Provides error classification to external components in a chain agnostic way
 // Only exposes the error types that could be set in the transaction error field
 type ErrorClassifier interface {
@@ -30,6 +30,7 @@ type TxStore[
 	FEE feetypes.Fee,
 ] interface {
 	UnstartedTxQueuePruner
+	UnstartedTxBatcher
 	TxHistoryReaper[CHAIN_ID]
 	TransactionStore[ADDR, CHAIN_ID, TX_HASH, BLOCK_HASH, SEQ, FEE]
 
@@ -71,7 +72,13 @@ type TransactionStore[
 	FindLatestSequence(ctx context.Context, fromAddress ADDR, chainID CHAIN_ID) (SEQ, error)
 	// FindReorgOrIncludedTxs returns either a list of re-org'd transactions or included transactions based on the provided sequence
 	FindReorgOrIncludedTxs(ctx context.Context, fromAddress ADDR, nonce SEQ, chainID CHAIN_ID) (reorgTx []*Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], includedTxs []*Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
+	// FindTxesByIDs returns the transactions with the given IDs, without their attempts
+	FindTxesByIDs(ctx context.Context, etxIDs []int64, chainID CHAIN_ID) (etxs []*Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	FindTxsRequiringGasBump(ctx context.Context, address ADDR, blockNum, gasBumpThreshold, depth int64, chainID CHAIN_ID) (etxs []*Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
+	// FindTxsPastDeadline returns the unconfirmed txs of address whose deadline is before now or blockNum, and that are not being cancelled or purged yet
+	FindTxsPastDeadline(ctx context.Context, address ADDR, now time.Time, blockNum int64, chainID CHAIN_ID) (etxs []*Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
+	// FindPrivateTxsPastFallback returns the unconfirmed txs of address sent privately, and first broadcast before blockNum
+	FindPrivateTxsPastFallback(ctx context.Context, address ADDR, blockNum int64, chainID CHAIN_ID) (etxs []*Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	FindTxsRequiringResubmissionDueToInsufficientFunds(ctx context.Context, address ADDR, chainID CHAIN_ID) (etxs []*Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	FindTxAttemptsConfirmedMissingReceipt(ctx context.Context, chainID CHAIN_ID) (attempts []TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	FindTxAttemptsRequiringResend(ctx context.Context, olderThan time.Time, maxInFlightTransactions uint32, chainID CHAIN_ID, address ADDR) (attempts []TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
@@ -106,8 +113,14 @@ type TransactionStore[
 	UpdateTxFatalErrorAndDeleteAttempts(ctx context.Context, etx *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error
 	// UpdateTxFatalError updates transaction states to fatal error with error message
 	UpdateTxFatalError(ctx context.Context, etxIDs []int64, errMsg string) error
+	// UpdateTxSimulationFailed updates an unstarted transaction state to simulation failed with its error
+	UpdateTxSimulationFailed(ctx context.Context, etx *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error
+	// UpdateTxPrivateSubmissionFallback updates a transaction sent privately to be broadcast publicly from now on
+	UpdateTxPrivateSubmissionFallback(ctx context.Context, etxID int64) error
 	UpdateTxsForRebroadcast(ctx context.Context, etxIDs []int64, attemptIDs []int64) error
 	UpdateTxsUnconfirmed(ctx context.Context, etxIDs []int64) error
+	// UpdateTxUnstartedToCancelled updates an unstarted transaction past its deadline to cancelled, without it ever being sent
+	UpdateTxUnstartedToCancelled(ctx context.Context, etx *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error
 	UpdateTxUnstartedToInProgress(ctx context.Context, etx *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], attempt *TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error
 }
 
@@ -119,11 +132,25 @@ type UnstartedTxQueuePruner interface {
 	PruneUnstartedTxQueue(ctx context.Context, queueSize uint32, subject uuid.UUID) (ids []int64, err error)
 }
 
+type UnstartedTxBatcher interface {
+	// BatchUnstartedTx aggregates the call of the unstarted tx etxID with the ones of the other unstarted txs of subject
+	// sent from the same address to one of targets, into a single tx to batchContract. Batch txs have at most
+	// maxBatchSize calls. The tx is left as is if there is nothing to aggregate it with.
+	BatchUnstartedTx(ctx context.Context, etxID int64, subject uuid.UUID, batchContract string, targets []string, maxBatchSize uint32) (batchTxID int64, batched bool, err error)
+}
+
 // R is the raw unparsed transaction receipt
 type ReceiptPlus[R any] struct {
 	ID           uuid.UUID `db:"pipeline_run_id"`
 	Receipt      R         `db:"receipt"`
 	FailOnRevert bool      `db:"fail_on_revert"`
+	// BatchTxID is the ID of the batch tx Receipt is for, if the tx was batched
+	BatchTxID *int64 `db:"batch_tx_id"`
+	// BatchCallIndex is the index of the call of the tx in the batch tx Receipt is for, if the tx was batched
+	BatchCallIndex *int32 `db:"batch_call_index"`
+	// BatchCallAllowFailure is true if the call of the tx could fail without reverting its batch tx, in which case its
+	// result is not the status of Receipt
+	BatchCallAllowFailure bool `db:"batch_call_allow_failure"`
 }
 
 type ChainReceipt[TX_HASH, BLOCK_HASH types.Hashable] interface {
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -48,3 +49,21 @@ func TestTxAttemptState(t *testing.T) {
 		}
 	})
 }
+
+func TestTxDeadline(t *testing.T) {
+	now := time.Now()
+	before, after := now.Add(-time.Minute), now.Add(time.Minute)
+	block := int64(100)
+
+	assert.True(t, TxDeadline{}.IsZero())
+	assert.False(t, TxDeadline{}.Passed(now, block))
+	assert.False(t, TxDeadline{BlockNumber: &block}.IsZero())
+
+	assert.True(t, TxDeadline{Time: &before}.Passed(now, block))
+	assert.False(t, TxDeadline{Time: &after}.Passed(now, block))
+	assert.True(t, TxDeadline{BlockNumber: &block}.Passed(now, block+1))
+	assert.False(t, TxDeadline{BlockNumber: &block}.Passed(now, block))
+	// Either passing is enough
+	assert.True(t, TxDeadline{Time: &after, BlockNumber: &block}.Passed(now, block+1))
+	assert.True(t, TxDeadline{Time: &before, BlockNumber: &block}.Passed(now, block))
+}
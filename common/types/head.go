@@ -42,4 +42,7 @@ type Head[BLOCK_HASH Hashable] interface {
 
 	// Returns the latest finalized based on finality tag or depth
 	LatestFinalizedHead() Head[BLOCK_HASH]
+
+	// Returns the latest safe based on safe tag, or the latest finalized if the chain has no safe tag
+	LatestSafeHead() Head[BLOCK_HASH]
 }
@@ -586,6 +586,53 @@ func (_c *Head_LatestFinalizedHead_Call[BLOCK_HASH]) RunAndReturn(run func() typ
 	return _c
 }
 
+// LatestSafeHead provides a mock function with given fields:
+func (_m *Head[BLOCK_HASH]) LatestSafeHead() types.Head[BLOCK_HASH] {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LatestSafeHead")
+	}
+
+	var r0 types.Head[BLOCK_HASH]
+	if rf, ok := ret.Get(0).(func() types.Head[BLOCK_HASH]); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Head[BLOCK_HASH])
+		}
+	}
+
+	return r0
+}
+
+// Head_LatestSafeHead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LatestSafeHead'
+type Head_LatestSafeHead_Call[BLOCK_HASH types.Hashable] struct {
+	*mock.Call
+}
+
+// LatestSafeHead is a helper method to define mock.On call
+func (_e *Head_Expecter[BLOCK_HASH]) LatestSafeHead() *Head_LatestSafeHead_Call[BLOCK_HASH] {
+	return &Head_LatestSafeHead_Call[BLOCK_HASH]{Call: _e.mock.On("LatestSafeHead")}
+}
+
+func (_c *Head_LatestSafeHead_Call[BLOCK_HASH]) Run(run func()) *Head_LatestSafeHead_Call[BLOCK_HASH] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Head_LatestSafeHead_Call[BLOCK_HASH]) Return(_a0 types.Head[BLOCK_HASH]) *Head_LatestSafeHead_Call[BLOCK_HASH] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Head_LatestSafeHead_Call[BLOCK_HASH]) RunAndReturn(run func() types.Head[BLOCK_HASH]) *Head_LatestSafeHead_Call[BLOCK_HASH] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewHead creates a new instance of Head. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewHead[BLOCK_HASH types.Hashable](t interface {
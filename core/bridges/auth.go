@@ -0,0 +1,188 @@
+package bridges
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	"go.uber.org/multierr"
+)
+
+// AuthMode is how the requests to a bridge are authenticated, on top of the
+// outgoing token.
+type AuthMode string
+
+const (
+	// AuthModeToken sends requests as they are.
+	AuthModeToken AuthMode = "token"
+	// AuthModeHMAC signs requests with a secret shared with the bridge.
+	AuthModeHMAC AuthMode = "hmac"
+	// AuthModeMTLS presents a client certificate to the bridge.
+	AuthModeMTLS AuthMode = "mtls"
+)
+
+const (
+	// TimestampHeader carries the unix time in seconds at which a request or
+	// response was signed.
+	TimestampHeader = "X-Chainlink-Bridge-Timestamp"
+	// SignatureHeader carries the signature of a request or response, see Sign.
+	SignatureHeader = "X-Chainlink-Bridge-Signature"
+	// MaxSignatureAge bounds the age, and the clock skew, of signed responses.
+	MaxSignatureAge = 5 * time.Minute
+
+	minHMACSecretLength = 16
+)
+
+// ErrInvalidSignature is returned for responses of bridges with
+// VerifyResponses that are not signed with the secret of the bridge.
+var ErrInvalidSignature = pkgerrors.New("invalid bridge response signature")
+
+// OrDefault returns AuthModeToken for the empty mode.
+func (m AuthMode) OrDefault() AuthMode {
+	if m == "" {
+		return AuthModeToken
+	}
+	return m
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of "<ts>.<hex encoded SHA-256 of
+// body>" with secret, where ts is the unix time in seconds.
+func Sign(secret string, ts int64, body []byte) string {
+	digest := sha256.Sum256(body)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(ts, 10) + "." + hex.EncodeToString(digest[:])))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SignRequest returns the headers, as name and value pairs, that
+// authenticate a request with body to the bridge. It returns nil unless the
+// bridge uses AuthModeHMAC.
+func (bt BridgeType) SignRequest(body []byte, now time.Time) []string {
+	if bt.AuthMode != AuthModeHMAC {
+		return nil
+	}
+	ts := now.Unix()
+	return []string{
+		TimestampHeader, strconv.FormatInt(ts, 10),
+		SignatureHeader, Sign(bt.HMACSecret, ts, body),
+	}
+}
+
+// VerifyResponse checks that a response of the bridge is signed with its
+// secret within MaxSignatureAge of now, if the bridge verifies responses.
+func (bt BridgeType) VerifyResponse(header http.Header, body []byte, now time.Time) error {
+	if !bt.VerifyResponses {
+		return nil
+	}
+	ts, err := strconv.ParseInt(header.Get(TimestampHeader), 10, 64)
+	if err != nil {
+		return pkgerrors.Wrapf(ErrInvalidSignature, "missing or malformed %s header", TimestampHeader)
+	}
+	if age := now.Sub(time.Unix(ts, 0)); age > MaxSignatureAge || age < -MaxSignatureAge {
+		return pkgerrors.Wrapf(ErrInvalidSignature, "signed %s ago, allowed up to %s", age, MaxSignatureAge)
+	}
+	signature, err := hex.DecodeString(header.Get(SignatureHeader))
+	if err != nil {
+		return pkgerrors.Wrapf(ErrInvalidSignature, "malformed %s header", SignatureHeader)
+	}
+	expected, _ := hex.DecodeString(Sign(bt.HMACSecret, ts, body))
+	if !hmac.Equal(signature, expected) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// ClientTLSConfig returns the TLS config presenting the client certificate of
+// a bridge that uses AuthModeMTLS. The server certificate is checked against
+// TLSRootCA if set, or the system roots otherwise.
+func (bt BridgeType) ClientTLSConfig() (*tls.Config, error) {
+	cert, err := tls.X509KeyPair([]byte(bt.TLSClientCert), []byte(bt.TLSClientKey))
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "invalid TLS client certificate or key")
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if bt.TLSRootCA != "" {
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM([]byte(bt.TLSRootCA)) {
+			return nil, pkgerrors.New("invalid TLS root CA, expected PEM encoded certificates")
+		}
+	}
+	return config, nil
+}
+
+// ValidateAuth checks that the request sets the secrets its auth mode needs,
+// and only those.
+func (btr *BridgeTypeRequest) ValidateAuth() (err error) {
+	mode := btr.AuthMode.OrDefault()
+	switch mode {
+	case AuthModeToken, AuthModeHMAC, AuthModeMTLS:
+	default:
+		return fmt.Errorf("AuthMode must be one of %s, %s or %s", AuthModeToken, AuthModeHMAC, AuthModeMTLS)
+	}
+
+	if mode == AuthModeHMAC {
+		if len(btr.HMACSecret) < minHMACSecretLength {
+			err = multierr.Append(err, fmt.Errorf("HMACSecret must be at least %d characters", minHMACSecretLength))
+		}
+	} else {
+		if btr.HMACSecret != "" {
+			err = multierr.Append(err, fmt.Errorf("HMACSecret requires AuthMode %s", AuthModeHMAC))
+		}
+		if btr.verifyResponses() {
+			err = multierr.Append(err, fmt.Errorf("VerifyResponses requires AuthMode %s", AuthModeHMAC))
+		}
+	}
+
+	if mode == AuthModeMTLS {
+		if btr.URL.Scheme != "https" {
+			err = multierr.Append(err, fmt.Errorf("AuthMode %s requires an https URL", AuthModeMTLS))
+		}
+		bt := BridgeType{TLSClientCert: btr.TLSClientCert, TLSClientKey: btr.TLSClientKey, TLSRootCA: btr.TLSRootCA}
+		if _, tlsErr := bt.ClientTLSConfig(); tlsErr != nil {
+			err = multierr.Append(err, tlsErr)
+		}
+	} else if btr.TLSClientCert != "" || btr.TLSClientKey != "" || btr.TLSRootCA != "" {
+		err = multierr.Append(err, fmt.Errorf("TLSClientCert, TLSClientKey and TLSRootCA require AuthMode %s", AuthModeMTLS))
+	}
+	return err
+}
+
+// verifyResponses returns whether the request enables VerifyResponses.
+func (btr *BridgeTypeRequest) verifyResponses() bool {
+	return btr.VerifyResponses != nil && *btr.VerifyResponses
+}
+
+// KeepAuth fills the auth settings that an update of bt omits from bt. The
+// auth mode and response verification are kept unless set, and so are the
+// secrets, unless the auth mode is changed.
+func (btr *BridgeTypeRequest) KeepAuth(bt BridgeType) {
+	if btr.AuthMode == "" {
+		btr.AuthMode = bt.AuthMode
+	}
+	if btr.AuthMode.OrDefault() != bt.AuthMode.OrDefault() {
+		return
+	}
+	if btr.VerifyResponses == nil {
+		btr.VerifyResponses = &bt.VerifyResponses
+	}
+	if btr.HMACSecret == "" {
+		btr.HMACSecret = bt.HMACSecret
+	}
+	if btr.TLSClientCert == "" && btr.TLSClientKey == "" {
+		btr.TLSClientCert = bt.TLSClientCert
+		btr.TLSClientKey = bt.TLSClientKey
+	}
+	if btr.TLSRootCA == "" {
+		btr.TLSRootCA = bt.TLSRootCA
+	}
+}
@@ -0,0 +1,135 @@
+package bridges_test
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	clhttptest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/httptest"
+)
+
+const testHMACSecret = "0123456789abcdef0123456789abcdef"
+
+func TestBridgeType_SignRequest(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1700000000, 0)
+	body := []byte(`{"data":{}}`)
+
+	bt := bridges.BridgeType{AuthMode: bridges.AuthModeToken}
+	assert.Nil(t, bt.SignRequest(body, now))
+
+	bt = bridges.BridgeType{AuthMode: bridges.AuthModeHMAC, HMACSecret: testHMACSecret}
+	headers := bt.SignRequest(body, now)
+	require.Equal(t, []string{
+		bridges.TimestampHeader, "1700000000",
+		bridges.SignatureHeader, bridges.Sign(testHMACSecret, 1700000000, body),
+	}, headers)
+	// HMAC-SHA256 of "1700000000.<sha256 of body>"
+	assert.Equal(t, "aeb7e77c30a45f7138bb5aad154c2a6bc1fae124036bcae8a0683121bed44f4d", headers[3])
+}
+
+func TestBridgeType_VerifyResponse(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	body := []byte(`{"data":{"result":1}}`)
+	signed := func(secret string, ts time.Time, body []byte) http.Header {
+		h := http.Header{}
+		h.Set(bridges.TimestampHeader, strconv.FormatInt(ts.Unix(), 10))
+		h.Set(bridges.SignatureHeader, bridges.Sign(secret, ts.Unix(), body))
+		return h
+	}
+
+	bt := bridges.BridgeType{AuthMode: bridges.AuthModeHMAC, HMACSecret: testHMACSecret}
+	require.NoError(t, bt.VerifyResponse(http.Header{}, body, now), "responses are not verified by default")
+
+	bt.VerifyResponses = true
+	require.NoError(t, bt.VerifyResponse(signed(testHMACSecret, now, body), body, now))
+
+	for name, header := range map[string]http.Header{
+		"unsigned":      {},
+		"other secret":  signed("fedcba9876543210fedcba9876543210", now, body),
+		"other body":    signed(testHMACSecret, now, []byte(`{"data":{"result":2}}`)),
+		"too old":       signed(testHMACSecret, now.Add(-bridges.MaxSignatureAge-time.Second), body),
+		"in the future": signed(testHMACSecret, now.Add(bridges.MaxSignatureAge+time.Second), body),
+	} {
+		assert.ErrorIs(t, bt.VerifyResponse(header, body, now), bridges.ErrInvalidSignature, name)
+	}
+}
+
+func TestBridgeTypeRequest_ValidateAuth(t *testing.T) {
+	t.Parallel()
+
+	certPEM, keyPEM := clhttptest.NewTLSCertificatePEM(t)
+	_, otherKeyPEM := clhttptest.NewTLSCertificatePEM(t)
+
+	tests := []struct {
+		name string
+		btr  bridges.BridgeTypeRequest
+		err  string
+	}{
+		{"default", bridges.BridgeTypeRequest{}, ""},
+		{"unknown mode", bridges.BridgeTypeRequest{AuthMode: "basic"}, "AuthMode must be one of token, hmac or mtls"},
+		{"hmac", bridges.BridgeTypeRequest{AuthMode: bridges.AuthModeHMAC, HMACSecret: testHMACSecret, VerifyResponses: ptr.To(true)}, ""},
+		{"hmac short secret", bridges.BridgeTypeRequest{AuthMode: bridges.AuthModeHMAC, HMACSecret: "secret"}, "HMACSecret must be at least 16 characters"},
+		{"verify without hmac", bridges.BridgeTypeRequest{VerifyResponses: ptr.To(true)}, "VerifyResponses requires AuthMode hmac"},
+		{"secret without hmac", bridges.BridgeTypeRequest{HMACSecret: testHMACSecret}, "HMACSecret requires AuthMode hmac"},
+		{"mtls", bridges.BridgeTypeRequest{
+			AuthMode: bridges.AuthModeMTLS, URL: cltest.WebURL(t, "https://bridge.example.com"),
+			TLSClientCert: certPEM, TLSClientKey: keyPEM, TLSRootCA: certPEM,
+		}, ""},
+		{"mtls over http", bridges.BridgeTypeRequest{
+			AuthMode: bridges.AuthModeMTLS, URL: cltest.WebURL(t, "http://bridge.example.com"),
+			TLSClientCert: certPEM, TLSClientKey: keyPEM,
+		}, "AuthMode mtls requires an https URL"},
+		{"mtls mismatched key", bridges.BridgeTypeRequest{
+			AuthMode: bridges.AuthModeMTLS, URL: cltest.WebURL(t, "https://bridge.example.com"),
+			TLSClientCert: certPEM, TLSClientKey: otherKeyPEM,
+		}, "invalid TLS client certificate or key"},
+		{"mtls invalid root CA", bridges.BridgeTypeRequest{
+			AuthMode: bridges.AuthModeMTLS, URL: cltest.WebURL(t, "https://bridge.example.com"),
+			TLSClientCert: certPEM, TLSClientKey: keyPEM, TLSRootCA: "root",
+		}, "invalid TLS root CA"},
+		{"certificate without mtls", bridges.BridgeTypeRequest{TLSClientCert: certPEM}, "TLSClientCert, TLSClientKey and TLSRootCA require AuthMode mtls"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.btr.ValidateAuth()
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestBridgeTypeRequest_KeepAuth(t *testing.T) {
+	t.Parallel()
+
+	bt := bridges.BridgeType{AuthMode: bridges.AuthModeHMAC, HMACSecret: testHMACSecret, VerifyResponses: true}
+
+	btr := bridges.BridgeTypeRequest{}
+	btr.KeepAuth(bt)
+	assert.Equal(t, bridges.AuthModeHMAC, btr.AuthMode, "the auth mode is kept unless set")
+	assert.Equal(t, testHMACSecret, btr.HMACSecret)
+	assert.Equal(t, ptr.To(true), btr.VerifyResponses)
+
+	btr = bridges.BridgeTypeRequest{AuthMode: bridges.AuthModeHMAC, HMACSecret: "fedcba9876543210fedcba9876543210", VerifyResponses: ptr.To(false)}
+	btr.KeepAuth(bt)
+	assert.Equal(t, "fedcba9876543210fedcba9876543210", btr.HMACSecret)
+	assert.Equal(t, ptr.To(false), btr.VerifyResponses)
+
+	btr = bridges.BridgeTypeRequest{AuthMode: bridges.AuthModeToken}
+	btr.KeepAuth(bt)
+	assert.Empty(t, btr.HMACSecret, "secrets are dropped when the auth mode is changed")
+	assert.Nil(t, btr.VerifyResponses)
+}
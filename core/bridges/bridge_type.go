@@ -17,10 +17,19 @@ import (
 
 // BridgeTypeRequest is the incoming record used to create a BridgeType
 type BridgeTypeRequest struct {
-	Name                   BridgeName    `json:"name"`
-	URL                    models.WebURL `json:"url"`
-	Confirmations          uint32        `json:"confirmations"`
-	MinimumContractPayment *assets.Link  `json:"minimumContractPayment"`
+	Name                      BridgeName      `json:"name"`
+	URL                       models.WebURL   `json:"url"`
+	Confirmations             uint32          `json:"confirmations"`
+	MinimumContractPayment    *assets.Link    `json:"minimumContractPayment"`
+	CacheTTL                  models.Interval `json:"cacheTTL"`
+	CacheStaleWhileRevalidate models.Interval `json:"cacheStaleWhileRevalidate"`
+	CacheStaleIfError         models.Interval `json:"cacheStaleIfError"`
+	AuthMode                  AuthMode        `json:"authMode"`
+	HMACSecret                string          `json:"hmacSecret"`
+	TLSClientCert             string          `json:"tlsClientCert"`
+	TLSClientKey              string          `json:"tlsClientKey"`
+	TLSRootCA                 string          `json:"tlsRootCA"`
+	VerifyResponses           *bool           `json:"verifyResponses"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -52,16 +61,38 @@ type BridgeTypeAuthentication struct {
 
 // BridgeType is used for external adapters and has fields for
 // the name of the adapter and its URL.
+//
+// The auth secrets HMACSecret and TLSClientKey are encrypted at rest with a
+// key derived from the keystore password (see SecretsCipher), and are never
+// serialized.
 type BridgeType struct {
-	Name                   BridgeName
-	URL                    models.WebURL
-	Confirmations          uint32
-	IncomingTokenHash      string
-	Salt                   string
-	OutgoingToken          string
-	MinimumContractPayment *assets.Link
-	CreatedAt              time.Time
-	UpdatedAt              time.Time
+	Name                      BridgeName
+	URL                       models.WebURL
+	Confirmations             uint32
+	IncomingTokenHash         string
+	Salt                      string
+	OutgoingToken             string
+	MinimumContractPayment    *assets.Link
+	CacheTTL                  models.Interval
+	CacheStaleWhileRevalidate models.Interval
+	CacheStaleIfError         models.Interval
+	AuthMode                  AuthMode
+	HMACSecret                string `json:"-"`
+	TLSClientCert             string
+	TLSClientKey              string `json:"-"`
+	TLSRootCA                 string
+	VerifyResponses           bool
+	CreatedAt                 time.Time
+	UpdatedAt                 time.Time
+}
+
+// CachePolicy returns how the responses of the bridge are cached.
+func (bt BridgeType) CachePolicy() CachePolicy {
+	return CachePolicy{
+		TTL:                  bt.CacheTTL.Duration(),
+		StaleWhileRevalidate: bt.CacheStaleWhileRevalidate.Duration(),
+		StaleIfError:         bt.CacheStaleIfError.Duration(),
+	}
 }
 
 // NewBridgeType returns a bridge type authentication (with plaintext
@@ -78,21 +109,30 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 	}
 
 	return &BridgeTypeAuthentication{
-			Name:                   btr.Name,
-			URL:                    btr.URL,
-			Confirmations:          btr.Confirmations,
-			IncomingToken:          incomingToken,
-			OutgoingToken:          outgoingToken,
-			MinimumContractPayment: btr.MinimumContractPayment,
-		}, &BridgeType{
-			Name:                   btr.Name,
-			URL:                    btr.URL,
-			Confirmations:          btr.Confirmations,
-			IncomingTokenHash:      hash,
-			Salt:                   salt,
-			OutgoingToken:          outgoingToken,
-			MinimumContractPayment: btr.MinimumContractPayment,
-		}, nil
+		Name:                   btr.Name,
+		URL:                    btr.URL,
+		Confirmations:          btr.Confirmations,
+		IncomingToken:          incomingToken,
+		OutgoingToken:          outgoingToken,
+		MinimumContractPayment: btr.MinimumContractPayment,
+	}, &BridgeType{
+		Name:                      btr.Name,
+		URL:                       btr.URL,
+		Confirmations:             btr.Confirmations,
+		IncomingTokenHash:         hash,
+		Salt:                      salt,
+		OutgoingToken:             outgoingToken,
+		MinimumContractPayment:    btr.MinimumContractPayment,
+		CacheTTL:                  btr.CacheTTL,
+		CacheStaleWhileRevalidate: btr.CacheStaleWhileRevalidate,
+		CacheStaleIfError:         btr.CacheStaleIfError,
+		AuthMode:                  btr.AuthMode.OrDefault(),
+		HMACSecret:                btr.HMACSecret,
+		TLSClientCert:             btr.TLSClientCert,
+		TLSClientKey:              btr.TLSClientKey,
+		TLSRootCA:                 btr.TLSRootCA,
+		VerifyResponses:           btr.verifyResponses(),
+	}, nil
 }
 
 // AuthenticateBridgeType returns true if the passed token matches its
@@ -200,9 +240,43 @@ func (t *BridgeName) Scan(value interface{}) error {
 	return nil
 }
 
+// CachePolicy controls how the responses of a bridge are cached by the
+// ResponseCache. Responses are cached by request body, without the run meta,
+// so tasks of different jobs that send the same request share them.
+// Concurrent requests that miss the cache share a single bridge call.
+type CachePolicy struct {
+	// TTL is how long a response is served without calling the bridge.
+	TTL time.Duration
+	// StaleWhileRevalidate is how long after the TTL a response is still
+	// served, while it is refreshed in the background.
+	StaleWhileRevalidate time.Duration
+	// StaleIfError is how long a response is served in place of a failed
+	// bridge call.
+	StaleIfError time.Duration
+}
+
+// Enabled returns true if responses are cached at all.
+func (p CachePolicy) Enabled() bool {
+	return p.TTL > 0 || p.StaleIfError > 0
+}
+
+// Retention is how long a response may be served for.
+func (p CachePolicy) Retention() time.Duration {
+	return max(p.TTL+p.StaleWhileRevalidate, p.StaleIfError)
+}
+
 type BridgeResponse struct {
 	DotID      string
 	SpecID     int32
 	Value      []byte
 	FinishedAt time.Time
 }
+
+// CachedBridgeResponse is a response cached by bridge and request body, see
+// CachePolicy.
+type CachedBridgeResponse struct {
+	BridgeName  BridgeName
+	RequestHash []byte
+	Value       []byte
+	FinishedAt  time.Time
+}
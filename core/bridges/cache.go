@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"golang.org/x/exp/maps"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
@@ -33,26 +34,50 @@ type Cache struct {
 	bridgeTypesCache     sync.Map
 	bridgeLastValueCache map[string]BridgeResponse
 	mu                   sync.RWMutex
+	responses            map[requestKey]*cachedResponse
+	responsesMu          sync.RWMutex
+	fetches              singleflight.Group
+	circuits             *CircuitBreakers
 }
 
 var _ ORM = (*Cache)(nil)
 var _ services.Service = (*Cache)(nil)
+var _ CircuitBreaker = (*Cache)(nil)
 
 func NewCache(base ORM, lggr logger.Logger, upsertInterval time.Duration) *Cache {
 	c := &Cache{
 		ORM:                  base,
 		interval:             upsertInterval,
 		bridgeLastValueCache: make(map[string]BridgeResponse),
+		responses:            make(map[requestKey]*cachedResponse),
 	}
 	c.Service, c.eng = services.Config{
 		Name:  CacheServiceName,
 		Start: c.start,
 	}.NewServiceEngine(lggr)
+	c.circuits = NewCircuitBreakers(c.eng)
 	return c
 }
 
 func (c *Cache) WithDataSource(ds sqlutil.DataSource) ORM {
-	return NewCache(NewORM(ds), c.eng, c.interval)
+	cache := NewCache(c.ORM.WithDataSource(ds), c.eng, c.interval)
+	cache.circuits = c.circuits
+	return cache
+}
+
+// Circuits returns the circuit breakers of the bridges called through the cache.
+func (c *Cache) Circuits() *CircuitBreakers {
+	return c.circuits
+}
+
+// AllowRequest implements CircuitBreaker.
+func (c *Cache) AllowRequest(name BridgeName) error {
+	return c.circuits.AllowRequest(name)
+}
+
+// RecordResult implements CircuitBreaker.
+func (c *Cache) RecordResult(name BridgeName, err error) {
+	c.circuits.RecordResult(name, err)
 }
 
 func (c *Cache) FindBridge(ctx context.Context, name BridgeName) (BridgeType, error) {
@@ -119,6 +144,8 @@ func (c *Cache) DeleteBridgeType(ctx context.Context, bt *BridgeType) error {
 
 	// We delete regardless of the rows affected, in case it gets out of sync
 	c.bridgeTypesCache.Delete(bt.Name)
+	c.evictResponses(bt.Name)
+	c.circuits.Reset(bt.Name)
 
 	return err
 }
@@ -144,6 +171,8 @@ func (c *Cache) UpdateBridgeType(ctx context.Context, bt *BridgeType, btr *Bridg
 	}
 
 	c.bridgeTypesCache.Store(bt.Name, *bt)
+	c.evictResponses(bt.Name)
+	c.circuits.Reset(bt.Name)
 
 	return nil
 }
@@ -195,11 +224,14 @@ func (c *Cache) start(_ context.Context) error {
 		JitterPct: services.DefaultJitter,
 	}.NewTicker(c.interval)
 	c.eng.GoTick(ticker, c.doBulkUpsert)
+	c.eng.GoTick(services.NewTicker(ResponseRefreshInterval), c.refreshHotResponses)
 
 	return nil
 }
 
 func (c *Cache) doBulkUpsert(ctx context.Context) {
+	c.doBulkUpsertResponses(ctx)
+
 	c.mu.RLock()
 	values := maps.Values(c.bridgeLastValueCache)
 	c.mu.RUnlock()
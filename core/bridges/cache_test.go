@@ -2,7 +2,10 @@ package bridges_test
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -12,7 +15,9 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
 func TestBridgeCache_Type(t *testing.T) {
@@ -200,3 +205,260 @@ func TestBridgeCache_Response(t *testing.T) {
 		}
 	})
 }
+
+func TestBridgeCache_FetchResponse(t *testing.T) {
+	t.Parallel()
+
+	newCache := func(t *testing.T, upsertInterval time.Duration) (*bridges.Cache, *mocks.ORM) {
+		mORM := mocks.NewORM(t)
+		lggr, _ := logger.NewLogger()
+		return bridges.NewCache(mORM, lggr, upsertInterval), mORM
+	}
+
+	// fetcher counts the calls to the bridge and answers with the given responses
+	type fetcher struct {
+		calls atomic.Int32
+		value []byte
+		err   error
+	}
+	fetchFunc := func(f *fetcher) bridges.FetchFunc {
+		return func(context.Context) ([]byte, error) {
+			f.calls.Add(1)
+			return f.value, f.err
+		}
+	}
+
+	bridge := bridges.BridgeType{
+		Name:     "test",
+		CacheTTL: models.Interval(time.Hour),
+	}
+
+	t.Run("serves fresh responses per request body", func(t *testing.T) {
+		t.Parallel()
+
+		cache, mORM := newCache(t, time.Hour)
+		ctx := testutils.Context(t)
+		mORM.On("GetCachedBridgeResponse", mock.Anything, bridge.Name, mock.Anything, time.Hour).
+			Return(bridges.CachedBridgeResponse{}, sql.ErrNoRows).Twice()
+
+		f := &fetcher{value: []byte("a")}
+		value, status, err := cache.FetchResponse(ctx, bridge, []byte(`{"a":1}`), fetchFunc(f))
+		require.NoError(t, err)
+		assert.Equal(t, bridges.CacheStatusMiss, status)
+		assert.Equal(t, []byte("a"), value)
+
+		value, status, err = cache.FetchResponse(ctx, bridge, []byte(`{"a":1}`), fetchFunc(f))
+		require.NoError(t, err)
+		assert.Equal(t, bridges.CacheStatusHit, status)
+		assert.Equal(t, []byte("a"), value)
+		assert.Equal(t, int32(1), f.calls.Load())
+
+		_, status, err = cache.FetchResponse(ctx, bridge, []byte(`{"a":2}`), fetchFunc(f))
+		require.NoError(t, err)
+		assert.Equal(t, bridges.CacheStatusMiss, status)
+		assert.Equal(t, int32(2), f.calls.Load())
+	})
+
+	t.Run("shares the bridge call between concurrent misses", func(t *testing.T) {
+		t.Parallel()
+
+		cache, mORM := newCache(t, time.Hour)
+		ctx := testutils.Context(t)
+		mORM.On("GetCachedBridgeResponse", mock.Anything, bridge.Name, mock.Anything, time.Hour).
+			Return(bridges.CachedBridgeResponse{}, sql.ErrNoRows)
+
+		var calls atomic.Int32
+		release := make(chan struct{})
+		fetch := func(context.Context) ([]byte, error) {
+			calls.Add(1)
+			<-release
+			return []byte("a"), nil
+		}
+
+		const requests = 5
+		var wg sync.WaitGroup
+		for i := 0; i < requests; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				value, status, err := cache.FetchResponse(ctx, bridge, []byte(`{}`), fetch)
+				assert.NoError(t, err)
+				assert.Equal(t, bridges.CacheStatusMiss, status)
+				assert.Equal(t, []byte("a"), value)
+			}()
+		}
+
+		require.Eventually(t, func() bool { return calls.Load() == 1 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+		// give the other requests time to join the call in flight
+		time.Sleep(100 * time.Millisecond)
+		close(release)
+		wg.Wait()
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("keeps the shared bridge call when the first caller is cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		cache, mORM := newCache(t, time.Hour)
+		ctx := testutils.Context(t)
+		mORM.On("GetCachedBridgeResponse", mock.Anything, bridge.Name, mock.Anything, time.Hour).
+			Return(bridges.CachedBridgeResponse{}, sql.ErrNoRows)
+
+		var calls atomic.Int32
+		release := make(chan struct{})
+		fetch := func(ctx context.Context) ([]byte, error) {
+			calls.Add(1)
+			select {
+			case <-release:
+				return []byte("a"), nil
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+
+		firstCtx, cancelFirst := context.WithCancel(ctx)
+		firstErr := make(chan error)
+		go func() {
+			_, _, err := cache.FetchResponse(firstCtx, bridge, []byte(`{}`), fetch)
+			firstErr <- err
+		}()
+		require.Eventually(t, func() bool { return calls.Load() == 1 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+
+		secondValue := make(chan []byte)
+		go func() {
+			value, _, err := cache.FetchResponse(ctx, bridge, []byte(`{}`), fetch)
+			assert.NoError(t, err)
+			secondValue <- value
+		}()
+		// give the second request time to join the call in flight
+		time.Sleep(100 * time.Millisecond)
+
+		cancelFirst()
+		require.ErrorIs(t, <-firstErr, context.Canceled)
+		close(release)
+		assert.Equal(t, []byte("a"), <-secondValue)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("serves persisted responses", func(t *testing.T) {
+		t.Parallel()
+
+		cache, mORM := newCache(t, time.Hour)
+		mORM.On("GetCachedBridgeResponse", mock.Anything, bridge.Name, mock.Anything, time.Hour).
+			Return(bridges.CachedBridgeResponse{Value: []byte("persisted"), FinishedAt: time.Now().Add(-time.Minute)}, nil).Once()
+
+		f := &fetcher{value: []byte("fetched")}
+		value, status, err := cache.FetchResponse(testutils.Context(t), bridge, []byte(`{}`), fetchFunc(f))
+		require.NoError(t, err)
+		assert.Equal(t, bridges.CacheStatusHit, status)
+		assert.Equal(t, []byte("persisted"), value)
+		assert.Zero(t, f.calls.Load())
+	})
+
+	t.Run("serves stale responses while revalidating", func(t *testing.T) {
+		t.Parallel()
+
+		cache, mORM := newCache(t, time.Hour)
+		ctx := testutils.Context(t)
+		bridge := bridges.BridgeType{
+			Name:                      "test",
+			CacheTTL:                  models.Interval(time.Minute),
+			CacheStaleWhileRevalidate: models.Interval(time.Minute),
+		}
+		mORM.On("GetCachedBridgeResponse", mock.Anything, bridge.Name, mock.Anything, 2*time.Minute).
+			Return(bridges.CachedBridgeResponse{Value: []byte("old"), FinishedAt: time.Now().Add(-90 * time.Second)}, nil).Once()
+
+		f := &fetcher{value: []byte("new")}
+		value, status, err := cache.FetchResponse(ctx, bridge, []byte(`{}`), fetchFunc(f))
+		require.NoError(t, err)
+		assert.Equal(t, bridges.CacheStatusStale, status)
+		assert.Equal(t, []byte("old"), value)
+
+		require.Eventually(t, func() bool {
+			value, status, err = cache.FetchResponse(ctx, bridge, []byte(`{}`), fetchFunc(f))
+			return err == nil && status == bridges.CacheStatusHit
+		}, testutils.WaitTimeout(t), 10*time.Millisecond)
+		assert.Equal(t, []byte("new"), value)
+		assert.Equal(t, int32(1), f.calls.Load())
+	})
+
+	t.Run("serves stale responses on error", func(t *testing.T) {
+		t.Parallel()
+
+		cache, mORM := newCache(t, time.Hour)
+		ctx := testutils.Context(t)
+		bridge := bridges.BridgeType{
+			Name:              "test",
+			CacheStaleIfError: models.Interval(time.Hour),
+		}
+		mORM.On("GetCachedBridgeResponse", mock.Anything, bridge.Name, mock.Anything, time.Hour).
+			Return(bridges.CachedBridgeResponse{Value: []byte("old"), FinishedAt: time.Now().Add(-10 * time.Minute)}, nil).Once()
+
+		f := &fetcher{err: errors.New("bridge down")}
+		value, status, err := cache.FetchResponse(ctx, bridge, []byte(`{}`), fetchFunc(f))
+		require.NoError(t, err)
+		assert.Equal(t, bridges.CacheStatusStaleIfError, status)
+		assert.Equal(t, []byte("old"), value)
+
+		bridge.CacheStaleIfError = models.Interval(time.Minute)
+		_, status, err = cache.FetchResponse(ctx, bridge, []byte(`{}`), fetchFunc(f))
+		require.EqualError(t, err, "bridge down")
+		assert.Equal(t, bridges.CacheStatusMiss, status)
+		assert.Equal(t, int32(2), f.calls.Load())
+	})
+
+	t.Run("drops responses of updated bridges", func(t *testing.T) {
+		t.Parallel()
+
+		cache, mORM := newCache(t, time.Hour)
+		ctx := testutils.Context(t)
+		mORM.On("GetCachedBridgeResponse", mock.Anything, bridge.Name, mock.Anything, time.Hour).
+			Return(bridges.CachedBridgeResponse{}, sql.ErrNoRows).Twice()
+		mORM.On("UpdateBridgeType", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		f := &fetcher{value: []byte("a")}
+		_, _, err := cache.FetchResponse(ctx, bridge, []byte(`{}`), fetchFunc(f))
+		require.NoError(t, err)
+
+		bt := bridge
+		require.NoError(t, cache.UpdateBridgeType(ctx, &bt, &bridges.BridgeTypeRequest{}))
+
+		_, status, err := cache.FetchResponse(ctx, bridge, []byte(`{}`), fetchFunc(f))
+		require.NoError(t, err)
+		assert.Equal(t, bridges.CacheStatusMiss, status)
+		assert.Equal(t, int32(2), f.calls.Load())
+	})
+
+	t.Run("refreshes hot responses and persists them", func(t *testing.T) {
+		t.Parallel()
+
+		cache, mORM := newCache(t, 100*time.Millisecond)
+		ctx := testutils.Context(t)
+		bridge := bridges.BridgeType{
+			Name:     "test",
+			CacheTTL: models.Interval(time.Second),
+		}
+		mORM.On("GetCachedBridgeResponse", mock.Anything, bridge.Name, mock.Anything, time.Second).
+			Return(bridges.CachedBridgeResponse{}, sql.ErrNoRows).Once()
+		var persisted atomic.Bool
+		mORM.On("BulkUpsertCachedBridgeResponses", mock.Anything, mock.MatchedBy(func(responses []bridges.CachedBridgeResponse) bool {
+			return len(responses) == 1 && responses[0].BridgeName == bridge.Name && string(responses[0].Value) == "a"
+		})).Return(nil).Run(func(mock.Arguments) { persisted.Store(true) })
+		mORM.On("DeleteExpiredCachedBridgeResponses", mock.Anything).Return(int64(0), nil)
+
+		require.NoError(t, cache.Start(ctx))
+		t.Cleanup(func() { require.NoError(t, cache.Close()) })
+
+		f := &fetcher{value: []byte("a")}
+		_, status, err := cache.FetchResponse(ctx, bridge, []byte(`{}`), fetchFunc(f))
+		require.NoError(t, err)
+		assert.Equal(t, bridges.CacheStatusMiss, status)
+		_, status, err = cache.FetchResponse(ctx, bridge, []byte(`{}`), fetchFunc(f))
+		require.NoError(t, err)
+		assert.Equal(t, bridges.CacheStatusHit, status)
+
+		require.Eventually(t, func() bool {
+			return f.calls.Load() == 2 && persisted.Load()
+		}, testutils.WaitTimeout(t), 10*time.Millisecond)
+	})
+}
@@ -0,0 +1,231 @@
+package bridges
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+const (
+	// CircuitFailureThreshold is the number of consecutive failed calls after
+	// which the circuit of a bridge opens.
+	CircuitFailureThreshold = 5
+	// CircuitOpenTimeout is how long a circuit stays open before a probe call
+	// is let through. It doubles with every failed probe, up to
+	// CircuitMaxOpenTimeout.
+	CircuitOpenTimeout = 10 * time.Second
+	// CircuitMaxOpenTimeout bounds how long a circuit stays open.
+	CircuitMaxOpenTimeout = 5 * time.Minute
+)
+
+var promBridgeCircuitState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "bridge_circuit_state",
+	Help: "State of the circuit breaker of a bridge scoped by name (0 closed, 1 half open, 2 open)",
+},
+	[]string{"name"},
+)
+
+// CircuitState is the state of the circuit breaker of a bridge.
+type CircuitState string
+
+const (
+	// CircuitClosed lets all calls through.
+	CircuitClosed CircuitState = "closed"
+	// CircuitOpen fails calls fast until the open timeout elapses.
+	CircuitOpen CircuitState = "open"
+	// CircuitHalfOpen lets a single probe call through, which closes the
+	// circuit if it succeeds and opens it again otherwise.
+	CircuitHalfOpen CircuitState = "half_open"
+)
+
+func (s CircuitState) metric() float64 {
+	switch s {
+	case CircuitHalfOpen:
+		return 1
+	case CircuitOpen:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// ErrCircuitOpen matches, with errors.Is, the errors of calls that were not
+// made because the circuit of the bridge is open.
+var ErrCircuitOpen = errors.New("bridge circuit open")
+
+// CircuitOpenError is returned instead of calling a bridge whose circuit is
+// open.
+type CircuitOpenError struct {
+	Name    BridgeName
+	RetryAt time.Time
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit of bridge %s is open until %s", e.Name, e.RetryAt.Format(time.RFC3339))
+}
+
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// CircuitBreaker guards the calls to bridges that keep failing.
+type CircuitBreaker interface {
+	// AllowRequest returns a *CircuitOpenError if the bridge must not be
+	// called. Every allowed call must be followed by RecordResult.
+	AllowRequest(name BridgeName) error
+	// RecordResult records the outcome of a call, err is nil unless the
+	// bridge failed to answer.
+	RecordResult(name BridgeName, err error)
+}
+
+// CircuitStatus describes the circuit of a bridge.
+type CircuitStatus struct {
+	State               CircuitState
+	ConsecutiveFailures int
+	// OpenedAt and RetryAt are zero while the circuit is closed.
+	OpenedAt  time.Time
+	RetryAt   time.Time
+	LastError string
+}
+
+type circuit struct {
+	CircuitStatus
+	openTimeout time.Duration
+}
+
+// CircuitBreakers holds the circuit of every bridge that was called.
+type CircuitBreakers struct {
+	lggr logger.SugaredLogger
+
+	mu       sync.Mutex
+	circuits map[BridgeName]*circuit
+	now      func() time.Time
+}
+
+var _ CircuitBreaker = (*CircuitBreakers)(nil)
+
+func NewCircuitBreakers(lggr logger.Logger) *CircuitBreakers {
+	return &CircuitBreakers{
+		lggr:     logger.Sugared(logger.Named(lggr, "CircuitBreakers")),
+		circuits: make(map[BridgeName]*circuit),
+		now:      time.Now,
+	}
+}
+
+// AllowRequest implements CircuitBreaker. Once the open timeout of a circuit
+// elapsed, a single probe call is allowed per open timeout.
+func (cb *CircuitBreakers) AllowRequest(name BridgeName) error {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	c, ok := cb.circuits[name]
+	if !ok || c.State == CircuitClosed {
+		return nil
+	}
+
+	now := cb.now()
+	if now.Before(c.RetryAt) {
+		return &CircuitOpenError{Name: name, RetryAt: c.RetryAt}
+	}
+	c.State = CircuitHalfOpen
+	c.RetryAt = now.Add(c.openTimeout)
+	promBridgeCircuitState.WithLabelValues(name.String()).Set(c.State.metric())
+	cb.lggr.Infow("Probing bridge with open circuit", "bridge", name)
+	return nil
+}
+
+// RecordResult implements CircuitBreaker.
+func (cb *CircuitBreakers) RecordResult(name BridgeName, err error) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	c, ok := cb.circuits[name]
+	if !ok {
+		c = &circuit{CircuitStatus: CircuitStatus{State: CircuitClosed}}
+		cb.circuits[name] = c
+	}
+
+	if err == nil {
+		if c.State != CircuitClosed {
+			cb.lggr.Infow("Bridge recovered, closing circuit", "bridge", name)
+		}
+		c.CircuitStatus = CircuitStatus{State: CircuitClosed}
+		c.openTimeout = 0
+		promBridgeCircuitState.WithLabelValues(name.String()).Set(c.State.metric())
+		return
+	}
+
+	c.ConsecutiveFailures++
+	c.LastError = err.Error()
+	switch c.State {
+	case CircuitClosed:
+		if c.ConsecutiveFailures >= CircuitFailureThreshold {
+			cb.open(name, c, CircuitOpenTimeout)
+		}
+	case CircuitHalfOpen:
+		cb.open(name, c, min(2*c.openTimeout, CircuitMaxOpenTimeout))
+	case CircuitOpen:
+		// a call made before the circuit opened
+	}
+}
+
+func (cb *CircuitBreakers) open(name BridgeName, c *circuit, timeout time.Duration) {
+	now := cb.now()
+	c.State = CircuitOpen
+	c.OpenedAt = now
+	c.RetryAt = now.Add(timeout)
+	c.openTimeout = timeout
+	promBridgeCircuitState.WithLabelValues(name.String()).Set(c.State.metric())
+	cb.lggr.Warnw("Bridge keeps failing, opening circuit", "bridge", name,
+		"consecutiveFailures", c.ConsecutiveFailures, "retryAt", c.RetryAt, "err", c.LastError)
+}
+
+// Status returns the status of the circuit of a bridge, which is closed for
+// bridges that were not called yet or a nil CircuitBreakers.
+func (cb *CircuitBreakers) Status(name BridgeName) CircuitStatus {
+	if cb == nil {
+		return CircuitStatus{State: CircuitClosed}
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if c, ok := cb.circuits[name]; ok {
+		return c.CircuitStatus
+	}
+	return CircuitStatus{State: CircuitClosed}
+}
+
+// Statuses returns the status of the circuits of all bridges that were called.
+func (cb *CircuitBreakers) Statuses() map[BridgeName]CircuitStatus {
+	statuses := make(map[BridgeName]CircuitStatus)
+	if cb == nil {
+		return statuses
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	for name, c := range cb.circuits {
+		statuses[name] = c.CircuitStatus
+	}
+	return statuses
+}
+
+// Reset closes the circuit of a bridge, for example after it was updated.
+func (cb *CircuitBreakers) Reset(name BridgeName) {
+	if cb == nil {
+		return
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if _, ok := cb.circuits[name]; ok {
+		delete(cb.circuits, name)
+		promBridgeCircuitState.DeleteLabelValues(name.String())
+	}
+}
@@ -0,0 +1,104 @@
+package bridges
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+func TestCircuitBreakers(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1700000000, 0)
+	cb := NewCircuitBreakers(logger.Test(t))
+	cb.now = func() time.Time { return now }
+
+	const name BridgeName = "bridge"
+	errBridge := errors.New("bridge down")
+
+	assert.Equal(t, CircuitStatus{State: CircuitClosed}, cb.Status(name))
+
+	for i := 0; i < CircuitFailureThreshold-1; i++ {
+		require.NoError(t, cb.AllowRequest(name))
+		cb.RecordResult(name, errBridge)
+	}
+	assert.Equal(t, CircuitClosed, cb.Status(name).State)
+	assert.Equal(t, CircuitFailureThreshold-1, cb.Status(name).ConsecutiveFailures)
+
+	t.Run("successes reset the failure count", func(t *testing.T) {
+		cb.RecordResult(name, nil)
+		assert.Equal(t, CircuitStatus{State: CircuitClosed}, cb.Status(name))
+	})
+
+	for i := 0; i < CircuitFailureThreshold; i++ {
+		require.NoError(t, cb.AllowRequest(name))
+		cb.RecordResult(name, errBridge)
+	}
+	status := cb.Status(name)
+	require.Equal(t, CircuitOpen, status.State)
+	assert.Equal(t, now, status.OpenedAt)
+	assert.Equal(t, now.Add(CircuitOpenTimeout), status.RetryAt)
+	assert.Equal(t, "bridge down", status.LastError)
+
+	t.Run("open circuits fail fast", func(t *testing.T) {
+		err := cb.AllowRequest(name)
+		require.ErrorIs(t, err, ErrCircuitOpen)
+		var openErr *CircuitOpenError
+		require.ErrorAs(t, err, &openErr)
+		assert.Equal(t, name, openErr.Name)
+		assert.Equal(t, now.Add(CircuitOpenTimeout), openErr.RetryAt)
+
+		require.NoError(t, cb.AllowRequest("other"), "circuits are per bridge")
+	})
+
+	t.Run("failed probes double the open timeout", func(t *testing.T) {
+		now = now.Add(CircuitOpenTimeout)
+		require.NoError(t, cb.AllowRequest(name))
+		assert.Equal(t, CircuitHalfOpen, cb.Status(name).State)
+		require.ErrorIs(t, cb.AllowRequest(name), ErrCircuitOpen, "only one probe is let through")
+
+		cb.RecordResult(name, errBridge)
+		status := cb.Status(name)
+		assert.Equal(t, CircuitOpen, status.State)
+		assert.Equal(t, now.Add(2*CircuitOpenTimeout), status.RetryAt)
+	})
+
+	t.Run("successful probes close the circuit", func(t *testing.T) {
+		now = now.Add(2 * CircuitOpenTimeout)
+		require.NoError(t, cb.AllowRequest(name))
+		cb.RecordResult(name, nil)
+		assert.Equal(t, CircuitStatus{State: CircuitClosed}, cb.Status(name))
+		require.NoError(t, cb.AllowRequest(name))
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		for i := 0; i < CircuitFailureThreshold; i++ {
+			cb.RecordResult(name, errBridge)
+		}
+		require.Contains(t, cb.Statuses(), name)
+		cb.Reset(name)
+		assert.NotContains(t, cb.Statuses(), name)
+		require.NoError(t, cb.AllowRequest(name))
+	})
+}
+
+func TestCache_HealthReport(t *testing.T) {
+	t.Parallel()
+
+	c := NewCache(nil, logger.Test(t), DefaultUpsertInterval)
+	c.RecordResult("healthy", nil)
+	for i := 0; i < CircuitFailureThreshold; i++ {
+		c.RecordResult("failing", errors.New("bridge down"))
+	}
+
+	// An open circuit only degrades its bridge, which is reported by its metric, so the health of the node is unaffected
+	report := c.HealthReport()
+	assert.Len(t, report, 1)
+	assert.Contains(t, report, CacheServiceName)
+	assert.Equal(t, CircuitOpen, c.Circuits().Status("failing").State)
+}
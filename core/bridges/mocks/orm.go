@@ -142,6 +142,53 @@ func (_c *ORM_BulkUpsertBridgeResponse_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// BulkUpsertCachedBridgeResponses provides a mock function with given fields: ctx, responses
+func (_m *ORM) BulkUpsertCachedBridgeResponses(ctx context.Context, responses []bridges.CachedBridgeResponse) error {
+	ret := _m.Called(ctx, responses)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkUpsertCachedBridgeResponses")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []bridges.CachedBridgeResponse) error); ok {
+		r0 = rf(ctx, responses)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_BulkUpsertCachedBridgeResponses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkUpsertCachedBridgeResponses'
+type ORM_BulkUpsertCachedBridgeResponses_Call struct {
+	*mock.Call
+}
+
+// BulkUpsertCachedBridgeResponses is a helper method to define mock.On call
+//   - ctx context.Context
+//   - responses []bridges.CachedBridgeResponse
+func (_e *ORM_Expecter) BulkUpsertCachedBridgeResponses(ctx interface{}, responses interface{}) *ORM_BulkUpsertCachedBridgeResponses_Call {
+	return &ORM_BulkUpsertCachedBridgeResponses_Call{Call: _e.mock.On("BulkUpsertCachedBridgeResponses", ctx, responses)}
+}
+
+func (_c *ORM_BulkUpsertCachedBridgeResponses_Call) Run(run func(ctx context.Context, responses []bridges.CachedBridgeResponse)) *ORM_BulkUpsertCachedBridgeResponses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]bridges.CachedBridgeResponse))
+	})
+	return _c
+}
+
+func (_c *ORM_BulkUpsertCachedBridgeResponses_Call) Return(_a0 error) *ORM_BulkUpsertCachedBridgeResponses_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_BulkUpsertCachedBridgeResponses_Call) RunAndReturn(run func(context.Context, []bridges.CachedBridgeResponse) error) *ORM_BulkUpsertCachedBridgeResponses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateBridgeType provides a mock function with given fields: ctx, bt
 func (_m *ORM) CreateBridgeType(ctx context.Context, bt *bridges.BridgeType) error {
 	ret := _m.Called(ctx, bt)
@@ -283,6 +330,62 @@ func (_c *ORM_DeleteBridgeType_Call) RunAndReturn(run func(context.Context, *bri
 	return _c
 }
 
+// DeleteExpiredCachedBridgeResponses provides a mock function with given fields: ctx
+func (_m *ORM) DeleteExpiredCachedBridgeResponses(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredCachedBridgeResponses")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_DeleteExpiredCachedBridgeResponses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredCachedBridgeResponses'
+type ORM_DeleteExpiredCachedBridgeResponses_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredCachedBridgeResponses is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ORM_Expecter) DeleteExpiredCachedBridgeResponses(ctx interface{}) *ORM_DeleteExpiredCachedBridgeResponses_Call {
+	return &ORM_DeleteExpiredCachedBridgeResponses_Call{Call: _e.mock.On("DeleteExpiredCachedBridgeResponses", ctx)}
+}
+
+func (_c *ORM_DeleteExpiredCachedBridgeResponses_Call) Run(run func(ctx context.Context)) *ORM_DeleteExpiredCachedBridgeResponses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ORM_DeleteExpiredCachedBridgeResponses_Call) Return(_a0 int64, _a1 error) *ORM_DeleteExpiredCachedBridgeResponses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_DeleteExpiredCachedBridgeResponses_Call) RunAndReturn(run func(context.Context) (int64, error)) *ORM_DeleteExpiredCachedBridgeResponses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteExternalInitiator provides a mock function with given fields: ctx, name
 func (_m *ORM) DeleteExternalInitiator(ctx context.Context, name string) error {
 	ret := _m.Called(ctx, name)
@@ -629,6 +732,65 @@ func (_c *ORM_FindExternalInitiatorByName_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// GetCachedBridgeResponse provides a mock function with given fields: ctx, name, requestHash, maxElapsed
+func (_m *ORM) GetCachedBridgeResponse(ctx context.Context, name bridges.BridgeName, requestHash []byte, maxElapsed time.Duration) (bridges.CachedBridgeResponse, error) {
+	ret := _m.Called(ctx, name, requestHash, maxElapsed)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCachedBridgeResponse")
+	}
+
+	var r0 bridges.CachedBridgeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, bridges.BridgeName, []byte, time.Duration) (bridges.CachedBridgeResponse, error)); ok {
+		return rf(ctx, name, requestHash, maxElapsed)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bridges.BridgeName, []byte, time.Duration) bridges.CachedBridgeResponse); ok {
+		r0 = rf(ctx, name, requestHash, maxElapsed)
+	} else {
+		r0 = ret.Get(0).(bridges.CachedBridgeResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bridges.BridgeName, []byte, time.Duration) error); ok {
+		r1 = rf(ctx, name, requestHash, maxElapsed)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_GetCachedBridgeResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCachedBridgeResponse'
+type ORM_GetCachedBridgeResponse_Call struct {
+	*mock.Call
+}
+
+// GetCachedBridgeResponse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name bridges.BridgeName
+//   - requestHash []byte
+//   - maxElapsed time.Duration
+func (_e *ORM_Expecter) GetCachedBridgeResponse(ctx interface{}, name interface{}, requestHash interface{}, maxElapsed interface{}) *ORM_GetCachedBridgeResponse_Call {
+	return &ORM_GetCachedBridgeResponse_Call{Call: _e.mock.On("GetCachedBridgeResponse", ctx, name, requestHash, maxElapsed)}
+}
+
+func (_c *ORM_GetCachedBridgeResponse_Call) Run(run func(ctx context.Context, name bridges.BridgeName, requestHash []byte, maxElapsed time.Duration)) *ORM_GetCachedBridgeResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bridges.BridgeName), args[2].([]byte), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *ORM_GetCachedBridgeResponse_Call) Return(_a0 bridges.CachedBridgeResponse, _a1 error) *ORM_GetCachedBridgeResponse_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_GetCachedBridgeResponse_Call) RunAndReturn(run func(context.Context, bridges.BridgeName, []byte, time.Duration) (bridges.CachedBridgeResponse, error)) *ORM_GetCachedBridgeResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCachedResponse provides a mock function with given fields: ctx, dotId, specId, maxElapsed
 func (_m *ORM) GetCachedResponse(ctx context.Context, dotId string, specId int32, maxElapsed time.Duration) ([]byte, error) {
 	ret := _m.Called(ctx, dotId, specId, maxElapsed)
@@ -33,32 +33,45 @@ type ORM interface {
 	GetCachedResponseWithFinished(ctx context.Context, dotId string, specId int32, maxElapsed time.Duration) ([]byte, time.Time, error)
 	BulkUpsertBridgeResponse(ctx context.Context, responses []BridgeResponse) error
 
+	GetCachedBridgeResponse(ctx context.Context, name BridgeName, requestHash []byte, maxElapsed time.Duration) (CachedBridgeResponse, error)
+	BulkUpsertCachedBridgeResponses(ctx context.Context, responses []CachedBridgeResponse) error
+	DeleteExpiredCachedBridgeResponses(ctx context.Context) (int64, error)
+
 	WithDataSource(sqlutil.DataSource) ORM
 }
 
 type orm struct {
-	ds sqlutil.DataSource
+	ds      sqlutil.DataSource
+	secrets *SecretsCipher
 }
 
 var _ ORM = (*orm)(nil)
 
+// NewORM returns an ORM that can't store or read the auth secrets of bridges.
 func NewORM(ds sqlutil.DataSource) ORM {
 	return &orm{ds: ds}
 }
 
-func (o *orm) WithDataSource(ds sqlutil.DataSource) ORM { return NewORM(ds) }
+// NewORMWithSecrets returns an ORM that encrypts the auth secrets of bridges at rest with secrets.
+func NewORMWithSecrets(ds sqlutil.DataSource, secrets *SecretsCipher) ORM {
+	return &orm{ds: ds, secrets: secrets}
+}
+
+func (o *orm) WithDataSource(ds sqlutil.DataSource) ORM { return NewORMWithSecrets(ds, o.secrets) }
 
 func (o *orm) transact(ctx context.Context, readOnly bool, fn func(tx *orm) error) error {
 	opts := sqlutil.TxOptions{TxOptions: sql.TxOptions{ReadOnly: readOnly}}
-	return sqlutil.Transact(ctx, func(ds sqlutil.DataSource) *orm { return &orm{ds: ds} }, o.ds, &opts, fn)
+	return sqlutil.Transact(ctx, func(ds sqlutil.DataSource) *orm { return &orm{ds: ds, secrets: o.secrets} }, o.ds, &opts, fn)
 }
 
 // FindBridge looks up a Bridge by its Name.
 // Returns sql.ErrNoRows if name not present
 func (o *orm) FindBridge(ctx context.Context, name BridgeName) (bt BridgeType, err error) {
 	stmt := "SELECT * FROM bridge_types WHERE name = $1"
-	err = o.ds.GetContext(ctx, &bt, stmt, name.String())
-
+	if err = o.ds.GetContext(ctx, &bt, stmt, name.String()); err != nil {
+		return
+	}
+	err = o.secrets.decryptSecrets(&bt)
 	return
 }
 
@@ -82,6 +95,11 @@ func (o *orm) FindBridges(ctx context.Context, names []BridgeName) ([]BridgeType
 		return nil, pkgerrors.Errorf("not all bridges exist, asked for %v, exists %v", names, bts)
 	}
 
+	for i := range bts {
+		if err = o.secrets.decryptSecrets(&bts[i]); err != nil {
+			return nil, err
+		}
+	}
 	return bts, nil
 }
 
@@ -116,6 +134,11 @@ func (o *orm) BridgeTypes(ctx context.Context, offset int, limit int) (bridges [
 		if err = tx.ds.SelectContext(ctx, &bridges, sql, limit, offset); err != nil {
 			return pkgerrors.Wrap(err, "BridgeTypes failed to load bridge_types")
 		}
+		for i := range bridges {
+			if err = tx.secrets.decryptSecrets(&bridges[i]); err != nil {
+				return err
+			}
+		}
 		return nil
 	})
 
@@ -124,27 +147,56 @@ func (o *orm) BridgeTypes(ctx context.Context, offset int, limit int) (bridges [
 
 // CreateBridgeType saves the bridge type.
 func (o *orm) CreateBridgeType(ctx context.Context, bt *BridgeType) error {
-	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, created_at, updated_at)
-	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, now(), now())
+	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, cache_ttl, cache_stale_while_revalidate, cache_stale_if_error,
+		auth_mode, hmac_secret, tls_client_cert, tls_client_key, tls_root_ca, verify_responses, created_at, updated_at)
+	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, :cache_ttl, :cache_stale_while_revalidate, :cache_stale_if_error,
+		:auth_mode, :hmac_secret, :tls_client_cert, :tls_client_key, :tls_root_ca, :verify_responses, now(), now())
 	RETURNING *;`
+	bt.AuthMode = bt.AuthMode.OrDefault()
+	row := *bt
+	if err := o.secrets.encryptSecrets(&row.HMACSecret, &row.TLSClientKey); err != nil {
+		return pkgerrors.Wrap(err, "CreateBridgeType failed")
+	}
 	err := o.transact(ctx, false, func(tx *orm) error {
 		stmt, err := tx.ds.PrepareNamedContext(ctx, stmt)
 		if err != nil {
 			return err
 		}
 		defer stmt.Close()
-		return stmt.GetContext(ctx, bt, bt)
+		if err = stmt.GetContext(ctx, bt, row); err != nil {
+			return err
+		}
+		return tx.secrets.decryptSecrets(bt)
 	})
 
 	return pkgerrors.Wrap(err, "CreateBridgeType failed")
 }
 
-// UpdateBridgeType updates the bridge type.
+// UpdateBridgeType updates the bridge type. Cached responses of the bridge
+// are dropped, since they may come from a different URL or policy.
 func (o *orm) UpdateBridgeType(ctx context.Context, bt *BridgeType, btr *BridgeTypeRequest) error {
-	stmt := "UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3 WHERE name = $4 RETURNING *"
-	err := o.ds.GetContext(ctx, bt, stmt, btr.URL, btr.Confirmations, btr.MinimumContractPayment, bt.Name)
+	hmacSecret, tlsClientKey := btr.HMACSecret, btr.TLSClientKey
+	if err := o.secrets.encryptSecrets(&hmacSecret, &tlsClientKey); err != nil {
+		return pkgerrors.Wrap(err, "UpdateBridgeType failed")
+	}
+	return o.transact(ctx, false, func(tx *orm) error {
+		stmt := `UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3,
+			cache_ttl = $4, cache_stale_while_revalidate = $5, cache_stale_if_error = $6,
+			auth_mode = $7, hmac_secret = $8, tls_client_cert = $9, tls_client_key = $10, tls_root_ca = $11, verify_responses = $12
+		WHERE name = $13 RETURNING *`
+		if err := tx.ds.GetContext(ctx, bt, stmt, btr.URL, btr.Confirmations, btr.MinimumContractPayment,
+			btr.CacheTTL, btr.CacheStaleWhileRevalidate, btr.CacheStaleIfError,
+			btr.AuthMode.OrDefault(), hmacSecret, btr.TLSClientCert, tlsClientKey, btr.TLSRootCA, btr.verifyResponses(),
+			bt.Name); err != nil {
+			return err
+		}
+		if err := tx.secrets.decryptSecrets(bt); err != nil {
+			return err
+		}
 
-	return err
+		_, err := tx.ds.ExecContext(ctx, `DELETE FROM bridge_response_cache WHERE bridge_name = $1`, bt.Name)
+		return pkgerrors.Wrap(err, "failed to drop cached responses")
+	})
 }
 
 func (o *orm) GetCachedResponse(ctx context.Context, dotId string, specId int32, maxElapsed time.Duration) ([]byte, error) {
@@ -206,6 +258,43 @@ func (o *orm) BulkUpsertBridgeResponse(ctx context.Context, responses []BridgeRe
 	return nil
 }
 
+// GetCachedBridgeResponse returns the response of the bridge to the request
+// with the given hash, if it finished within maxElapsed.
+func (o *orm) GetCachedBridgeResponse(ctx context.Context, name BridgeName, requestHash []byte, maxElapsed time.Duration) (CachedBridgeResponse, error) {
+	var response CachedBridgeResponse
+	stmt := `SELECT * FROM bridge_response_cache WHERE bridge_name = $1 AND request_hash = $2 AND finished_at > $3`
+	err := o.ds.GetContext(ctx, &response, stmt, name, requestHash, time.Now().Add(-maxElapsed))
+
+	return response, pkgerrors.Wrapf(err, "failed to fetch cached response of bridge %s", name)
+}
+
+func (o *orm) BulkUpsertCachedBridgeResponses(ctx context.Context, responses []CachedBridgeResponse) error {
+	sql := `INSERT INTO bridge_response_cache(bridge_name, request_hash, value, finished_at)
+			VALUES (:bridge_name, :request_hash, :value, :finished_at)
+			ON CONFLICT ON CONSTRAINT bridge_response_cache_pkey
+				DO UPDATE SET value = excluded.value, finished_at = excluded.finished_at
+				WHERE bridge_response_cache.finished_at < excluded.finished_at;`
+
+	_, err := o.ds.NamedExecContext(ctx, sql, responses)
+
+	return err
+}
+
+// DeleteExpiredCachedBridgeResponses deletes the cached responses that the
+// cache policy of their bridge no longer allows to serve.
+func (o *orm) DeleteExpiredCachedBridgeResponses(ctx context.Context) (int64, error) {
+	sql := `DELETE FROM bridge_response_cache c USING bridge_types b
+			WHERE c.bridge_name = b.name
+			AND c.finished_at < now() - GREATEST(b.cache_ttl + b.cache_stale_while_revalidate, b.cache_stale_if_error) / 1000 * interval '1 microsecond';`
+
+	result, err := o.ds.ExecContext(ctx, sql)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
 // --- External Initiator
 
 // ExternalInitiators returns a list of external initiators sorted by name
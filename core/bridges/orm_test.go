@@ -1,6 +1,7 @@
 package bridges_test
 
 import (
+	"database/sql"
 	"testing"
 	"time"
 
@@ -159,6 +160,48 @@ func TestORM_TestCachedResponse(t *testing.T) {
 	require.Equal(t, []byte{111, 222, 2}, val)
 }
 
+func TestORM_CachedBridgeResponses(t *testing.T) {
+	ctx := testutils.Context(t)
+	_, orm := setupORM(t)
+
+	bt := &bridges.BridgeType{
+		Name:              "cached",
+		URL:               cltest.WebURL(t, "http://bridge.example.com"),
+		CacheTTL:          models.Interval(time.Minute),
+		CacheStaleIfError: models.Interval(time.Hour),
+	}
+	require.NoError(t, orm.CreateBridgeType(ctx, bt))
+
+	found, err := orm.FindBridge(ctx, bt.Name)
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, found.CachePolicy().TTL)
+	assert.Equal(t, time.Hour, found.CachePolicy().StaleIfError)
+
+	hash := []byte{1, 2, 3}
+	_, err = orm.GetCachedBridgeResponse(ctx, bt.Name, hash, time.Hour)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	require.NoError(t, orm.BulkUpsertCachedBridgeResponses(ctx, []bridges.CachedBridgeResponse{
+		{BridgeName: bt.Name, RequestHash: hash, Value: []byte("fresh"), FinishedAt: time.Now()},
+		{BridgeName: bt.Name, RequestHash: []byte{4}, Value: []byte("expired"), FinishedAt: time.Now().Add(-2 * time.Hour)},
+	}))
+
+	cached, err := orm.GetCachedBridgeResponse(ctx, bt.Name, hash, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("fresh"), cached.Value)
+
+	deleted, err := orm.DeleteExpiredCachedBridgeResponses(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	// updating the bridge drops its cached responses
+	require.NoError(t, orm.UpdateBridgeType(ctx, bt, &bridges.BridgeTypeRequest{URL: bt.URL, CacheTTL: models.Interval(time.Second)}))
+	assert.Equal(t, time.Second, bt.CachePolicy().TTL)
+	assert.Zero(t, bt.CachePolicy().StaleIfError)
+	_, err = orm.GetCachedBridgeResponse(ctx, bt.Name, hash, time.Hour)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
 func TestORM_CreateExternalInitiator(t *testing.T) {
 	ctx := testutils.Context(t)
 	_, orm := setupORM(t)
@@ -0,0 +1,363 @@
+package bridges
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	// ResponseRefreshInterval is how often hot responses are checked for a
+	// background refresh.
+	ResponseRefreshInterval = time.Second
+	// refreshAheadFraction is the fraction of the TTL before its end at which a
+	// hot response is refreshed, so that it is replaced before it goes stale.
+	refreshAheadFraction = 0.2
+)
+
+var (
+	promBridgeResponseCacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_response_cache_hits_total",
+		Help: "Fresh responses served from the bridge response cache scoped by name",
+	},
+		[]string{"name"},
+	)
+	promBridgeResponseCacheMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_response_cache_misses_total",
+		Help: "Requests the bridge response cache had to pass on to the bridge scoped by name",
+	},
+		[]string{"name"},
+	)
+	promBridgeResponseCacheStale = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bridge_response_cache_stale_total",
+		Help: "Stale responses served from the bridge response cache scoped by name and reason (revalidate or error)",
+	},
+		[]string{"name", "reason"},
+	)
+)
+
+// CacheStatus describes where a response returned by a ResponseCache came from.
+type CacheStatus string
+
+const (
+	// CacheStatusMiss means the response was fetched from the bridge.
+	CacheStatusMiss CacheStatus = "miss"
+	// CacheStatusHit means a fresh cached response was served.
+	CacheStatusHit CacheStatus = "hit"
+	// CacheStatusStale means a cached response past its TTL was served while
+	// it is refreshed in the background.
+	CacheStatusStale CacheStatus = "stale"
+	// CacheStatusStaleIfError means a cached response was served because the
+	// bridge call failed.
+	CacheStatusStaleIfError CacheStatus = "stale_if_error"
+)
+
+// FetchFunc calls a bridge, returning the response body. It may be called
+// after the request that created it completed, to refresh the response.
+type FetchFunc func(ctx context.Context) ([]byte, error)
+
+// ResponseCache serves bridge responses according to the CachePolicy of the
+// bridge.
+type ResponseCache interface {
+	// FetchResponse returns the response of the bridge to request, calling fetch
+	// when there is no cached response that the policy allows to serve.
+	FetchResponse(ctx context.Context, bt BridgeType, request []byte, fetch FetchFunc) ([]byte, CacheStatus, error)
+}
+
+var _ ResponseCache = (*Cache)(nil)
+
+type requestKey struct {
+	name BridgeName
+	hash [sha256.Size]byte
+}
+
+func newRequestKey(name BridgeName, request []byte) requestKey {
+	return requestKey{name: name, hash: sha256.Sum256(request)}
+}
+
+func (k requestKey) String() string {
+	return k.name.String() + "/" + hex.EncodeToString(k.hash[:])
+}
+
+type cachedResponse struct {
+	value      []byte
+	finishedAt time.Time
+
+	policy        CachePolicy
+	fetch         FetchFunc
+	lastRequested time.Time
+	refreshing    bool
+	persisted     bool
+}
+
+func (r *cachedResponse) age(now time.Time) time.Duration {
+	return now.Sub(r.finishedAt)
+}
+
+// refreshAfter is the age after which a hot response is refreshed. The
+// refresh ahead window spans at least two refresh intervals, so that a tick
+// falls within it.
+func (p CachePolicy) refreshAfter() time.Duration {
+	return p.TTL - max(time.Duration(float64(p.TTL)*refreshAheadFraction), 2*ResponseRefreshInterval)
+}
+
+// isHot reports whether the response was requested since it was fetched.
+func (r *cachedResponse) isHot() bool {
+	return r.policy.TTL > 0 && !r.finishedAt.IsZero() && r.lastRequested.After(r.finishedAt)
+}
+
+// FetchResponse implements ResponseCache. Responses are kept in memory and
+// persisted, so that they survive restarts. Responses that are requested
+// again after they were fetched are refreshed in the background shortly
+// before they go stale.
+func (c *Cache) FetchResponse(ctx context.Context, bt BridgeType, request []byte, fetch FetchFunc) ([]byte, CacheStatus, error) {
+	policy := bt.CachePolicy()
+	name := bt.Name.String()
+	key := newRequestKey(bt.Name, request)
+	now := time.Now()
+
+	cached, inCache := c.requestResponse(ctx, key, policy, fetch, now)
+	if inCache {
+		switch age := cached.age(now); {
+		case age < policy.TTL:
+			promBridgeResponseCacheHits.WithLabelValues(name).Inc()
+			return cached.value, CacheStatusHit, nil
+		case age < policy.TTL+policy.StaleWhileRevalidate:
+			promBridgeResponseCacheStale.WithLabelValues(name, "revalidate").Inc()
+			c.refreshResponse(key)
+			return cached.value, CacheStatusStale, nil
+		}
+	}
+
+	promBridgeResponseCacheMisses.WithLabelValues(name).Inc()
+	value, err := c.fetchResponse(ctx, key, fetch)
+	if err != nil {
+		if inCache && cached.age(now) < policy.StaleIfError {
+			promBridgeResponseCacheStale.WithLabelValues(name, "error").Inc()
+			return cached.value, CacheStatusStaleIfError, nil
+		}
+		return nil, CacheStatusMiss, err
+	}
+
+	return value, CacheStatusMiss, nil
+}
+
+// fetchResponse calls fetch and stores the response for key. Concurrent misses
+// for the same key share a single bridge call. The call is detached from the
+// context of the first caller, so that its cancellation does not fail the
+// others, but keeps its deadline as timeout. Every caller stops waiting when
+// its own context is done.
+func (c *Cache) fetchResponse(ctx context.Context, key requestKey, fetch FetchFunc) ([]byte, error) {
+	ch := c.fetches.DoChan(key.String(), func() (interface{}, error) {
+		fetchCtx, cancel := c.eng.Ctx(context.WithoutCancel(ctx))
+		defer cancel()
+		if deadline, ok := ctx.Deadline(); ok {
+			var cancelTimeout context.CancelFunc
+			fetchCtx, cancelTimeout = context.WithTimeout(fetchCtx, time.Until(deadline))
+			defer cancelTimeout()
+		}
+
+		value, err := fetch(fetchCtx)
+		if err != nil {
+			return nil, err
+		}
+		c.storeResponse(key, value, time.Now())
+		return value, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.([]byte), nil
+	}
+}
+
+// requestResponse returns a copy of the cached response for key, loading it
+// from the ORM if it is not in memory, and records the request.
+func (c *Cache) requestResponse(ctx context.Context, key requestKey, policy CachePolicy, fetch FetchFunc, now time.Time) (cachedResponse, bool) {
+	c.responsesMu.Lock()
+	cached, inCache := c.responses[key]
+	if inCache {
+		cached.policy = policy
+		cached.fetch = fetch
+		cached.lastRequested = now
+		resp := *cached
+		c.responsesMu.Unlock()
+		return resp, true
+	}
+	c.responsesMu.Unlock()
+
+	persisted, err := c.ORM.GetCachedBridgeResponse(ctx, key.name, key.hash[:], policy.Retention())
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			c.eng.Warnw("failed to load cached bridge response", "bridge", key.name, "err", err)
+		}
+		c.responsesMu.Lock()
+		defer c.responsesMu.Unlock()
+		if cached, inCache = c.responses[key]; inCache {
+			return *cached, true
+		}
+		// track the request, so that the fetched response can be stored
+		c.responses[key] = &cachedResponse{policy: policy, fetch: fetch, lastRequested: now}
+		return cachedResponse{}, false
+	}
+
+	resp := &cachedResponse{
+		value:         persisted.Value,
+		finishedAt:    persisted.FinishedAt,
+		policy:        policy,
+		fetch:         fetch,
+		lastRequested: now,
+		persisted:     true,
+	}
+
+	c.responsesMu.Lock()
+	defer c.responsesMu.Unlock()
+	// a concurrent fetch may have stored a newer response in the meantime
+	if cached, inCache = c.responses[key]; inCache && cached.finishedAt.After(resp.finishedAt) {
+		return *cached, true
+	}
+	c.responses[key] = resp
+
+	return *resp, true
+}
+
+func (c *Cache) storeResponse(key requestKey, value []byte, finishedAt time.Time) {
+	c.responsesMu.Lock()
+	defer c.responsesMu.Unlock()
+
+	cached, inCache := c.responses[key]
+	if !inCache {
+		// the bridge was updated or deleted while the request was in flight
+		return
+	}
+	if cached.finishedAt.After(finishedAt) {
+		return
+	}
+
+	cached.value = value
+	cached.finishedAt = finishedAt
+	cached.persisted = false
+}
+
+// refreshResponse fetches the response for key in the background, unless a
+// refresh is already in flight.
+func (c *Cache) refreshResponse(key requestKey) {
+	c.responsesMu.Lock()
+	cached, inCache := c.responses[key]
+	if !inCache || cached.refreshing || cached.fetch == nil {
+		c.responsesMu.Unlock()
+		return
+	}
+	cached.refreshing = true
+	fetch := cached.fetch
+	c.responsesMu.Unlock()
+
+	c.eng.Go(func(ctx context.Context) {
+		value, err := fetch(ctx)
+		if err != nil {
+			c.eng.Debugw("background refresh of bridge response failed", "bridge", key.name, "err", err)
+		} else {
+			c.storeResponse(key, value, time.Now())
+		}
+
+		c.responsesMu.Lock()
+		defer c.responsesMu.Unlock()
+		if cached, inCache := c.responses[key]; inCache {
+			cached.refreshing = false
+		}
+	})
+}
+
+// refreshHotResponses pre-warms the responses that were requested since they
+// were fetched and are about to go stale, and evicts the responses that can
+// no longer be served and were not requested within their retention.
+func (c *Cache) refreshHotResponses(_ context.Context) {
+	now := time.Now()
+
+	var hot []requestKey
+	c.responsesMu.Lock()
+	for key, cached := range c.responses {
+		retention := cached.policy.Retention()
+		requestedRecently := now.Sub(cached.lastRequested) < retention
+		if cached.isHot() && requestedRecently && cached.age(now) >= cached.policy.refreshAfter() {
+			hot = append(hot, key)
+			continue
+		}
+		if !requestedRecently && !cached.refreshing && cached.age(now) >= retention {
+			delete(c.responses, key)
+		}
+	}
+	c.responsesMu.Unlock()
+
+	for _, key := range hot {
+		c.refreshResponse(key)
+	}
+}
+
+// doBulkUpsertResponses persists the responses fetched since the last call and
+// prunes the persisted responses that can no longer be served.
+func (c *Cache) doBulkUpsertResponses(ctx context.Context) {
+	var responses []CachedBridgeResponse
+	c.responsesMu.RLock()
+	total := len(c.responses)
+	for key, cached := range c.responses {
+		if cached.persisted || cached.finishedAt.IsZero() {
+			continue
+		}
+		responses = append(responses, CachedBridgeResponse{
+			BridgeName:  key.name,
+			RequestHash: key.hash[:],
+			Value:       cached.value,
+			FinishedAt:  cached.finishedAt,
+		})
+	}
+	c.responsesMu.RUnlock()
+
+	if total == 0 {
+		return
+	}
+
+	if len(responses) > 0 {
+		if err := c.ORM.BulkUpsertCachedBridgeResponses(ctx, responses); err != nil {
+			c.eng.Warnf("bulk upsert of cached bridge responses failed: %s", err.Error())
+			return
+		}
+
+		c.responsesMu.Lock()
+		for _, resp := range responses {
+			key := requestKey{name: resp.BridgeName}
+			copy(key.hash[:], resp.RequestHash)
+			if cached, inCache := c.responses[key]; inCache && cached.finishedAt.Equal(resp.FinishedAt) {
+				cached.persisted = true
+			}
+		}
+		c.responsesMu.Unlock()
+	}
+
+	if _, err := c.ORM.DeleteExpiredCachedBridgeResponses(ctx); err != nil {
+		c.eng.Warnf("failed to delete expired cached bridge responses: %s", err.Error())
+	}
+}
+
+// evictResponses drops the cached responses of a bridge from memory.
+func (c *Cache) evictResponses(name BridgeName) {
+	c.responsesMu.Lock()
+	defer c.responsesMu.Unlock()
+
+	for key := range c.responses {
+		if key.name == name {
+			delete(c.responses, key)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package bridges
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const (
+	// encryptedSecretPrefix marks the secrets of bridge_types that are encrypted by a SecretsCipher.
+	encryptedSecretPrefix = "encrypted:v1:"
+	// secretsScryptR is the scrypt block size, like the one the keystore uses.
+	secretsScryptR = 8
+)
+
+// secretsSalt binds the key derived from the keystore password to the secrets of bridges, so that it differs from the
+// keys encrypting the key ring.
+var secretsSalt = []byte("chainlink/bridge_types/secrets")
+
+var ErrMissingSecretsCipher = errors.New("bridge secrets can't be stored or read without a secrets cipher")
+
+// SecretsCipher encrypts the auth secrets of bridges at rest, with an AES-GCM key derived from the keystore password
+// once, so that the node can read them for every request without running scrypt again.
+type SecretsCipher struct {
+	aead cipher.AEAD
+}
+
+// NewSecretsCipher derives the key encrypting the secrets of bridges from password, the keystore password of the node.
+func NewSecretsCipher(password string, scryptParams utils.ScryptParams) (*SecretsCipher, error) {
+	if password == "" {
+		return nil, errors.New("keystore password is required to encrypt bridge secrets")
+	}
+	key, err := scrypt.Key([]byte(password), secretsSalt, scryptParams.N, secretsScryptR, scryptParams.P, 32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive the bridge secrets key: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &SecretsCipher{aead: aead}, nil
+}
+
+// Encrypt returns secret encrypted for storage. Empty secrets are kept empty, so that unset secrets stay recognizable.
+func (c *SecretsCipher) Encrypt(secret string) (string, error) {
+	if secret == "" {
+		return "", nil
+	}
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed := c.aead.Seal(nonce, nonce, []byte(secret), nil)
+	return encryptedSecretPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt returns the secret that value was encrypted from by Encrypt.
+func (c *SecretsCipher) Decrypt(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	encoded, ok := strings.CutPrefix(value, encryptedSecretPrefix)
+	if !ok {
+		return "", errors.New("bridge secret is not encrypted")
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode bridge secret: %w", err)
+	}
+	if len(sealed) < c.aead.NonceSize() {
+		return "", errors.New("bridge secret is too short")
+	}
+	secret, err := c.aead.Open(nil, sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():], nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt bridge secret: %w", err)
+	}
+	return string(secret), nil
+}
+
+// encryptSecrets encrypts the secrets hmacSecret and tlsClientKey in place. Without a cipher, only empty secrets can
+// be stored.
+func (c *SecretsCipher) encryptSecrets(hmacSecret, tlsClientKey *string) (err error) {
+	if c == nil {
+		if *hmacSecret != "" || *tlsClientKey != "" {
+			return ErrMissingSecretsCipher
+		}
+		return nil
+	}
+	if *hmacSecret, err = c.Encrypt(*hmacSecret); err != nil {
+		return fmt.Errorf("HMACSecret: %w", err)
+	}
+	if *tlsClientKey, err = c.Encrypt(*tlsClientKey); err != nil {
+		return fmt.Errorf("TLSClientKey: %w", err)
+	}
+	return nil
+}
+
+// decryptSecrets decrypts the secrets of bt in place. Without a cipher, only empty secrets can be read.
+func (c *SecretsCipher) decryptSecrets(bt *BridgeType) (err error) {
+	if c == nil {
+		if bt.HMACSecret != "" || bt.TLSClientKey != "" {
+			return fmt.Errorf("bridge %s: %w", bt.Name, ErrMissingSecretsCipher)
+		}
+		return nil
+	}
+	if bt.HMACSecret, err = c.Decrypt(bt.HMACSecret); err != nil {
+		return fmt.Errorf("HMACSecret of bridge %s: %w", bt.Name, err)
+	}
+	if bt.TLSClientKey, err = c.Decrypt(bt.TLSClientKey); err != nil {
+		return fmt.Errorf("TLSClientKey of bridge %s: %w", bt.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package bridges_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+func TestSecretsCipher(t *testing.T) {
+	t.Parallel()
+
+	c, err := bridges.NewSecretsCipher("password", utils.FastScryptParams)
+	require.NoError(t, err)
+
+	const secret = "0123456789abcdef0123456789abcdef"
+	encrypted, err := c.Encrypt(secret)
+	require.NoError(t, err)
+	assert.NotContains(t, encrypted, secret)
+	assert.True(t, strings.HasPrefix(encrypted, "encrypted:v1:"))
+
+	decrypted, err := c.Decrypt(encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, secret, decrypted)
+
+	t.Run("keeps empty secrets empty", func(t *testing.T) {
+		encrypted, err := c.Encrypt("")
+		require.NoError(t, err)
+		assert.Empty(t, encrypted)
+	})
+
+	t.Run("rejects secrets that are not encrypted", func(t *testing.T) {
+		_, err := c.Decrypt(secret)
+		require.Error(t, err)
+	})
+
+	t.Run("rejects secrets encrypted with another password", func(t *testing.T) {
+		other, err := bridges.NewSecretsCipher("other password", utils.FastScryptParams)
+		require.NoError(t, err)
+		_, err = other.Decrypt(encrypted)
+		require.Error(t, err)
+	})
+
+	t.Run("requires a password", func(t *testing.T) {
+		_, err := bridges.NewSecretsCipher("", utils.FastScryptParams)
+		require.Error(t, err)
+	})
+}
@@ -623,6 +623,10 @@ func (g *TestGasEstimatorConfig) FeeHistory() evmconfig.FeeHistory {
 	return &TestFeeHistoryConfig{}
 }
 
+func (g *TestGasEstimatorConfig) Composite() evmconfig.Composite {
+	return &TestCompositeConfig{}
+}
+
 func (g *TestGasEstimatorConfig) EIP1559DynamicFees() bool   { return false }
 func (g *TestGasEstimatorConfig) LimitDefault() uint64       { return 1e6 }
 func (g *TestGasEstimatorConfig) BumpPercent() uint16        { return 2 }
@@ -675,6 +679,10 @@ type TestFeeHistoryConfig struct {
 	evmconfig.FeeHistory
 }
 
+type TestCompositeConfig struct {
+	evmconfig.Composite
+}
+
 type transactionsConfig struct {
 	evmconfig.Transactions
 	e         *TestEvmConfig
@@ -688,6 +696,14 @@ func (t *transactionsConfig) ReaperInterval() time.Duration        { return t.e.
 func (t *transactionsConfig) ReaperThreshold() time.Duration       { return t.e.ReaperThreshold }
 func (t *transactionsConfig) ResendAfterThreshold() time.Duration  { return t.e.ResendAfterThreshold }
 func (t *transactionsConfig) AutoPurge() evmconfig.AutoPurgeConfig { return t.autoPurge }
+func (*transactionsConfig) PrivateSubmission() evmconfig.PrivateSubmissionConfig {
+	return &privateSubmissionConfig{}
+}
+func (*transactionsConfig) PrivateSubmissionFallbackBlocks() uint32 { return 0 }
+func (*transactionsConfig) NonceReconciliation() evmconfig.NonceReconciliationConfig {
+	return &nonceReconciliationConfig{}
+}
+func (*transactionsConfig) KeyPool() evmconfig.KeyPoolConfig { return &keyPoolConfig{} }
 
 type autoPurgeConfig struct {
 	evmconfig.AutoPurgeConfig
@@ -695,6 +711,24 @@ type autoPurgeConfig struct {
 
 func (a *autoPurgeConfig) Enabled() bool { return false }
 
+type privateSubmissionConfig struct {
+	evmconfig.PrivateSubmissionConfig
+}
+
+func (*privateSubmissionConfig) URL() *url.URL { return nil }
+
+type nonceReconciliationConfig struct {
+	evmconfig.NonceReconciliationConfig
+}
+
+func (*nonceReconciliationConfig) Interval() time.Duration { return 0 }
+
+type keyPoolConfig struct {
+	evmconfig.KeyPoolConfig
+}
+
+func (*keyPoolConfig) Enabled() bool { return false }
+
 type MockConfig struct {
 	EvmConfig           *TestEvmConfig
 	RpcDefaultBatchSize uint32
@@ -9,6 +9,7 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 
@@ -34,6 +35,9 @@ type Client interface {
 	// NodeStates returns a map of node Name->node state
 	// It might be nil or empty, e.g. for mock clients etc
 	NodeStates() map[string]string
+	// NodeScores returns a map of node Name->rolling stats of the calls made to the node
+	// It might be nil or empty, e.g. for mock clients etc
+	NodeScores() map[string]commonclient.NodeScore
 
 	TokenBalance(ctx context.Context, address common.Address, contractAddress common.Address) (*big.Int, error)
 	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
@@ -59,6 +63,9 @@ type Client interface {
 	// CAUTION: Using this method might cause local finality violations. It's highly recommended
 	// to use HeadTracker to get latest finalized block.
 	LatestFinalizedBlock(ctx context.Context) (head *evmtypes.Head, err error)
+	// LatestSafeBlock - returns the latest safe block as it's returned from an RPC.
+	// CAUTION: It's highly recommended to use HeadTracker to get latest safe block.
+	LatestSafeBlock(ctx context.Context) (head *evmtypes.Head, err error)
 
 	SendTransactionReturnCode(ctx context.Context, tx *types.Transaction, fromAddress common.Address) (commonclient.SendTxReturnCode, error)
 
@@ -102,6 +109,7 @@ type chainClient struct {
 		*RPCClient,
 	]
 	txSender     *commonclient.TransactionSender[*types.Transaction, *SendTxResult, *big.Int, *RPCClient]
+	reader       *commonclient.CriticalReader[*big.Int, *RPCClient]
 	logger       logger.SugaredLogger
 	chainType    chaintype.ChainType
 	clientErrors evmconfig.ClientErrors
@@ -115,6 +123,7 @@ func NewChainClient(
 	sendonlys []commonclient.SendOnlyNode[*big.Int, *RPCClient],
 	chainID *big.Int,
 	clientErrors evmconfig.ClientErrors,
+	criticalReads evmconfig.CriticalReads,
 	deathDeclarationDelay time.Duration,
 	chainType chaintype.ChainType,
 ) Client {
@@ -139,9 +148,12 @@ func NewChainClient(
 		0, // use the default value provided by the implementation
 	)
 
+	reader := commonclient.NewCriticalReader[*big.Int, *RPCClient](lggr, chainID, chainFamily, multiNode, criticalReads)
+
 	return &chainClient{
 		multiNode:    multiNode,
 		txSender:     txSender,
+		reader:       reader,
 		logger:       logger.Sugared(lggr),
 		chainType:    chainType,
 		clientErrors: clientErrors,
@@ -149,11 +161,9 @@ func NewChainClient(
 }
 
 func (c *chainClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
-	r, err := c.multiNode.SelectRPC()
-	if err != nil {
-		return nil, err
-	}
-	return r.BalanceAt(ctx, account, blockNumber)
+	return commonclient.CriticalReadAt(ctx, c.reader, "BalanceAt", blockNumber, func(ctx context.Context, r *RPCClient, blockNumber *big.Int) (*big.Int, error) {
+		return r.BalanceAt(ctx, account, blockNumber)
+	}, (*big.Int).String)
 }
 
 // BatchCallContext - sends all given requests as a single batch.
@@ -243,11 +253,9 @@ func (c *chainClient) CallContext(ctx context.Context, result interface{}, metho
 }
 
 func (c *chainClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
-	r, err := c.multiNode.SelectRPC()
-	if err != nil {
-		return nil, err
-	}
-	return r.CallContract(ctx, msg, blockNumber)
+	return commonclient.CriticalReadAt(ctx, c.reader, "CallContract", blockNumber, func(ctx context.Context, r *RPCClient, blockNumber *big.Int) ([]byte, error) {
+		return r.CallContract(ctx, msg, blockNumber)
+	}, hexutil.Encode)
 }
 
 func (c *chainClient) PendingCallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
@@ -298,6 +306,23 @@ func (c *chainClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([
 	return r.FilterEvents(ctx, q)
 }
 
+// LogFilterer fetches logs from a single RPC.
+type LogFilterer interface {
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+}
+
+// LogFilterers returns the alive primary RPCs, so that independent log queries, such as the chunks of a backfill, can
+// be spread across them.
+func (c *chainClient) LogFilterers(ctx context.Context) ([]LogFilterer, error) {
+	var filterers []LogFilterer
+	err := c.multiNode.DoAll(ctx, func(_ context.Context, rpc *RPCClient, isSendOnly bool) {
+		if !isSendOnly {
+			filterers = append(filterers, rpc)
+		}
+	})
+	return filterers, err
+}
+
 func (c *chainClient) HeaderByHash(ctx context.Context, h common.Hash) (head *types.Header, err error) {
 	r, err := c.multiNode.SelectRPC()
 	if err != nil {
@@ -307,11 +332,11 @@ func (c *chainClient) HeaderByHash(ctx context.Context, h common.Hash) (head *ty
 }
 
 func (c *chainClient) HeaderByNumber(ctx context.Context, n *big.Int) (head *types.Header, err error) {
-	r, err := c.multiNode.SelectRPC()
-	if err != nil {
-		return head, err
-	}
-	return r.HeaderByNumber(ctx, n)
+	return commonclient.CriticalReadAt(ctx, c.reader, "HeaderByNumber", n, func(ctx context.Context, r *RPCClient, n *big.Int) (*types.Header, error) {
+		return r.HeaderByNumber(ctx, n)
+	}, func(h *types.Header) string {
+		return h.Hash().Hex()
+	})
 }
 
 func (c *chainClient) HeadByHash(ctx context.Context, h common.Hash) (*evmtypes.Head, error) {
@@ -354,6 +379,10 @@ func (c *chainClient) NodeStates() map[string]string {
 	return c.multiNode.NodeStates()
 }
 
+func (c *chainClient) NodeScores() map[string]commonclient.NodeScore {
+	return c.multiNode.NodeScores()
+}
+
 func (c *chainClient) PendingCodeAt(ctx context.Context, account common.Address) (b []byte, err error) {
 	r, err := c.multiNode.SelectRPC()
 	if err != nil {
@@ -475,6 +504,14 @@ func (c *chainClient) LatestFinalizedBlock(ctx context.Context) (*evmtypes.Head,
 	return r.LatestFinalizedBlock(ctx)
 }
 
+func (c *chainClient) LatestSafeBlock(ctx context.Context) (*evmtypes.Head, error) {
+	r, err := c.multiNode.SelectRPC()
+	if err != nil {
+		return nil, err
+	}
+	return r.LatestSafeBlock(ctx)
+}
+
 func (c *chainClient) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (feeHistory *ethereum.FeeHistory, err error) {
 	r, err := c.multiNode.SelectRPC()
 	if err != nil {
@@ -49,6 +49,7 @@ func NewClientConfigs(
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	criticalReadMode := commonclient.CriticalReadModeSingle
 	nodePool := toml.NodePool{
 		SelectionMode:              selectionMode,
 		LeaseDuration:              commonconfig.MustNewDuration(leaseDuration),
@@ -60,6 +61,7 @@ func NewClientConfigs(
 		DeathDeclarationDelay:      commonconfig.MustNewDuration(deathDeclarationDelay),
 		FinalizedBlockPollInterval: commonconfig.MustNewDuration(finalizedBlockPollInterval),
 		NewHeadsPollInterval:       commonconfig.MustNewDuration(newHeadsPollInterval),
+		CriticalReads:              toml.CriticalReads{Mode: &criticalReadMode},
 	}
 	nodePoolCfg := &evmconfig.NodePoolConfig{C: nodePool}
 	chainConfig := &evmconfig.EVMConfig{
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"regexp"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
@@ -432,6 +433,14 @@ func (s *SendError) IsCanceled() bool {
 	return pkgerrors.Is(s.err, context.Canceled)
 }
 
+// IsRateLimited indicates if the transaction was refused because the RPC rate limited the node, see IsRateLimited
+func (s *SendError) IsRateLimited() bool {
+	if s == nil {
+		return false
+	}
+	return IsRateLimited(s.err)
+}
+
 func NewFatalSendError(e error) *SendError {
 	if e == nil {
 		return nil
@@ -619,6 +628,10 @@ func ClassifySendError(err error, clientErrors config.ClientErrors, lggr logger.
 		lggr.Errorw(fmt.Sprintf("service unavailable while sending transaction %x", tx.Hash()), "err", sendError, "etx", tx)
 		return commonclient.Retryable
 	}
+	if sendError.IsRateLimited() {
+		lggr.Warnw(fmt.Sprintf("rate limited while sending transaction %x", tx.Hash()), "err", sendError, "etx", tx)
+		return commonclient.Retryable
+	}
 	if sendError.IsTimeout() {
 		lggr.Errorw(fmt.Sprintf("timeout while sending transaction %x", tx.Hash()), "err", sendError, "etx", tx)
 		return commonclient.Retryable
@@ -728,3 +741,29 @@ func IsTooManyResults(err error, clientErrors config.ClientErrors) bool {
 	}
 	return false
 }
+
+// Messages of the RPC providers refusing requests over their rate or compute unit limits
+var rateLimited = regexp.MustCompile(`(?i)(rate limit|rate exceeded|too many requests|request limit reached|request count exceeded|compute units per second|exceeded its .*capacity)`)
+
+// jsonRpcRateLimited is the JSON-RPC error code some providers, e.g. Alchemy, use like the HTTP status
+const jsonRpcRateLimited = 429
+
+// IsRateLimited indicates if the request was refused because the RPC provider, or the budget of the node, rate limited
+// it. The node is healthy and the request can be retried later, so it must not be treated as a node failure.
+func IsRateLimited(err error) bool {
+	if err == nil {
+		return false
+	}
+	if pkgerrors.Is(err, ErrRPCBudgetExceeded) {
+		return true
+	}
+	var httpErr rpc.HTTPError
+	if pkgerrors.As(err, &httpErr) && httpErr.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	var rpcErr rpc.Error
+	if pkgerrors.As(err, &rpcErr) && rpcErr.ErrorCode() == jsonRpcRateLimited {
+		return true
+	}
+	return rateLimited.MatchString(err.Error())
+}
@@ -4,10 +4,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/rpc"
 	pkgerrors "github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
@@ -542,3 +545,32 @@ func Test_IsTooManyResultsError(t *testing.T) {
 		})
 	}
 }
+
+func Test_IsRateLimited(t *testing.T) {
+	tests := []errorCase{
+		{`{"code":429,"message":"Your app has exceeded its compute units per second capacity. If you have retries enabled, you can safely ignore this message."}`, true, "alchemy"},
+		{`{"code":-32005,"message":"daily request count exceeded, request rate limited"}`, true, "infura"},
+		{`{"code":-32007,"message":"request limit reached - reduce calls per second or upgrade your account at quicknode.com"}`, true, "quicknode"},
+		{`{"code":-32005,"message":"query returned more than 10000 results. Try with this block range [0xCB3D, 0x7B737]."}`, false, "too many results"},
+		{`{"code":3,"message":"execution reverted"}`, false, "revert"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.network, func(t *testing.T) {
+			jsonRpcErr := evmclient.JsonError{}
+			require.NoError(t, json.Unmarshal([]byte(test.message), &jsonRpcErr))
+			assert.Equal(t, test.expect, evmclient.IsRateLimited(jsonRpcErr))
+		})
+	}
+
+	t.Run("HTTP status", func(t *testing.T) {
+		err := fmt.Errorf("call failed: %w", rpc.HTTPError{StatusCode: http.StatusTooManyRequests, Status: "429 Too Many Requests"})
+		assert.True(t, evmclient.IsRateLimited(err))
+		assert.True(t, evmclient.NewSendError(err).IsRateLimited())
+	})
+
+	t.Run("budget", func(t *testing.T) {
+		assert.True(t, evmclient.IsRateLimited(fmt.Errorf("%w: low priority request would be delayed", evmclient.ErrRPCBudgetExceeded)))
+		assert.False(t, evmclient.IsRateLimited(nil))
+	})
+}
@@ -1,8 +1,10 @@
 package client
 
 import (
+	"fmt"
 	"math/big"
 	"net/url"
+	"os"
 	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -16,18 +18,37 @@ import (
 func NewEvmClient(cfg evmconfig.NodePool, chainCfg commonclient.ChainConfig, clientErrors evmconfig.ClientErrors, lggr logger.Logger, chainID *big.Int, nodes []*toml.Node, chainType chaintype.ChainType) (Client, error) {
 	var primaries []commonclient.Node[*big.Int, *RPCClient]
 	var sendonlys []commonclient.SendOnlyNode[*big.Int, *RPCClient]
+	var recorded []*RPCClient
 	largePayloadRPCTimeout, defaultRPCTimeout := getRPCTimeouts(chainType)
+	// The recordings are closed with the nodes, or here if the client can't be created
+	closeRecordings := func() {
+		for _, rpc := range recorded {
+			rpc.closeRecording()
+		}
+	}
 
 	for i, node := range nodes {
 		if node.SendOnly != nil && *node.SendOnly {
 			rpc := NewRPCClient(cfg, lggr, nil, node.HTTPURL.URL(), *node.Name, i, chainID,
 				commonclient.Secondary, largePayloadRPCTimeout, defaultRPCTimeout, chainType)
+			if err := recordRPC(rpc, node); err != nil {
+				closeRecordings()
+				return nil, err
+			}
+			recorded = append(recorded, rpc)
+			limitRPC(rpc, node)
 			sendonly := commonclient.NewSendOnlyNode(lggr, (url.URL)(*node.HTTPURL),
 				*node.Name, chainID, rpc)
 			sendonlys = append(sendonlys, sendonly)
 		} else {
 			rpc := NewRPCClient(cfg, lggr, node.WSURL.URL(), node.HTTPURL.URL(), *node.Name, i,
 				chainID, commonclient.Primary, largePayloadRPCTimeout, defaultRPCTimeout, chainType)
+			if err := recordRPC(rpc, node); err != nil {
+				closeRecordings()
+				return nil, err
+			}
+			recorded = append(recorded, rpc)
+			limitRPC(rpc, node)
 			primaryNode := commonclient.NewNode(cfg, chainCfg,
 				lggr, node.WSURL.URL(), node.HTTPURL.URL(), *node.Name, i, chainID, *node.Order,
 				rpc, "EVM")
@@ -36,7 +57,32 @@ func NewEvmClient(cfg evmconfig.NodePool, chainCfg commonclient.ChainConfig, cli
 	}
 
 	return NewChainClient(lggr, cfg.SelectionMode(), cfg.LeaseDuration(),
-		primaries, sendonlys, chainID, clientErrors, cfg.DeathDeclarationDelay(), chainType), nil
+		primaries, sendonlys, chainID, clientErrors, cfg.CriticalReads(), cfg.DeathDeclarationDelay(), chainType), nil
+}
+
+// recordRPC records the calls of rpc to the RecordingPath of the node, if set. The file is closed with rpc.
+func recordRPC(rpc *RPCClient, node *toml.Node) error {
+	if node.RecordingPath == nil || *node.RecordingPath == "" {
+		return nil
+	}
+	f, err := os.OpenFile(*node.RecordingPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open RPC recording of node %s: %w", *node.Name, err)
+	}
+	rpc.RecordTo(f)
+	return nil
+}
+
+// limitRPC limits the requests of rpc to the budgets of the node.
+func limitRPC(rpc *RPCClient, node *toml.Node) {
+	var requestsPerSecond, computeUnitsPerSecond uint32
+	if node.MaxRequestsPerSecond != nil {
+		requestsPerSecond = *node.MaxRequestsPerSecond
+	}
+	if node.MaxComputeUnitsPerSecond != nil {
+		computeUnitsPerSecond = *node.MaxComputeUnitsPerSecond
+	}
+	rpc.LimitTo(requestsPerSecond, computeUnitsPerSecond)
 }
 
 func getRPCTimeouts(chainType chaintype.ChainType) (largePayload, defaultTimeout time.Duration) {
@@ -223,6 +223,9 @@ func (nc *NullClient) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, er
 // NodeStates implements evmclient.Client
 func (nc *NullClient) NodeStates() map[string]string { return nil }
 
+// NodeScores implements evmclient.Client
+func (nc *NullClient) NodeScores() map[string]commonclient.NodeScore { return nil }
+
 func (nc *NullClient) IsL2() bool {
 	nc.lggr.Debug("IsL2")
 	return false
@@ -232,6 +235,10 @@ func (nc *NullClient) LatestFinalizedBlock(_ context.Context) (*evmtypes.Head, e
 	return nil, nil
 }
 
+func (nc *NullClient) LatestSafeBlock(_ context.Context) (*evmtypes.Head, error) {
+	return nil, nil
+}
+
 func (nc *NullClient) CheckTxValidity(_ context.Context, _ common.Address, _ common.Address, _ []byte) *SendError {
 	return nil
 }
@@ -0,0 +1,239 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/url"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	commonassets "github.com/smartcontractkit/chainlink-common/pkg/assets"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/chaintype"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+// replayNewHeadsPollInterval is how often ReplayClient replays the latest block as a new head if
+// NewHeadsPollInterval is not set.
+const replayNewHeadsPollInterval = 100 * time.Millisecond
+
+var _ Client = (*ReplayClient)(nil)
+
+// ReplayClient satisfies the Client by replaying the responses recorded by RPCClient.RecordTo, without network access.
+// It sends the calls to a single RPCClient, and answers them with the recorded responses to the same requests, in the
+// order they were recorded. Requests that were not recorded fail.
+// New heads are polled from the recorded latest blocks, and SubscribeFilterLogs is not supported.
+type ReplayClient struct {
+	rpc          *RPCClient
+	lggr         logger.SugaredLogger
+	chainID      *big.Int
+	chainType    chaintype.ChainType
+	clientErrors config.ClientErrors
+}
+
+// NewReplayClient returns a ReplayClient replaying the recording read from r.
+func NewReplayClient(lggr logger.Logger, cfg config.NodePool, chainID *big.Int, chainType chaintype.ChainType, r io.Reader) (*ReplayClient, error) {
+	records, err := ReadRPCRecords(r)
+	if err != nil {
+		return nil, err
+	}
+	transport, err := newReplayTransport(records)
+	if err != nil {
+		return nil, err
+	}
+
+	lggr = logger.Named(lggr, "ReplayClient")
+	rpcClient := NewRPCClient(cfg, lggr, nil, &url.URL{Scheme: "http", Host: "replay"}, "replay", 0, chainID,
+		commonclient.Primary, commonclient.QueryTimeout, commonclient.QueryTimeout, chainType)
+	rpcClient.httpTransport = transport
+	if rpcClient.newHeadsPollInterval == 0 {
+		rpcClient.newHeadsPollInterval = replayNewHeadsPollInterval
+	}
+	return &ReplayClient{
+		rpc:          rpcClient,
+		lggr:         logger.Sugared(lggr),
+		chainID:      chainID,
+		chainType:    chainType,
+		clientErrors: cfg.Errors(),
+	}, nil
+}
+
+func (c *ReplayClient) Dial(ctx context.Context) error {
+	return c.rpc.Dial(ctx)
+}
+
+func (c *ReplayClient) Close() {
+	c.rpc.Close()
+}
+
+func (c *ReplayClient) ConfiguredChainID() *big.Int {
+	return c.chainID
+}
+
+func (c *ReplayClient) NodeStates() map[string]string {
+	return map[string]string{c.rpc.Name(): "Alive"}
+}
+
+func (c *ReplayClient) NodeScores() map[string]commonclient.NodeScore {
+	return map[string]commonclient.NodeScore{c.rpc.Name(): c.rpc.CallStats()}
+}
+
+func (c *ReplayClient) TokenBalance(ctx context.Context, address common.Address, contractAddress common.Address) (*big.Int, error) {
+	return c.rpc.TokenBalance(ctx, address, contractAddress)
+}
+
+func (c *ReplayClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return c.rpc.BalanceAt(ctx, account, blockNumber)
+}
+
+func (c *ReplayClient) LINKBalance(ctx context.Context, address common.Address, linkAddress common.Address) (*commonassets.Link, error) {
+	return c.rpc.LINKBalance(ctx, address, linkAddress)
+}
+
+func (c *ReplayClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	return c.rpc.CallContext(ctx, result, method, args...)
+}
+
+func (c *ReplayClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	return c.rpc.BatchCallContext(ctx, b)
+}
+
+// BatchCallContextAll is the same as BatchCallContext, as there is a single node.
+func (c *ReplayClient) BatchCallContextAll(ctx context.Context, b []rpc.BatchElem) error {
+	return c.rpc.BatchCallContext(ctx, b)
+}
+
+func (c *ReplayClient) HeadByNumber(ctx context.Context, n *big.Int) (*evmtypes.Head, error) {
+	return c.rpc.BlockByNumber(ctx, n)
+}
+
+func (c *ReplayClient) HeadByHash(ctx context.Context, h common.Hash) (*evmtypes.Head, error) {
+	return c.rpc.BlockByHash(ctx, h)
+}
+
+func (c *ReplayClient) SubscribeToHeads(ctx context.Context) (<-chan *evmtypes.Head, ethereum.Subscription, error) {
+	return c.rpc.SubscribeToHeads(ctx)
+}
+
+func (c *ReplayClient) LatestFinalizedBlock(ctx context.Context) (*evmtypes.Head, error) {
+	return c.rpc.LatestFinalizedBlock(ctx)
+}
+
+func (c *ReplayClient) LatestSafeBlock(ctx context.Context) (*evmtypes.Head, error) {
+	return c.rpc.LatestSafeBlock(ctx)
+}
+
+func (c *ReplayClient) SendTransactionReturnCode(ctx context.Context, tx *types.Transaction, fromAddress common.Address) (commonclient.SendTxReturnCode, error) {
+	err := c.SendTransaction(ctx, tx)
+	returnCode := ClassifySendError(err, c.clientErrors, c.lggr, tx, fromAddress, c.IsL2())
+	return returnCode, err
+}
+
+func (c *ReplayClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	result := c.rpc.SendTransaction(ctx, tx)
+	if result == nil {
+		return errors.New("SendTransaction failed: result is nil")
+	}
+	return result.Error()
+}
+
+func (c *ReplayClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return c.rpc.CodeAt(ctx, account, blockNumber)
+}
+
+func (c *ReplayClient) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return c.rpc.PendingCodeAt(ctx, account)
+}
+
+func (c *ReplayClient) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	n, err := c.rpc.PendingSequenceAt(ctx, account)
+	return uint64(n), err
+}
+
+func (c *ReplayClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	return c.rpc.NonceAt(ctx, account, blockNumber)
+}
+
+func (c *ReplayClient) TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, error) {
+	return c.rpc.TransactionByHash(ctx, txHash)
+}
+
+func (c *ReplayClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return c.rpc.TransactionReceiptGeth(ctx, txHash)
+}
+
+func (c *ReplayClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	return c.rpc.BlockByNumberGeth(ctx, number)
+}
+
+func (c *ReplayClient) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	return c.rpc.BlockByHashGeth(ctx, hash)
+}
+
+func (c *ReplayClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	return c.rpc.FilterEvents(ctx, q)
+}
+
+// SubscribeFilterLogs is not supported, as log subscriptions are not recorded.
+func (c *ReplayClient) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return nil, fmt.Errorf("SubscribeFilterLogs is not supported by %T", c)
+}
+
+func (c *ReplayClient) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return c.rpc.EstimateGas(ctx, call)
+}
+
+func (c *ReplayClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return c.rpc.SuggestGasPrice(ctx)
+}
+
+func (c *ReplayClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return c.rpc.SuggestGasTipCap(ctx)
+}
+
+func (c *ReplayClient) LatestBlockHeight(ctx context.Context) (*big.Int, error) {
+	return c.rpc.LatestBlockHeight(ctx)
+}
+
+func (c *ReplayClient) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	return c.rpc.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles)
+}
+
+func (c *ReplayClient) HeaderByNumber(ctx context.Context, n *big.Int) (*types.Header, error) {
+	return c.rpc.HeaderByNumber(ctx, n)
+}
+
+func (c *ReplayClient) HeaderByHash(ctx context.Context, h common.Hash) (*types.Header, error) {
+	return c.rpc.HeaderByHash(ctx, h)
+}
+
+func (c *ReplayClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return c.rpc.CallContract(ctx, msg, blockNumber)
+}
+
+func (c *ReplayClient) PendingCallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
+	return c.rpc.PendingCallContract(ctx, msg)
+}
+
+func (c *ReplayClient) IsL2() bool {
+	return c.chainType.IsL2()
+}
+
+func (c *ReplayClient) CheckTxValidity(ctx context.Context, from common.Address, to common.Address, data []byte) *SendError {
+	msg := ethereum.CallMsg{
+		From: from,
+		To:   &to,
+		Data: data,
+	}
+	return SimulateTransaction(ctx, c, c.lggr, c.chainType, msg)
+}
@@ -0,0 +1,169 @@
+package client_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+)
+
+// newHTTPRPCServer answers eth_getBalance with an increasing balance, and eth_blockNumber with 16.
+func newHTTPRPCServer(t *testing.T) *url.URL {
+	var balance int64
+	answer := func(req map[string]json.RawMessage) map[string]any {
+		resp := map[string]any{"jsonrpc": "2.0", "id": req["id"]}
+		var method string
+		require.NoError(t, json.Unmarshal(req["method"], &method))
+		switch method {
+		case "eth_getBalance":
+			balance++
+			resp["result"] = fmt.Sprintf("0x%x", balance)
+		case "eth_blockNumber":
+			resp["result"] = "0x10"
+		default:
+			resp["error"] = map[string]any{"code": -32601, "message": "method not found"}
+		}
+		return resp
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var resp any
+		if bytes.HasPrefix(body, []byte("[")) {
+			var reqs []map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal(body, &reqs))
+			var resps []map[string]any
+			for _, req := range reqs {
+				resps = append(resps, answer(req))
+			}
+			resp = resps
+		} else {
+			var req map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal(body, &req))
+			resp = answer(req)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	t.Cleanup(server.Close)
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	return u
+}
+
+func TestRPCClient_RecordTo_ClosesRecording(t *testing.T) {
+	t.Parallel()
+	ctx := tests.Context(t)
+	path := filepath.Join(t.TempDir(), "recording.jsonl")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+
+	rpcClient := client.NewRPCClient(client.TestNodePoolConfig{}, logger.Test(t), nil, newHTTPRPCServer(t), "rpc", 1, big.NewInt(123456), commonclient.Primary, commonclient.QueryTimeout, commonclient.QueryTimeout, "")
+	rpcClient.RecordTo(f)
+	require.NoError(t, rpcClient.Dial(ctx))
+	_, err = rpcClient.LatestBlockHeight(ctx)
+	require.NoError(t, err)
+	rpcClient.Close()
+
+	_, err = f.Write([]byte("{}"))
+	require.ErrorIs(t, err, os.ErrClosed)
+	recording, err := os.ReadFile(path)
+	require.NoError(t, err)
+	records, err := client.ReadRPCRecords(bytes.NewReader(recording))
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+}
+
+func TestReplayClient(t *testing.T) {
+	t.Parallel()
+	ctx := tests.Context(t)
+	chainID := big.NewInt(123456)
+	account := common.HexToAddress("0x2a3e23c6f242F5345320814aC8a1b4E58707D292")
+	batch := func() []rpc.BatchElem {
+		return []rpc.BatchElem{
+			{Method: "eth_blockNumber", Result: new(string)},
+			{Method: "eth_getBalance", Args: []any{account, "latest"}, Result: new(string)},
+		}
+	}
+
+	var recording bytes.Buffer
+	rpcClient := client.NewRPCClient(client.TestNodePoolConfig{}, logger.Test(t), nil, newHTTPRPCServer(t), "rpc", 1, chainID, commonclient.Primary, commonclient.QueryTimeout, commonclient.QueryTimeout, "")
+	rpcClient.RecordTo(&recording)
+	require.NoError(t, rpcClient.Dial(ctx))
+	defer rpcClient.Close()
+
+	balance, err := rpcClient.BalanceAt(ctx, account, nil)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1), balance)
+	height, err := rpcClient.LatestBlockHeight(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(16), height)
+	recordedBatch := batch()
+	require.NoError(t, rpcClient.BatchCallContext(ctx, recordedBatch))
+	balance, err = rpcClient.BalanceAt(ctx, account, nil)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(3), balance)
+	_, err = rpcClient.CodeAt(ctx, account, nil)
+	require.ErrorContains(t, err, "method not found")
+
+	records, err := client.ReadRPCRecords(bytes.NewReader(recording.Bytes()))
+	require.NoError(t, err)
+	require.Len(t, records, 5)
+	for _, record := range records {
+		assert.Equal(t, "rpc", record.Node)
+		assert.False(t, record.RequestTime.IsZero())
+		assert.False(t, record.ResponseTime.Before(record.RequestTime))
+		assert.Equal(t, http.StatusOK, record.StatusCode)
+	}
+
+	replay, err := client.NewReplayClient(logger.Test(t), client.TestNodePoolConfig{}, chainID, "", &recording)
+	require.NoError(t, err)
+	require.NoError(t, replay.Dial(ctx))
+	defer replay.Close()
+
+	t.Run("replays the recorded responses in order", func(t *testing.T) {
+		balance, err := replay.BalanceAt(ctx, account, nil)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(1), balance)
+		height, err := replay.LatestBlockHeight(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(16), height)
+		replayedBatch := batch()
+		require.NoError(t, replay.BatchCallContext(ctx, replayedBatch))
+		assert.Equal(t, recordedBatch, replayedBatch)
+		balance, err = replay.BalanceAt(ctx, account, nil)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(3), balance)
+		_, err = replay.CodeAt(ctx, account, nil)
+		require.ErrorContains(t, err, "method not found")
+	})
+
+	t.Run("repeats the last recorded response", func(t *testing.T) {
+		balance, err := replay.BalanceAt(ctx, account, nil)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(3), balance)
+	})
+
+	t.Run("fails requests that were not recorded", func(t *testing.T) {
+		_, err := replay.BalanceAt(ctx, account, big.NewInt(1))
+		require.ErrorContains(t, err, "no recorded response for request")
+	})
+}
@@ -0,0 +1,341 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+)
+
+var (
+	promEVMPoolRPCNodeBudgetUtilization = prometheus.NewDesc(
+		"evm_pool_rpc_node_budget_utilization",
+		"The share of the request or compute unit budget of the given RPC node used in the last second, between 0 and 1",
+		[]string{"evmChainID", "nodeName", "budget"}, nil,
+	)
+	promEVMPoolRPCNodeBudgetThrottled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_pool_rpc_node_budget_throttled_total",
+		Help: "The total number of requests to the given RPC node delayed or refused because its budget was used up",
+	}, []string{"evmChainID", "nodeName", "priority"})
+)
+
+// budgets are the budgets of the dialed nodes, whose utilization is computed when the metrics are collected, so that
+// it decays while the node is idle.
+var budgets = &budgetCollector{budgets: map[*rpcBudget]struct{}{}}
+
+func init() {
+	prometheus.MustRegister(budgets)
+}
+
+type budgetCollector struct {
+	mu      sync.Mutex
+	budgets map[*rpcBudget]struct{}
+}
+
+func (c *budgetCollector) register(b *rpcBudget) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.budgets[b] = struct{}{}
+}
+
+func (c *budgetCollector) unregister(b *rpcBudget) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.budgets, b)
+}
+
+func (c *budgetCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- promEVMPoolRPCNodeBudgetUtilization
+}
+
+func (c *budgetCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	for b := range c.budgets {
+		for name, utilization := range b.utilization(now) {
+			ch <- prometheus.MustNewConstMetric(promEVMPoolRPCNodeBudgetUtilization, prometheus.GaugeValue, utilization, b.chainID, b.node, name)
+		}
+	}
+}
+
+// ErrRPCBudgetExceeded is returned for requests that could not be sent before their deadline because the request or
+// compute unit budget of the node was used up.
+var ErrRPCBudgetExceeded = errors.New("RPC budget exceeded")
+
+// RPCPriority orders the requests competing for the budget of a node. See CtxWithRPCPriority.
+type RPCPriority int
+
+const (
+	// RPCPriorityLow is for requests that can be delayed, like log backfills and balance polls.
+	RPCPriorityLow RPCPriority = iota
+	// RPCPriorityNormal is the priority of the requests without one.
+	RPCPriorityNormal
+	// RPCPriorityHigh is for transaction sends, new heads and health checks.
+	RPCPriorityHigh
+)
+
+func (p RPCPriority) String() string {
+	switch p {
+	case RPCPriorityLow:
+		return "low"
+	case RPCPriorityHigh:
+		return "high"
+	default:
+		return "normal"
+	}
+}
+
+// budgetReserve is the share of the budget requests of a priority can not use, so that it is kept for the requests of a
+// higher priority.
+var budgetReserve = map[RPCPriority]float64{
+	RPCPriorityLow:    0.3,
+	RPCPriorityNormal: 0.1,
+	RPCPriorityHigh:   0,
+}
+
+// methodComputeUnits is the approximate cost of the methods in compute units, as charged by the common RPC providers.
+var methodComputeUnits = map[string]float64{
+	"eth_blockNumber":                         10,
+	"eth_chainId":                             0,
+	"web3_clientVersion":                      0,
+	"net_version":                             0,
+	"eth_syncing":                             0,
+	"eth_getBalance":                          19,
+	"eth_getCode":                             26,
+	"eth_getTransactionCount":                 26,
+	"eth_getBlockByNumber":                    16,
+	"eth_getBlockByHash":                      21,
+	"eth_getTransactionByHash":                17,
+	"eth_getTransactionReceipt":               15,
+	"eth_call":                                26,
+	"eth_estimateGas":                         87,
+	"eth_gasPrice":                            19,
+	"eth_maxPriorityFeePerGas":                10,
+	"eth_feeHistory":                          10,
+	"eth_getLogs":                             75,
+	"eth_sendRawTransaction":                  250,
+	"eth_subscribe":                           10,
+	"eth_unsubscribe":                         10,
+	"debug_traceTransaction":                  309,
+	"zks_estimateFee":                         87,
+	"eth_getFilterLogs":                       75,
+	"eth_getBlockReceipts":                    500,
+	"eth_getTransactionByBlockNumberAndIndex": 17,
+}
+
+// defaultComputeUnits is the cost of the methods missing from methodComputeUnits.
+const defaultComputeUnits = 20
+
+type rpcPriorityKey struct{}
+
+// CtxWithRPCPriority returns a ctx whose requests use the budget of the nodes with priority p. Transaction sends, new
+// heads and health checks are always RPCPriorityHigh.
+func CtxWithRPCPriority(ctx context.Context, p RPCPriority) context.Context {
+	return context.WithValue(ctx, rpcPriorityKey{}, p)
+}
+
+func rpcPriority(ctx context.Context, methods []string) RPCPriority {
+	for _, method := range methods {
+		if method == "eth_sendRawTransaction" {
+			return RPCPriorityHigh
+		}
+	}
+	if commonclient.CtxIsHeathCheckRequest(ctx) {
+		return RPCPriorityHigh
+	}
+	if p, ok := ctx.Value(rpcPriorityKey{}).(RPCPriority); ok {
+		return p
+	}
+	return RPCPriorityNormal
+}
+
+// tokenBucket refills rate tokens per second, up to rate tokens.
+type tokenBucket struct {
+	name   string
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(name string, rate uint32, now time.Time) *tokenBucket {
+	return &tokenBucket{name: name, rate: float64(rate), tokens: float64(rate), last: now}
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	b.tokens = min(b.rate, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+}
+
+// delay returns how long to wait until cost tokens can be taken while leaving the reserve share of the bucket.
+func (b *tokenBucket) delay(cost, reserve float64) time.Duration {
+	needed := min(cost+reserve*b.rate, b.rate)
+	if b.tokens >= needed {
+		return 0
+	}
+	return time.Duration((needed - b.tokens) / b.rate * float64(time.Second))
+}
+
+func (b *tokenBucket) take(cost float64) {
+	b.tokens -= min(cost, b.rate)
+}
+
+// rpcBudget delays the requests to a node so that they stay within its request and compute unit rates. Requests of a
+// lower priority leave a reserve of the budget to the higher ones.
+type rpcBudget struct {
+	chainID string
+	node    string
+
+	mu           sync.Mutex
+	requests     *tokenBucket // nil if unlimited
+	computeUnits *tokenBucket // nil if unlimited
+}
+
+func newRPCBudget(chainID, node string, requestsPerSecond, computeUnitsPerSecond uint32) *rpcBudget {
+	b := &rpcBudget{chainID: chainID, node: node}
+	now := time.Now()
+	if requestsPerSecond > 0 {
+		b.requests = newTokenBucket("requests", requestsPerSecond, now)
+	}
+	if computeUnitsPerSecond > 0 {
+		b.computeUnits = newTokenBucket("computeUnits", computeUnitsPerSecond, now)
+	}
+	return b
+}
+
+// RoundTripper returns an http.RoundTripper sending the requests with next once they fit in the budget.
+func (b *rpcBudget) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	return &budgetTransport{budget: b, next: next}
+}
+
+type budgetTransport struct {
+	budget *rpcBudget
+	next   http.RoundTripper
+}
+
+func (t *budgetTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+
+		methods := requestMethods(body)
+		if err := t.budget.waitMethods(req.Context(), rpcPriority(req.Context(), methods), methods); err != nil {
+			return nil, err
+		}
+	}
+	return t.next.RoundTrip(req)
+}
+
+// waitMethods blocks until a request calling methods can be taken from the budget with priority p, see wait.
+func (b *rpcBudget) waitMethods(ctx context.Context, p RPCPriority, methods []string) error {
+	var cost float64
+	for _, method := range methods {
+		cu, ok := methodComputeUnits[method]
+		if !ok {
+			cu = defaultComputeUnits
+		}
+		cost += cu
+	}
+	return b.wait(ctx, p, float64(max(len(methods), 1)), cost)
+}
+
+// wait blocks until the requests and compute units can be taken from the budget with priority p. It fails right away
+// if that is after the deadline of ctx.
+func (b *rpcBudget) wait(ctx context.Context, p RPCPriority, requests, cost float64) error {
+	reserve := budgetReserve[p]
+	throttled := false
+	for {
+		d := b.tryTake(requests, cost, reserve)
+		if d == 0 {
+			return nil
+		}
+		if !throttled {
+			throttled = true
+			promEVMPoolRPCNodeBudgetThrottled.WithLabelValues(b.chainID, b.node, p.String()).Inc()
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < d {
+			return fmt.Errorf("%w: %s priority request would be delayed by %s, after its deadline", ErrRPCBudgetExceeded, p, d)
+		}
+		select {
+		case <-time.After(d):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// tryTake takes the requests and compute units from the budget, or returns how long to wait until they are available.
+func (b *rpcBudget) tryTake(requests, cost, reserve float64) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := time.Now()
+	costs := []struct {
+		bucket *tokenBucket
+		cost   float64
+	}{{b.requests, requests}, {b.computeUnits, cost}}
+	var d time.Duration
+	for _, c := range costs {
+		if c.bucket != nil {
+			c.bucket.refill(now)
+			d = max(d, c.bucket.delay(c.cost, reserve))
+		}
+	}
+	if d > 0 {
+		return d
+	}
+	for _, c := range costs {
+		if c.bucket != nil {
+			c.bucket.take(c.cost)
+		}
+	}
+	return 0
+}
+
+// utilization returns the share of each bucket of the budget that is used at now, by bucket name.
+func (b *rpcBudget) utilization(now time.Time) map[string]float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	utilization := map[string]float64{}
+	for _, bucket := range []*tokenBucket{b.requests, b.computeUnits} {
+		if bucket != nil {
+			bucket.refill(now)
+			utilization[bucket.name] = 1 - max(bucket.tokens, 0)/bucket.rate
+		}
+	}
+	return utilization
+}
+
+// requestMethods returns the methods of a single JSON-RPC request or of a batch of them.
+func requestMethods(body []byte) []string {
+	var msgs []struct {
+		Method string `json:"method"`
+	}
+	if err := json.Unmarshal(body, &msgs); err != nil {
+		var msg struct {
+			Method string `json:"method"`
+		}
+		if err := json.Unmarshal(body, &msg); err != nil {
+			return nil
+		}
+		return []string{msg.Method}
+	}
+	methods := make([]string, len(msgs))
+	for i, msg := range msgs {
+		methods[i] = msg.Method
+	}
+	return methods
+}
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+)
+
+func TestRequestMethods(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"eth_call"}, requestMethods([]byte(`{"jsonrpc":"2.0","id":1,"method":"eth_call","params":[]}`)))
+	assert.Equal(t, []string{"eth_blockNumber", "eth_getLogs"}, requestMethods([]byte(`[{"id":1,"method":"eth_blockNumber"},{"id":2,"method":"eth_getLogs"}]`)))
+	assert.Empty(t, requestMethods([]byte(`not json`)))
+}
+
+func TestRPCPriority(t *testing.T) {
+	t.Parallel()
+	ctx := tests.Context(t)
+	low := CtxWithRPCPriority(ctx, RPCPriorityLow)
+
+	assert.Equal(t, RPCPriorityNormal, rpcPriority(ctx, []string{"eth_call"}))
+	assert.Equal(t, RPCPriorityLow, rpcPriority(low, []string{"eth_getLogs"}))
+	assert.Equal(t, RPCPriorityHigh, rpcPriority(low, []string{"eth_blockNumber", "eth_sendRawTransaction"}))
+	assert.Equal(t, RPCPriorityHigh, rpcPriority(commonclient.CtxAddHealthCheckFlag(low), []string{"web3_clientVersion"}))
+}
+
+func TestRPCBudget(t *testing.T) {
+	t.Parallel()
+
+	t.Run("lower priorities leave a reserve", func(t *testing.T) {
+		b := newRPCBudget("1", "node", 10, 0)
+		for i := 0; i < 7; i++ {
+			assert.Zero(t, b.tryTake(1, 0, budgetReserve[RPCPriorityLow]), "request %d", i)
+		}
+		assert.Positive(t, b.tryTake(1, 0, budgetReserve[RPCPriorityLow]))
+		assert.Zero(t, b.tryTake(1, 0, budgetReserve[RPCPriorityNormal]))
+		assert.Zero(t, b.tryTake(1, 0, budgetReserve[RPCPriorityNormal]))
+		assert.Positive(t, b.tryTake(1, 0, budgetReserve[RPCPriorityNormal]))
+		assert.Zero(t, b.tryTake(1, 0, budgetReserve[RPCPriorityHigh]))
+		assert.Positive(t, b.tryTake(1, 0, budgetReserve[RPCPriorityHigh]))
+	})
+
+	t.Run("compute units", func(t *testing.T) {
+		b := newRPCBudget("1", "node", 0, 100)
+		assert.Zero(t, b.tryTake(1, 75, 0))
+		assert.Positive(t, b.tryTake(1, 75, 0))
+		assert.Positive(t, b.tryTake(1, 1000, 0), "requests over the whole budget need a full budget")
+	})
+
+	t.Run("waits for the budget to refill", func(t *testing.T) {
+		b := newRPCBudget("1", "node", 100, 0)
+		ctx := tests.Context(t)
+		require.NoError(t, b.wait(ctx, RPCPriorityHigh, 100, 0))
+		start := time.Now()
+		require.NoError(t, b.wait(ctx, RPCPriorityHigh, 10, 0))
+		assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	})
+
+	t.Run("utilization decays while idle", func(t *testing.T) {
+		b := newRPCBudget("1", "node", 10, 100)
+		require.Zero(t, b.tryTake(10, 50, 0))
+		now := time.Now()
+		utilization := b.utilization(now)
+		assert.InDelta(t, 1, utilization["requests"], 0.05)
+		assert.InDelta(t, 0.5, utilization["computeUnits"], 0.05)
+
+		utilization = b.utilization(now.Add(500 * time.Millisecond))
+		assert.InDelta(t, 0.5, utilization["requests"], 0.05)
+		assert.InDelta(t, 0, utilization["computeUnits"], 0.05)
+
+		assert.Zero(t, b.utilization(now.Add(time.Second))["requests"])
+	})
+
+	t.Run("fails requests that would be delayed after their deadline", func(t *testing.T) {
+		b := newRPCBudget("1", "node", 1, 0)
+		ctx, cancel := context.WithTimeout(tests.Context(t), 10*time.Millisecond)
+		defer cancel()
+		require.NoError(t, b.wait(ctx, RPCPriorityHigh, 1, 0))
+		err := b.wait(ctx, RPCPriorityHigh, 1, 0)
+		require.ErrorIs(t, err, ErrRPCBudgetExceeded)
+		assert.True(t, IsRateLimited(err))
+	})
+}
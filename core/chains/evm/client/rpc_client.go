@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
+	"net/http"
 	"net/url"
 	"strconv"
 	"sync"
@@ -120,10 +122,19 @@ type RPCClient struct {
 	highestUserObservations commonclient.ChainInfo
 	// most recent chain info observed during current lifecycle (reseted on DisconnectAll)
 	latestChainInfo commonclient.ChainInfo
+
+	// rolling latency and error rate of the calls, used by the LatencyWeighted node selector
+	callStats commonclient.CallStats
+
+	// httpTransport sends the HTTP requests if set, e.g. to record or replay them
+	httpTransport http.RoundTripper
+	// budget limits the rate of the HTTP requests if set
+	budget *rpcBudget
 }
 
 var _ commonclient.RPCClient[*big.Int, *evmtypes.Head] = (*RPCClient)(nil)
 var _ commonclient.SendTxRPCClient[*types.Transaction, *SendTxResult] = (*RPCClient)(nil)
+var _ commonclient.CallStatsReporter = (*RPCClient)(nil)
 
 func NewRPCClient(
 	cfg config.NodePool,
@@ -173,6 +184,11 @@ func NewRPCClient(
 
 func (r *RPCClient) Ping(ctx context.Context) error {
 	version, err := r.ClientVersion(ctx)
+	if IsRateLimited(err) {
+		// The RPC answered, so the node is reachable, it is just busy
+		r.rpcLog.Debugw("ping rate limited", "err", err)
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("ping failed: %w", err)
 	}
@@ -205,6 +221,9 @@ func (r *RPCClient) Dial(callerCtx context.Context) error {
 	if r.ws == nil && r.http == nil {
 		return errors.New("cannot dial rpc client when both ws and http info are missing")
 	}
+	if r.budget != nil && r.http == nil {
+		return errors.New("cannot dial rpc client with a budget but without http info, as only subscriptions are limited over ws")
+	}
 
 	promEVMPoolRPCNodeDials.WithLabelValues(r.chainID.String(), r.name).Inc()
 	lggr := r.rpcLog
@@ -240,8 +259,21 @@ func (r *RPCClient) DialHTTP() error {
 	lggr := r.rpcLog.With("httpuri", r.http.uri.Redacted())
 	lggr.Debugw("RPC dial: evmclient.Client#dial")
 
+	transport := r.httpTransport
+	if r.budget != nil {
+		if transport == nil {
+			transport = http.DefaultTransport
+		}
+		transport = r.budget.RoundTripper(transport)
+		budgets.register(r.budget)
+	}
 	var httprpc *rpc.Client
-	httprpc, err := rpc.DialHTTP(r.http.uri.String())
+	var err error
+	if transport != nil {
+		httprpc, err = rpc.DialOptions(context.Background(), r.http.uri.String(), rpc.WithHTTPClient(&http.Client{Transport: transport}))
+	} else {
+		httprpc, err = rpc.DialHTTP(r.http.uri.String())
+	}
 	if err != nil {
 		promEVMPoolRPCNodeDialsFailed.WithLabelValues(r.chainID.String(), r.name).Inc()
 		return r.wrapRPCClientError(pkgerrors.Wrapf(err, "error while dialing HTTP: %v", r.http.uri.Redacted()))
@@ -255,12 +287,44 @@ func (r *RPCClient) DialHTTP() error {
 	return nil
 }
 
+// RecordTo writes every JSON-RPC request sent to the node over HTTP and its response to w, as JSON lines of RPCRecord.
+// Subscriptions are not recorded, as they use the WS connection. If w is an io.Closer, it is closed with the client.
+// It must be called before dialing.
+func (r *RPCClient) RecordTo(w io.Writer) {
+	r.httpTransport = newRPCRecorder(r.name, w, http.DefaultTransport)
+}
+
+// LimitTo delays the requests sent to the node over HTTP, and the subscriptions made over WS, so that they stay within
+// requestsPerSecond and computeUnitsPerSecond, where 0 is unlimited. Transaction sends, new heads and health checks have
+// priority over the other requests, and the requests marked with CtxWithRPCPriority come last. Dialing fails without
+// an HTTP URL, since the other requests over WS are not limited. It must be called before dialing.
+func (r *RPCClient) LimitTo(requestsPerSecond, computeUnitsPerSecond uint32) {
+	if requestsPerSecond == 0 && computeUnitsPerSecond == 0 {
+		r.budget = nil
+		return
+	}
+	r.budget = newRPCBudget(r.chainID.String(), r.name, requestsPerSecond, computeUnitsPerSecond)
+}
+
+// waitBudget blocks until a request over WS calling methods with priority p fits in the budget of the node, if it has
+// one.
+func (r *RPCClient) waitBudget(ctx context.Context, p RPCPriority, methods ...string) error {
+	if r.budget == nil {
+		return nil
+	}
+	return r.budget.waitMethods(ctx, p, methods)
+}
+
 func (r *RPCClient) Close() {
 	defer func() {
 		if r.ws != nil && r.ws.rpc != nil {
 			r.ws.rpc.Close()
 		}
 	}()
+	if r.budget != nil {
+		budgets.unregister(r.budget)
+	}
+	r.closeRecording()
 	r.cancelInflightRequests()
 	r.UnsubscribeAllExcept()
 	r.chainInfoLock.Lock()
@@ -268,6 +332,15 @@ func (r *RPCClient) Close() {
 	r.chainInfoLock.Unlock()
 }
 
+// closeRecording stops recording the requests of the client, if they are recorded.
+func (r *RPCClient) closeRecording() {
+	if recorder, ok := r.httpTransport.(*rpcRecorder); ok {
+		if err := recorder.Close(); err != nil {
+			r.rpcLog.Warnw("Failed to close RPC recording", "err", err)
+		}
+	}
+}
+
 // cancelInflightRequests closes and replaces the chStopInFlight
 func (r *RPCClient) cancelInflightRequests() {
 	r.stateMu.Lock()
@@ -317,6 +390,22 @@ func (r *RPCClient) logResult(
 			callName,                       // rpc call name
 		).
 		Observe(float64(callDuration))
+	if !errors.Is(err, context.Canceled) {
+		// Calls canceled by the caller say nothing about the RPC
+		r.callStats.Record(callDuration, callFailed(err))
+	}
+}
+
+// callFailed returns true if the RPC did not answer the call, as opposed to answering it with a JSON-RPC error
+// such as a reverted call or a rejected transaction.
+func callFailed(err error) bool {
+	var rpcErr rpc.Error
+	return err != nil && !errors.As(err, &rpcErr)
+}
+
+// CallStats returns the rolling latency and error rate of the calls made to the RPC.
+func (r *RPCClient) CallStats() commonclient.NodeScore {
+	return r.callStats.Score()
 }
 
 func (r *RPCClient) getRPCDomain() string {
@@ -463,7 +552,7 @@ func (r *RPCClient) SubscribeToHeads(ctx context.Context) (ch <-chan *evmtypes.H
 			if isHealthCheckRequest {
 				ctx = commonclient.CtxAddHealthCheckFlag(ctx)
 			}
-			return r.latestBlock(ctx)
+			return r.latestBlock(CtxWithRPCPriority(ctx, RPCPriorityHigh))
 		}, timeout, r.rpcLog)
 		if err = poller.Start(ctx); err != nil {
 			return nil, nil, err
@@ -496,6 +585,9 @@ func (r *RPCClient) SubscribeToHeads(ctx context.Context) (ch <-chan *evmtypes.H
 		return head
 	}, r.wrapRPCClientError)
 
+	if err = r.waitBudget(ctx, RPCPriorityHigh, "eth_subscribe"); err != nil {
+		return nil, nil, err
+	}
 	err = forwarder.start(ws.rpc.EthSubscribe(ctx, forwarder.srcCh, args...))
 	if err != nil {
 		return nil, nil, err
@@ -523,7 +615,7 @@ func (r *RPCClient) SubscribeToFinalizedHeads(ctx context.Context) (<-chan *evmt
 		if isHealthCheckRequest {
 			ctx = commonclient.CtxAddHealthCheckFlag(ctx)
 		}
-		return r.LatestFinalizedBlock(ctx)
+		return r.LatestFinalizedBlock(CtxWithRPCPriority(ctx, RPCPriorityHigh))
 	}, timeout, r.rpcLog)
 	if err := poller.Start(ctx); err != nil {
 		return nil, nil, err
@@ -668,6 +760,29 @@ func (r *RPCClient) LatestFinalizedBlock(ctx context.Context) (head *evmtypes.He
 	return
 }
 
+func (r *RPCClient) LatestSafeBlock(ctx context.Context) (head *evmtypes.Head, err error) {
+	ctx, cancel, _, _, _ := r.acquireQueryCtx(ctx, r.rpcTimeout)
+	defer cancel()
+	if r.chainType == chaintype.ChainAstar {
+		// astar does not support the safe tag, fallback to their custom request for the latest finalized block
+		err = r.astarLatestFinalizedBlock(ctx, &head)
+	} else {
+		err = r.ethGetBlockByNumber(ctx, rpc.SafeBlockNumber.String(), &head)
+	}
+
+	if err != nil {
+		return
+	}
+
+	if head == nil {
+		err = r.wrapRPCClientError(ethereum.NotFound)
+		return
+	}
+
+	head.EVMChainID = ubig.New(r.chainID)
+	return
+}
+
 func (r *RPCClient) latestBlock(ctx context.Context) (head *evmtypes.Head, err error) {
 	return r.BlockByNumber(ctx, nil)
 }
@@ -1229,6 +1344,9 @@ func (r *RPCClient) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQu
 		err = r.wrapWS(err)
 	}()
 	sub := newSubForwarder(ch, nil, r.wrapRPCClientError)
+	if err = r.waitBudget(ctx, rpcPriority(ctx, nil), "eth_subscribe"); err != nil {
+		return nil, err
+	}
 	err = sub.start(ws.geth.SubscribeFilterLogs(ctx, q, sub.srcCh))
 	if err != nil {
 		return
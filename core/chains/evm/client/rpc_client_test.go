@@ -91,6 +91,13 @@ func TestRPCClient_SubscribeToHeads(t *testing.T) {
 		require.Equal(t, errors.New("cannot dial rpc client when both ws and http info are missing"), rpcClient.Dial(ctx))
 	})
 
+	t.Run("Budget requires an HTTP URL", func(t *testing.T) {
+		server := testutils.NewWSServer(t, chainId, serverCallBack)
+		rpcClient := client.NewRPCClient(nodePoolCfgWSSub, lggr, server.WSURL(), nil, "rpc", 1, chainId, commonclient.Primary, commonclient.QueryTimeout, commonclient.QueryTimeout, "")
+		rpcClient.LimitTo(10, 0)
+		require.ErrorContains(t, rpcClient.Dial(ctx), "cannot dial rpc client with a budget but without http info")
+	})
+
 	t.Run("Updates chain info on new blocks", func(t *testing.T) {
 		server := testutils.NewWSServer(t, chainId, serverCallBack)
 		wsURL := server.WSURL()
@@ -678,3 +685,39 @@ func TestAstarCustomFinality(t *testing.T) {
 		})
 	}
 }
+
+func TestRPCClient_CallStats(t *testing.T) {
+	t.Parallel()
+	ctx := tests.Context(t)
+
+	chainId := big.NewInt(123456)
+	server := testutils.NewWSServer(t, chainId, func(method string, params gjson.Result) (resp testutils.JSONRPCResponse) {
+		switch method {
+		case "web3_clientVersion":
+			resp.Result = `"test"`
+		case "eth_call":
+			resp.Error.Code = 3
+			resp.Error.Message = "execution reverted"
+		}
+		return
+	}).WSURL()
+
+	rpc := client.NewRPCClient(client.TestNodePoolConfig{}, logger.Test(t), server, nil, "rpc", 1, chainId, commonclient.Primary, commonclient.QueryTimeout, commonclient.QueryTimeout, "")
+	require.NoError(t, rpc.Dial(ctx))
+	defer rpc.Close()
+
+	_, err := rpc.ClientVersion(ctx)
+	require.NoError(t, err)
+	err = rpc.CallContext(ctx, new(string), "eth_call")
+	require.ErrorContains(t, err, "execution reverted")
+
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = rpc.ClientVersion(canceledCtx)
+	require.ErrorIs(t, err, context.Canceled)
+
+	stats := rpc.CallStats()
+	assert.Equal(t, uint64(2), stats.Samples, "calls canceled by the caller are not recorded")
+	assert.Zero(t, stats.ErrorRate, "JSON-RPC errors are answers from the RPC")
+	assert.Positive(t, stats.Latency)
+}
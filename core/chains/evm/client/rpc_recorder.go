@@ -0,0 +1,259 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RPCRecord is a JSON-RPC request sent to a node over HTTP and its response, as written by RPCClient.RecordTo.
+// Batch requests are recorded as a single record.
+type RPCRecord struct {
+	Node         string          `json:"node"`
+	RequestTime  time.Time       `json:"requestTime"`
+	ResponseTime time.Time       `json:"responseTime"`
+	Request      json.RawMessage `json:"request"`
+	Response     json.RawMessage `json:"response,omitempty"`
+	// StatusCode is the HTTP status of the response, if one was received.
+	StatusCode int `json:"statusCode,omitempty"`
+	// Error is set if no response was received, or if its status is not 200 OK.
+	Error string `json:"error,omitempty"`
+}
+
+// ReadRPCRecords reads the records written by RPCClient.RecordTo.
+func ReadRPCRecords(r io.Reader) ([]RPCRecord, error) {
+	var records []RPCRecord
+	dec := json.NewDecoder(r)
+	for {
+		var record RPCRecord
+		if err := dec.Decode(&record); errors.Is(err, io.EOF) {
+			return records, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to read RPC record %d: %w", len(records), err)
+		}
+		records = append(records, record)
+	}
+}
+
+// rpcRecorder is an http.RoundTripper writing every request and its response to w as an RPCRecord.
+type rpcRecorder struct {
+	node string
+	next http.RoundTripper
+	w    io.Writer
+
+	mu     sync.Mutex
+	enc    *json.Encoder
+	closed bool
+}
+
+func newRPCRecorder(node string, w io.Writer, next http.RoundTripper) *rpcRecorder {
+	return &rpcRecorder{node: node, next: next, w: w, enc: json.NewEncoder(w)}
+}
+
+// Close stops the recording, and closes w if it is an io.Closer.
+func (r *rpcRecorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+	if c, ok := r.w.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+func (r *rpcRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	record := RPCRecord{Node: r.node, RequestTime: time.Now()}
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		record.Request = body
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		record.ResponseTime = time.Now()
+		record.Error = err.Error()
+		r.write(record)
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	record.ResponseTime = time.Now()
+	record.StatusCode = resp.StatusCode
+	if err != nil {
+		record.Error = err.Error()
+		r.write(record)
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	if resp.StatusCode == http.StatusOK && json.Valid(body) {
+		record.Response = body
+	} else {
+		record.Error = fmt.Sprintf("%s: %s", resp.Status, body)
+	}
+	r.write(record)
+	return resp, nil
+}
+
+func (r *rpcRecorder) write(record RPCRecord) {
+	if !json.Valid(record.Request) {
+		record.Request = nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return
+	}
+	// The recording is a debugging aid, so failing to write it must not fail the call
+	_ = r.enc.Encode(record)
+}
+
+// replayTransport is an http.RoundTripper answering requests with the responses of the matching RPCRecords.
+// Requests match a record if they are equal to its request but for their ids. Records of the same request are replayed
+// in the order they were recorded, and the last one is repeated once they are all replayed.
+type replayTransport struct {
+	mu      sync.Mutex
+	records map[string][]RPCRecord
+}
+
+func newReplayTransport(records []RPCRecord) (*replayTransport, error) {
+	t := &replayTransport{records: map[string][]RPCRecord{}}
+	for i, record := range records {
+		key, _, err := replayKey(record.Request)
+		if err != nil {
+			return nil, fmt.Errorf("invalid request in RPC record %d: %w", i, err)
+		}
+		t.records[key] = append(t.records[key], record)
+	}
+	return t, nil
+}
+
+func (t *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	key, ids, err := replayKey(body)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON-RPC request: %w", err)
+	}
+
+	record, ok := t.next(key)
+	if !ok {
+		return nil, fmt.Errorf("no recorded response for request %s", body)
+	}
+	if record.Response == nil {
+		if record.StatusCode == 0 {
+			return nil, errors.New(record.Error)
+		}
+		return newReplayResponse(req, record.StatusCode, []byte(record.Error)), nil
+	}
+
+	// The ids of the replayed request are different from the recorded ones, so the response has to use them instead
+	_, recordedIDs, err := replayKey(record.Request)
+	if err != nil {
+		return nil, err
+	}
+	response, err := replaceIDs(record.Response, recordedIDs, ids)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recorded response %s: %w", record.Response, err)
+	}
+	return newReplayResponse(req, http.StatusOK, response), nil
+}
+
+func (t *replayTransport) next(key string) (RPCRecord, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	records := t.records[key]
+	if len(records) == 0 {
+		return RPCRecord{}, false
+	}
+	if len(records) > 1 {
+		t.records[key] = records[1:]
+	}
+	return records[0], true
+}
+
+func newReplayResponse(req *http.Request, statusCode int, body []byte) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// replayKey returns the request without the ids of its messages, and those ids in order.
+func replayKey(request json.RawMessage) (string, []string, error) {
+	msgs, batch, err := splitMessages(request)
+	if err != nil {
+		return "", nil, err
+	}
+	ids := make([]string, len(msgs))
+	for i, msg := range msgs {
+		ids[i] = string(msg["id"])
+		delete(msg, "id")
+	}
+	// Maps are encoded with sorted keys, so that equal requests have the same key
+	var key []byte
+	if batch {
+		key, err = json.Marshal(msgs)
+	} else {
+		key, err = json.Marshal(msgs[0])
+	}
+	return string(key), ids, err
+}
+
+// replaceIDs returns the response with the ids of its messages in from replaced by the ones at the same position in to.
+func replaceIDs(response json.RawMessage, from, to []string) (json.RawMessage, error) {
+	msgs, batch, err := splitMessages(response)
+	if err != nil {
+		return nil, err
+	}
+	replacements := make(map[string]string, len(from))
+	for i := range min(len(from), len(to)) {
+		replacements[from[i]] = to[i]
+	}
+	for _, msg := range msgs {
+		if id, ok := replacements[string(msg["id"])]; ok {
+			msg["id"] = json.RawMessage(id)
+		}
+	}
+	if batch {
+		return json.Marshal(msgs)
+	}
+	return json.Marshal(msgs[0])
+}
+
+// splitMessages decodes a single JSON-RPC message or a batch of them.
+func splitMessages(raw json.RawMessage) (msgs []map[string]json.RawMessage, batch bool, err error) {
+	if strings.HasPrefix(strings.TrimSpace(string(raw)), "[") {
+		err = json.Unmarshal(raw, &msgs)
+		return msgs, true, err
+	}
+	var msg map[string]json.RawMessage
+	if err = json.Unmarshal(raw, &msg); err != nil {
+		return nil, false, err
+	}
+	return []map[string]json.RawMessage{msg}, false, nil
+}
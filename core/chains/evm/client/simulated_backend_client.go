@@ -569,6 +569,9 @@ func (c *SimulatedBackendClient) Backend() evmtypes.Backend {
 // NodeStates implements evmclient.Client
 func (c *SimulatedBackendClient) NodeStates() map[string]string { return nil }
 
+// NodeScores implements evmclient.Client
+func (c *SimulatedBackendClient) NodeScores() map[string]commonclient.NodeScore { return nil }
+
 // Commit imports all the pending transactions as a single block and starts a
 // fresh new state.
 func (c *SimulatedBackendClient) Commit() common.Hash {
@@ -762,6 +765,16 @@ func (c *SimulatedBackendClient) LatestFinalizedBlock(ctx context.Context) (*evm
 	return head, nil
 }
 
+func (c *SimulatedBackendClient) LatestSafeBlock(ctx context.Context) (*evmtypes.Head, error) {
+	h, err := c.client.HeaderByNumber(ctx, big.NewInt(rpc.SafeBlockNumber.Int64()))
+	if err != nil {
+		return nil, err
+	}
+	head := &evmtypes.Head{EVMChainID: ubig.New(c.chainID)}
+	head.SetFromHeader(h)
+	return head, nil
+}
+
 func (c *SimulatedBackendClient) ethGetLogs(ctx context.Context, result interface{}, args ...interface{}) error {
 	var from, to *big.Int
 	var hash *common.Hash
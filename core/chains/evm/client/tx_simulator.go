@@ -1,9 +1,13 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -54,3 +58,54 @@ func toCallArg(msg ethereum.CallMsg) interface{} {
 	}
 	return arg
 }
+
+// ExtractRevertData returns the data a simulated call reverted with, from the Data field of jErr. RPCs return it as a
+// hex string, sometimes after a prefix like "Reverted ".
+func ExtractRevertData(jErr *JsonError) ([]byte, bool) {
+	if jErr == nil {
+		return nil, false
+	}
+	data, ok := jErr.Data.(string)
+	if !ok {
+		return nil, false
+	}
+	i := strings.Index(data, "0x")
+	if i < 0 {
+		return nil, false
+	}
+	revertData, err := hexutil.Decode(data[i:])
+	if err != nil {
+		return nil, false
+	}
+	return revertData, true
+}
+
+// DecodeRevertReason decodes the reason of a revert with data. Error(string) and Panic(uint256) reverts are always
+// decoded, custom errors only if they are defined in one of errorABIs. It returns false if data could not be decoded.
+func DecodeRevertReason(data []byte, errorABIs ...abi.ABI) (string, bool) {
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason, true
+	}
+	if len(data) < 4 {
+		return "", false
+	}
+	for _, errorABI := range errorABIs {
+		for _, customErr := range errorABI.Errors {
+			if !bytes.Equal(customErr.ID[:4], data[:4]) {
+				continue
+			}
+			unpacked, err := customErr.Unpack(data)
+			if err != nil {
+				continue
+			}
+			args := make([]string, 0, len(customErr.Inputs))
+			if values, ok := unpacked.([]interface{}); ok {
+				for _, value := range values {
+					args = append(args, fmt.Sprintf("%v", value))
+				}
+			}
+			return fmt.Sprintf("%s(%s)", customErr.Name, strings.Join(args, ", ")), true
+		}
+	}
+	return "", false
+}
@@ -1,9 +1,14 @@
 package client_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tidwall/gjson"
 
@@ -111,3 +116,45 @@ func TestSimulateTx_Default(t *testing.T) {
 		require.Equal(t, false, sendErr.IsTerminallyStuckConfigError(nil))
 	})
 }
+
+func TestDecodeRevertReason(t *testing.T) {
+	t.Parallel()
+
+	errorABI, err := abi.JSON(strings.NewReader(`[{"type":"error","name":"Unauthorized","inputs":[{"name":"caller","type":"address"},{"name":"code","type":"uint8"}]}]`))
+	require.NoError(t, err)
+	caller := common.HexToAddress("0xfe0629509E6CB8dfa7a99214ae58Ceb465d5b5A9")
+	args, err := errorABI.Errors["Unauthorized"].Inputs.Pack(caller, uint8(7))
+	require.NoError(t, err)
+	customErr := append(errorABI.Errors["Unauthorized"].ID.Bytes()[:4], args...)
+
+	t.Run("decodes Error(string)", func(t *testing.T) {
+		// Error("boom")
+		data := hexutil.MustDecode("0x08c379a000000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000004626f6f6d00000000000000000000000000000000000000000000000000000000")
+		reason, ok := client.DecodeRevertReason(data)
+		require.True(t, ok)
+		assert.Equal(t, "boom", reason)
+	})
+
+	t.Run("decodes custom errors of the given ABIs", func(t *testing.T) {
+		reason, ok := client.DecodeRevertReason(customErr, errorABI)
+		require.True(t, ok)
+		assert.Equal(t, "Unauthorized(0xfe0629509E6CB8dfa7a99214ae58Ceb465d5b5A9, 7)", reason)
+	})
+
+	t.Run("does not decode unknown custom errors", func(t *testing.T) {
+		_, ok := client.DecodeRevertReason(customErr)
+		assert.False(t, ok)
+		_, ok = client.DecodeRevertReason([]byte{1, 2})
+		assert.False(t, ok)
+	})
+
+	t.Run("extracts revert data from RPC errors", func(t *testing.T) {
+		for _, data := range []interface{}{hexutil.Encode(customErr), "Reverted " + hexutil.Encode(customErr)} {
+			revertData, ok := client.ExtractRevertData(&client.JsonError{Code: 3, Message: "execution reverted", Data: data})
+			require.True(t, ok)
+			assert.Equal(t, customErr, revertData)
+		}
+		_, ok := client.ExtractRevertData(&client.JsonError{Code: 3, Message: "execution reverted"})
+		assert.False(t, ok)
+	})
+}
@@ -1,6 +1,10 @@
 package config
 
-import "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
 
 type balanceMonitorConfig struct {
 	c toml.BalanceMonitor
@@ -9,3 +13,35 @@ type balanceMonitorConfig struct {
 func (b *balanceMonitorConfig) Enabled() bool {
 	return *b.c.Enabled
 }
+
+func (b *balanceMonitorConfig) LowBalanceThreshold() *assets.Wei {
+	return b.c.LowBalanceThreshold
+}
+
+func (b *balanceMonitorConfig) TopUp() TopUp {
+	return &topUpConfig{c: b.c.TopUp}
+}
+
+type topUpConfig struct {
+	c toml.TopUpConfig
+}
+
+func (t *topUpConfig) TreasuryAddress() *types.EIP55Address {
+	return t.c.TreasuryAddress
+}
+
+func (t *topUpConfig) Threshold() *assets.Wei {
+	return t.c.Threshold
+}
+
+func (t *topUpConfig) Target() *assets.Wei {
+	return t.c.Target
+}
+
+func (t *topUpConfig) DailySpendCap() *assets.Wei {
+	return t.c.DailySpendCap
+}
+
+func (t *topUpConfig) DryRun() bool {
+	return t.c.DryRun != nil && *t.c.DryRun
+}
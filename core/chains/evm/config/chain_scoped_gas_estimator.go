@@ -43,6 +43,10 @@ func (g *gasEstimatorConfig) FeeHistory() FeeHistory {
 	return &feeHistoryConfig{c: g.c.FeeHistory}
 }
 
+func (g *gasEstimatorConfig) Composite() Composite {
+	return &compositeConfig{c: g.c.Composite}
+}
+
 func (g *gasEstimatorConfig) DAOracle() DAOracle {
 	return &daOracleConfig{c: g.c.DAOracle}
 }
@@ -216,3 +220,23 @@ type feeHistoryConfig struct {
 func (u *feeHistoryConfig) CacheTimeout() time.Duration {
 	return u.c.CacheTimeout.Duration()
 }
+
+type compositeConfig struct {
+	c toml.CompositeEstimator
+}
+
+func (c *compositeConfig) Modes() []string {
+	return *c.c.Modes
+}
+
+func (c *compositeConfig) Percentile() uint8 {
+	return *c.c.Percentile
+}
+
+func (c *compositeConfig) HistorySize() uint32 {
+	return *c.c.HistorySize
+}
+
+func (c *compositeConfig) BandPercent() uint16 {
+	return *c.c.BandPercent
+}
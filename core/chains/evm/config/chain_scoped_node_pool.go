@@ -51,3 +51,27 @@ func (n *NodePoolConfig) EnforceRepeatableRead() bool {
 func (n *NodePoolConfig) DeathDeclarationDelay() time.Duration {
 	return n.C.DeathDeclarationDelay.Duration()
 }
+
+func (n *NodePoolConfig) CriticalReads() CriticalReads {
+	return &criticalReadsConfig{c: n.C.CriticalReads}
+}
+
+type criticalReadsConfig struct {
+	c toml.CriticalReads
+}
+
+func (c *criticalReadsConfig) Mode() string {
+	return *c.c.Mode
+}
+
+func (c *criticalReadsConfig) HedgePercentile() uint8 {
+	return *c.c.HedgePercentile
+}
+
+func (c *criticalReadsConfig) QuorumNodes() uint32 {
+	return *c.c.QuorumNodes
+}
+
+func (c *criticalReadsConfig) QuorumAgreement() uint32 {
+	return *c.c.QuorumAgreement
+}
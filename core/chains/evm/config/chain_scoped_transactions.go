@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 )
 
 type transactionsConfig struct {
@@ -58,3 +59,74 @@ func (a *autoPurgeConfig) MinAttempts() *uint32 {
 func (a *autoPurgeConfig) DetectionApiUrl() *url.URL {
 	return a.c.DetectionApiUrl.URL()
 }
+
+func (t *transactionsConfig) PrivateSubmission() PrivateSubmissionConfig {
+	return &privateSubmissionConfig{c: t.c.PrivateSubmission}
+}
+
+func (t *transactionsConfig) PrivateSubmissionFallbackBlocks() uint32 {
+	return t.PrivateSubmission().FallbackBlocks()
+}
+
+type privateSubmissionConfig struct {
+	c toml.PrivateSubmissionConfig
+}
+
+func (p *privateSubmissionConfig) URL() *url.URL {
+	if p.c.URL == nil || p.c.URL.IsZero() {
+		return nil
+	}
+	return p.c.URL.URL()
+}
+
+func (p *privateSubmissionConfig) Method() string {
+	if p.c.Method == nil || *p.c.Method == "" {
+		return toml.PrivateSubmissionMethodPrivateTransaction
+	}
+	return *p.c.Method
+}
+
+func (p *privateSubmissionConfig) FallbackBlocks() uint32 {
+	if p.c.FallbackBlocks == nil {
+		return 0
+	}
+	return *p.c.FallbackBlocks
+}
+
+func (p *privateSubmissionConfig) SigningAddress() *types.EIP55Address {
+	return p.c.SigningAddress
+}
+
+func (t *transactionsConfig) NonceReconciliation() NonceReconciliationConfig {
+	return &nonceReconciliationConfig{c: t.c.NonceReconciliation}
+}
+
+type nonceReconciliationConfig struct {
+	c toml.NonceReconciliationConfig
+}
+
+func (n *nonceReconciliationConfig) Interval() time.Duration {
+	if n.c.Interval == nil {
+		return 0
+	}
+	return n.c.Interval.Duration()
+}
+
+func (t *transactionsConfig) KeyPool() KeyPoolConfig {
+	return &keyPoolConfig{c: t.c.KeyPool}
+}
+
+type keyPoolConfig struct {
+	c toml.KeyPoolConfig
+}
+
+func (k *keyPoolConfig) Enabled() bool {
+	return k.c.Enabled != nil && *k.c.Enabled
+}
+
+func (k *keyPoolConfig) MaxInFlightPerKey() uint32 {
+	if k.c.MaxInFlightPerKey == nil {
+		return 0
+	}
+	return *k.c.MaxInFlightPerKey
+}
@@ -82,6 +82,16 @@ type HeadTracker interface {
 
 type BalanceMonitor interface {
 	Enabled() bool
+	LowBalanceThreshold() *assets.Wei
+	TopUp() TopUp
+}
+
+type TopUp interface {
+	TreasuryAddress() *types.EIP55Address
+	Threshold() *assets.Wei
+	Target() *assets.Wei
+	DailySpendCap() *assets.Wei
+	DryRun() bool
 }
 
 type ClientErrors interface {
@@ -110,6 +120,10 @@ type Transactions interface {
 	MaxInFlight() uint32
 	MaxQueued() uint64
 	AutoPurge() AutoPurgeConfig
+	PrivateSubmission() PrivateSubmissionConfig
+	PrivateSubmissionFallbackBlocks() uint32
+	NonceReconciliation() NonceReconciliationConfig
+	KeyPool() KeyPoolConfig
 }
 
 type AutoPurgeConfig interface {
@@ -119,9 +133,26 @@ type AutoPurgeConfig interface {
 	DetectionApiUrl() *url.URL
 }
 
+type PrivateSubmissionConfig interface {
+	URL() *url.URL
+	Method() string
+	FallbackBlocks() uint32
+	SigningAddress() *types.EIP55Address
+}
+
+type NonceReconciliationConfig interface {
+	Interval() time.Duration
+}
+
+type KeyPoolConfig interface {
+	Enabled() bool
+	MaxInFlightPerKey() uint32
+}
+
 type GasEstimator interface {
 	BlockHistory() BlockHistory
 	FeeHistory() FeeHistory
+	Composite() Composite
 	LimitJobType() LimitJobType
 
 	EIP1559DynamicFees() bool
@@ -174,6 +205,13 @@ type FeeHistory interface {
 	CacheTimeout() time.Duration
 }
 
+type Composite interface {
+	Modes() []string
+	Percentile() uint8
+	HistorySize() uint32
+	BandPercent() uint16
+}
+
 type Workflow interface {
 	FromAddress() *types.EIP55Address
 	ForwarderAddress() *types.EIP55Address
@@ -192,6 +230,14 @@ type NodePool interface {
 	EnforceRepeatableRead() bool
 	DeathDeclarationDelay() time.Duration
 	NewHeadsPollInterval() time.Duration
+	CriticalReads() CriticalReads
+}
+
+type CriticalReads interface {
+	Mode() string
+	HedgePercentile() uint8
+	QuorumNodes() uint32
+	QuorumAgreement() uint32
 }
 
 // TODO BCF-2509 does the chainscopedconfig really need the entire app config?
@@ -17,6 +17,7 @@ import (
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
 
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -468,6 +469,11 @@ func (c *Chain) ValidateConfig() (err error) {
 		}
 	}
 
+	if c.BalanceMonitor.TopUp.TreasuryAddress != nil && c.BalanceMonitor.Enabled != nil && !*c.BalanceMonitor.Enabled {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BalanceMonitor.TopUp.TreasuryAddress", Value: c.BalanceMonitor.TopUp.TreasuryAddress.String(),
+			Msg: "cannot be set if BalanceMonitor is disabled"})
+	}
+
 	return
 }
 
@@ -479,7 +485,10 @@ type Transactions struct {
 	ReaperThreshold      *commonconfig.Duration
 	ResendAfterThreshold *commonconfig.Duration
 
-	AutoPurge AutoPurgeConfig `toml:",omitempty"`
+	AutoPurge           AutoPurgeConfig           `toml:",omitempty"`
+	PrivateSubmission   PrivateSubmissionConfig   `toml:",omitempty"`
+	NonceReconciliation NonceReconciliationConfig `toml:",omitempty"`
+	KeyPool             KeyPoolConfig             `toml:",omitempty"`
 }
 
 func (t *Transactions) setFrom(f *Transactions) {
@@ -502,6 +511,9 @@ func (t *Transactions) setFrom(f *Transactions) {
 		t.ResendAfterThreshold = v
 	}
 	t.AutoPurge.setFrom(&f.AutoPurge)
+	t.PrivateSubmission.setFrom(&f.PrivateSubmission)
+	t.NonceReconciliation.setFrom(&f.NonceReconciliation)
+	t.KeyPool.setFrom(&f.KeyPool)
 }
 
 type AutoPurgeConfig struct {
@@ -526,6 +538,76 @@ func (a *AutoPurgeConfig) setFrom(f *AutoPurgeConfig) {
 	}
 }
 
+type PrivateSubmissionConfig struct {
+	URL            *commonconfig.URL
+	Method         *string
+	FallbackBlocks *uint32
+	SigningAddress *types.EIP55Address
+}
+
+func (p *PrivateSubmissionConfig) setFrom(f *PrivateSubmissionConfig) {
+	if v := f.URL; v != nil {
+		p.URL = v
+	}
+	if v := f.Method; v != nil {
+		p.Method = v
+	}
+	if v := f.FallbackBlocks; v != nil {
+		p.FallbackBlocks = v
+	}
+	if v := f.SigningAddress; v != nil {
+		p.SigningAddress = v
+	}
+}
+
+func (p *PrivateSubmissionConfig) ValidateConfig() (err error) {
+	if p.URL != nil && !p.URL.IsZero() {
+		switch p.URL.Scheme {
+		case "http", "https":
+		default:
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "URL", Value: p.URL.Scheme, Msg: "must be http or https"})
+		}
+	}
+	if p.Method != nil {
+		switch *p.Method {
+		case "", PrivateSubmissionMethodPrivateTransaction, PrivateSubmissionMethodBundle:
+		default:
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Method", Value: *p.Method,
+				Msg: fmt.Sprintf("must be %s or %s", PrivateSubmissionMethodPrivateTransaction, PrivateSubmissionMethodBundle)})
+		}
+	}
+	return
+}
+
+const (
+	PrivateSubmissionMethodPrivateTransaction = "eth_sendPrivateTransaction"
+	PrivateSubmissionMethodBundle             = "eth_sendBundle"
+)
+
+type NonceReconciliationConfig struct {
+	Interval *commonconfig.Duration
+}
+
+func (n *NonceReconciliationConfig) setFrom(f *NonceReconciliationConfig) {
+	if v := f.Interval; v != nil {
+		n.Interval = v
+	}
+}
+
+type KeyPoolConfig struct {
+	Enabled           *bool
+	MaxInFlightPerKey *uint32
+}
+
+func (k *KeyPoolConfig) setFrom(f *KeyPoolConfig) {
+	if v := f.Enabled; v != nil {
+		k.Enabled = v
+	}
+	if v := f.MaxInFlightPerKey; v != nil {
+		k.MaxInFlightPerKey = v
+	}
+}
+
 type OCR2 struct {
 	Automation Automation `toml:",omitempty"`
 }
@@ -564,13 +646,67 @@ func (m *Workflow) setFrom(f *Workflow) {
 }
 
 type BalanceMonitor struct {
-	Enabled *bool
+	Enabled             *bool
+	LowBalanceThreshold *assets.Wei
+	TopUp               TopUpConfig `toml:",omitempty"`
 }
 
 func (m *BalanceMonitor) setFrom(f *BalanceMonitor) {
 	if v := f.Enabled; v != nil {
 		m.Enabled = v
 	}
+	if v := f.LowBalanceThreshold; v != nil {
+		m.LowBalanceThreshold = v
+	}
+	m.TopUp.setFrom(&f.TopUp)
+}
+
+type TopUpConfig struct {
+	TreasuryAddress *types.EIP55Address
+	Threshold       *assets.Wei
+	Target          *assets.Wei
+	DailySpendCap   *assets.Wei
+	DryRun          *bool
+}
+
+func (t *TopUpConfig) setFrom(f *TopUpConfig) {
+	if v := f.TreasuryAddress; v != nil {
+		t.TreasuryAddress = v
+	}
+	if v := f.Threshold; v != nil {
+		t.Threshold = v
+	}
+	if v := f.Target; v != nil {
+		t.Target = v
+	}
+	if v := f.DailySpendCap; v != nil {
+		t.DailySpendCap = v
+	}
+	if v := f.DryRun; v != nil {
+		t.DryRun = v
+	}
+}
+
+func (t *TopUpConfig) ValidateConfig() (err error) {
+	if t.TreasuryAddress == nil {
+		return
+	}
+	if t.Threshold == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "Threshold", Msg: "required when TreasuryAddress is set"})
+	}
+	if t.Target == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "Target", Msg: "required when TreasuryAddress is set"})
+	} else if t.Threshold != nil && t.Target.Cmp(t.Threshold) <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Target", Value: t.Target.String(),
+			Msg: "must be greater than Threshold"})
+	}
+	if t.DailySpendCap == nil {
+		err = multierr.Append(err, commonconfig.ErrMissing{Name: "DailySpendCap", Msg: "required when TreasuryAddress is set"})
+	} else if t.Target != nil && t.DailySpendCap.Cmp(t.Target) < 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "DailySpendCap", Value: t.DailySpendCap.String(),
+			Msg: "must be greater than or equal to Target"})
+	}
+	return
 }
 
 type GasEstimator struct {
@@ -600,6 +736,7 @@ type GasEstimator struct {
 
 	BlockHistory BlockHistoryEstimator `toml:",omitempty"`
 	FeeHistory   FeeHistoryEstimator   `toml:",omitempty"`
+	Composite    CompositeEstimator    `toml:",omitempty"`
 	DAOracle     DAOracle              `toml:",omitempty"`
 }
 
@@ -637,6 +774,14 @@ func (e *GasEstimator) ValidateConfig() (err error) {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BlockHistory.BlockHistorySize", Value: *e.BlockHistory.BlockHistorySize,
 			Msg: "must be greater than or equal to 1 with BlockHistory Mode"})
 	}
+	if *e.Mode == "Composite" {
+		if len(*e.Composite.Modes) == 0 {
+			err = multierr.Append(err, commonconfig.ErrEmpty{Name: "Composite.Modes", Msg: "required with Composite Mode"})
+		} else if slices.Contains(*e.Composite.Modes, "BlockHistory") && *e.BlockHistory.BlockHistorySize <= 0 {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BlockHistory.BlockHistorySize", Value: *e.BlockHistory.BlockHistorySize,
+				Msg: "must be greater than or equal to 1 with BlockHistory in Composite.Modes"})
+		}
+	}
 
 	return
 }
@@ -696,6 +841,7 @@ func (e *GasEstimator) setFrom(f *GasEstimator) {
 	e.LimitJobType.setFrom(&f.LimitJobType)
 	e.BlockHistory.setFrom(&f.BlockHistory)
 	e.FeeHistory.setFrom(&f.FeeHistory)
+	e.Composite.setFrom(&f.Composite)
 	e.DAOracle.setFrom(&f.DAOracle)
 }
 
@@ -769,6 +915,45 @@ func (u *FeeHistoryEstimator) setFrom(f *FeeHistoryEstimator) {
 	}
 }
 
+type CompositeEstimator struct {
+	Modes       *[]string
+	Percentile  *uint8
+	HistorySize *uint32
+	BandPercent *uint16
+}
+
+func (c *CompositeEstimator) setFrom(f *CompositeEstimator) {
+	if v := f.Modes; v != nil {
+		c.Modes = v
+	}
+	if v := f.Percentile; v != nil {
+		c.Percentile = v
+	}
+	if v := f.HistorySize; v != nil {
+		c.HistorySize = v
+	}
+	if v := f.BandPercent; v != nil {
+		c.BandPercent = v
+	}
+}
+
+func (c *CompositeEstimator) ValidateConfig() (err error) {
+	if c.Modes != nil {
+		for i, mode := range *c.Modes {
+			switch mode {
+			case "Arbitrum", "BlockHistory", "FeeHistory", "FixedPrice", "L2Suggested", "SuggestedPrice":
+			default:
+				err = multierr.Append(err, commonconfig.ErrInvalid{Name: fmt.Sprintf("Modes[%d]", i), Value: mode,
+					Msg: "must be one of Arbitrum, BlockHistory, FeeHistory, FixedPrice, L2Suggested or SuggestedPrice"})
+			}
+		}
+	}
+	if c.Percentile != nil && (*c.Percentile < 1 || *c.Percentile > 100) {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Percentile", Value: *c.Percentile, Msg: "must be between 1 and 100"})
+	}
+	return
+}
+
 type DAOracle struct {
 	OracleType             *DAOracleType
 	OracleAddress          *types.EIP55Address
@@ -972,6 +1157,7 @@ type NodePool struct {
 	EnforceRepeatableRead      *bool
 	DeathDeclarationDelay      *commonconfig.Duration
 	NewHeadsPollInterval       *commonconfig.Duration
+	CriticalReads              CriticalReads
 }
 
 func (p *NodePool) setFrom(f *NodePool) {
@@ -1010,6 +1196,7 @@ func (p *NodePool) setFrom(f *NodePool) {
 	}
 
 	p.Errors.setFrom(&f.Errors)
+	p.CriticalReads.setFrom(&f.CriticalReads)
 }
 
 func (p *NodePool) ValidateConfig(finalityTagEnabled *bool) (err error) {
@@ -1026,6 +1213,49 @@ func (p *NodePool) ValidateConfig(finalityTagEnabled *bool) (err error) {
 	return
 }
 
+type CriticalReads struct {
+	Mode            *string
+	HedgePercentile *uint8
+	QuorumNodes     *uint32
+	QuorumAgreement *uint32
+}
+
+func (r *CriticalReads) setFrom(f *CriticalReads) {
+	if v := f.Mode; v != nil {
+		r.Mode = v
+	}
+	if v := f.HedgePercentile; v != nil {
+		r.HedgePercentile = v
+	}
+	if v := f.QuorumNodes; v != nil {
+		r.QuorumNodes = v
+	}
+	if v := f.QuorumAgreement; v != nil {
+		r.QuorumAgreement = v
+	}
+}
+
+func (r *CriticalReads) ValidateConfig() (err error) {
+	if r.Mode != nil {
+		switch *r.Mode {
+		case commonclient.CriticalReadModeSingle, commonclient.CriticalReadModeHedged, commonclient.CriticalReadModeQuorum:
+		default:
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Mode", Value: *r.Mode,
+				Msg: fmt.Sprintf("must be one of %s, %s or %s", commonclient.CriticalReadModeSingle, commonclient.CriticalReadModeHedged, commonclient.CriticalReadModeQuorum)})
+		}
+	}
+	if r.HedgePercentile != nil && (*r.HedgePercentile == 0 || *r.HedgePercentile > 100) {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "HedgePercentile", Value: *r.HedgePercentile, Msg: "must be between 1 and 100"})
+	}
+	if r.QuorumAgreement != nil && r.QuorumNodes != nil {
+		if *r.QuorumAgreement*2 <= *r.QuorumNodes || *r.QuorumAgreement > *r.QuorumNodes {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "QuorumAgreement", Value: *r.QuorumAgreement,
+				Msg: fmt.Sprintf("must be a majority of QuorumNodes (%d)", *r.QuorumNodes)})
+		}
+	}
+	return
+}
+
 type OCR struct {
 	ContractConfirmations              *uint16
 	ContractTransmitterTransmitTimeout *commonconfig.Duration
@@ -1057,11 +1287,14 @@ func (o *OCR) setFrom(f *OCR) {
 }
 
 type Node struct {
-	Name     *string
-	WSURL    *commonconfig.URL
-	HTTPURL  *commonconfig.URL
-	SendOnly *bool
-	Order    *int32
+	Name                     *string
+	WSURL                    *commonconfig.URL
+	HTTPURL                  *commonconfig.URL
+	SendOnly                 *bool
+	Order                    *int32
+	RecordingPath            *string
+	MaxRequestsPerSecond     *uint32
+	MaxComputeUnitsPerSecond *uint32
 }
 
 func (n *Node) ValidateConfig() (err error) {
@@ -1118,6 +1351,15 @@ func (n *Node) SetFrom(f *Node) {
 	if f.Order != nil {
 		n.Order = f.Order
 	}
+	if f.RecordingPath != nil {
+		n.RecordingPath = f.RecordingPath
+	}
+	if f.MaxRequestsPerSecond != nil {
+		n.MaxRequestsPerSecond = f.MaxRequestsPerSecond
+	}
+	if f.MaxComputeUnitsPerSecond != nil {
+		n.MaxComputeUnitsPerSecond = f.MaxComputeUnitsPerSecond
+	}
 }
 
 func ChainIDInt64(cid string) (int64, error) {
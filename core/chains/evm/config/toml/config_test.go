@@ -29,3 +29,31 @@ func TestEVMConfig_ValidateConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestCriticalReads_ValidateConfig(t *testing.T) {
+	mode := func(s string) *string { return &s }
+	percentile := func(p uint8) *uint8 { return &p }
+	nodes := func(n uint32) *uint32 { return &n }
+
+	for _, tt := range []struct {
+		name string
+		cfg  toml.CriticalReads
+		err  string
+	}{
+		{"defaults", toml.CriticalReads{Mode: mode("Single"), HedgePercentile: percentile(90), QuorumNodes: nodes(3), QuorumAgreement: nodes(2)}, ""},
+		{"quorum", toml.CriticalReads{Mode: mode("Quorum"), QuorumNodes: nodes(5), QuorumAgreement: nodes(3)}, ""},
+		{"unknown mode", toml.CriticalReads{Mode: mode("Fastest")}, "Mode: invalid value (Fastest): must be one of Single, Hedged or Quorum"},
+		{"zero percentile", toml.CriticalReads{HedgePercentile: percentile(0)}, "HedgePercentile: invalid value (0): must be between 1 and 100"},
+		{"minority", toml.CriticalReads{QuorumNodes: nodes(4), QuorumAgreement: nodes(2)}, "QuorumAgreement: invalid value (2): must be a majority of QuorumNodes (4)"},
+		{"more than nodes", toml.CriticalReads{QuorumNodes: nodes(3), QuorumAgreement: nodes(4)}, "QuorumAgreement: invalid value (4): must be a majority of QuorumNodes (3)"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.ValidateConfig()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
@@ -2174,6 +2174,104 @@ func TestBlockHistoryEstimator_GetDynamicFee(t *testing.T) {
 	})
 }
 
+func TestBlockHistoryEstimator_GetFeeForInclusion(t *testing.T) {
+	t.Parallel()
+
+	bhCfg := newBlockHistoryConfig()
+	bhCfg.BlockHistorySizeF = uint16(10)
+	maxGasPrice := assets.NewWeiI(1000000)
+	geCfg := &gas.MockGasEstimatorConfig{}
+	geCfg.PriceMaxF = maxGasPrice
+	geCfg.PriceMinF = assets.NewWeiI(0)
+	geCfg.TipCapMinF = assets.NewWeiI(0)
+	geCfg.BumpThresholdF = uint64(1)
+
+	t.Run("legacy gas price", func(t *testing.T) {
+		bhe := newBlockHistoryEstimator(t, nil, defaultChainType, geCfg, bhCfg, rollupMocks.NewL1Oracle(t))
+		var blocks []evmtypes.Block
+		for i := int64(1); i <= 10; i++ {
+			// The lowest price of each block is the one needed to be included in it
+			blocks = append(blocks, evmtypes.Block{Number: i, Hash: utils.NewHash(), Transactions: legacyTransactionsFromGasPrices(5000, i*100)})
+		}
+		gas.SetRollingBlockHistory(bhe, blocks)
+		gas.SimulateStart(t, bhe)
+
+		for _, tc := range []struct {
+			target   gas.InclusionTarget
+			maxPrice *assets.Wei
+			expected *assets.Wei
+		}{
+			{gas.InclusionTarget{Blocks: 1, Confidence: 90}, maxGasPrice, assets.NewWeiI(900)},
+			{gas.InclusionTarget{Blocks: 3, Confidence: 90}, maxGasPrice, assets.NewWeiI(600)},
+			{gas.InclusionTarget{Blocks: 1, Confidence: 100}, maxGasPrice, assets.NewWeiI(1000)},
+			{gas.InclusionTarget{Blocks: 1, Confidence: 100}, assets.NewWeiI(800), assets.NewWeiI(800)},
+		} {
+			gasPrice, err := bhe.GetLegacyGasForInclusion(tests.Context(t), tc.target, tc.maxPrice)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, gasPrice, "target %s", tc.target)
+		}
+	})
+
+	t.Run("dynamic fee", func(t *testing.T) {
+		geCfg := *geCfg
+		geCfg.EIP1559DynamicFeesF = true
+		bhe := newBlockHistoryEstimator(t, nil, defaultChainType, &geCfg, bhCfg, rollupMocks.NewL1Oracle(t))
+		var blocks []evmtypes.Block
+		for i := int64(1); i <= 10; i++ {
+			blocks = append(blocks, evmtypes.Block{Number: i, Hash: utils.NewHash(), BaseFeePerGas: assets.NewWeiI(100000), Transactions: dynamicFeeTransactionsFromTipCaps(5000, i*10)})
+		}
+		gas.SetRollingBlockHistory(bhe, blocks)
+		h := testutils.Head(10)
+		h.BaseFeePerGas = assets.NewWeiI(100000)
+		bhe.OnNewLongestChain(tests.Context(t), h)
+		gas.SimulateStart(t, bhe)
+
+		fee, err := bhe.GetDynamicFeeForInclusion(tests.Context(t), gas.InclusionTarget{Blocks: 2, Confidence: 90}, maxGasPrice)
+		require.NoError(t, err)
+		// The fee cap covers the base fee rising for 2 blocks in a row: 100000 * 1.125^2 + 70
+		assert.Equal(t, gas.DynamicFee{GasFeeCap: assets.NewWeiI(126632), GasTipCap: assets.NewWeiI(70)}, fee)
+	})
+
+	t.Run("deadline", func(t *testing.T) {
+		bhe := newBlockHistoryEstimator(t, nil, defaultChainType, geCfg, bhCfg, rollupMocks.NewL1Oracle(t))
+		var blocks []evmtypes.Block
+		start := time.Now().Add(-10 * time.Second)
+		for i := int64(1); i <= 10; i++ {
+			blocks = append(blocks, evmtypes.Block{Number: i, Hash: utils.NewHash(), Timestamp: start.Add(time.Duration(i) * time.Second), Transactions: legacyTransactionsFromGasPrices(5000, i*100)})
+		}
+		gas.SetRollingBlockHistory(bhe, blocks)
+		bhe.OnNewLongestChain(tests.Context(t), testutils.Head(10))
+		gas.SimulateStart(t, bhe)
+
+		deadlineBlock := int64(13)
+		deadlineTime := time.Now().Add(3500 * time.Millisecond)
+		passedBlock := int64(5)
+		for _, tc := range []struct {
+			target   gas.InclusionTarget
+			expected *assets.Wei
+		}{
+			// 3 blocks are left until the deadline, like the 3 block target above
+			{gas.InclusionTarget{DeadlineBlock: &deadlineBlock, Confidence: 90}, assets.NewWeiI(600)},
+			// blocks come every second, so 3 blocks are left until the deadline
+			{gas.InclusionTarget{DeadlineTime: &deadlineTime, Confidence: 90}, assets.NewWeiI(600)},
+			// the deadline is passed, so the tx is priced for the next block
+			{gas.InclusionTarget{DeadlineBlock: &passedBlock, Confidence: 90}, assets.NewWeiI(900)},
+		} {
+			gasPrice, err := bhe.GetLegacyGasForInclusion(tests.Context(t), tc.target, maxGasPrice)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, gasPrice, "target %s", tc.target)
+		}
+	})
+
+	t.Run("without block history", func(t *testing.T) {
+		bhe := newBlockHistoryEstimator(t, nil, defaultChainType, geCfg, bhCfg, rollupMocks.NewL1Oracle(t))
+		gas.SimulateStart(t, bhe)
+
+		_, err := bhe.GetLegacyGasForInclusion(tests.Context(t), gas.InclusionTarget{Blocks: 1, Confidence: 90}, maxGasPrice)
+		require.ErrorIs(t, err, gas.ErrNoSuitableTransactions)
+	})
+}
+
 func TestBlockHistoryEstimator_HaltBumping(t *testing.T) {
 	bhCfg := newBlockHistoryConfig()
 	bhCfg.CheckInclusionBlocksF = uint16(4)
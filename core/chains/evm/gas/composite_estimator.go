@@ -0,0 +1,292 @@
+package gas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/rollups"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+var (
+	promCompositeEstimatorSourceSelected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gas_estimator_composite_source_selected",
+		Help: "The number of times the estimate of the given source was picked by the Composite estimator",
+	}, []string{"evmChainID", "source"})
+	promCompositeEstimatorClamped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gas_estimator_composite_clamped",
+		Help: "The number of times the Composite estimator clamped an estimate to its historical band, by direction (up or down)",
+	}, []string{"evmChainID", "direction"})
+)
+
+// minCompositeBandSamples is the number of past estimates needed before they are used to clamp new ones.
+const minCompositeBandSamples = 3
+
+var (
+	_ EvmEstimator       = (*compositeEstimator)(nil)
+	_ InclusionEstimator = (*compositeEstimator)(nil)
+)
+
+// CompositeSource is an estimator queried by the Composite estimator, with the mode it was created for.
+type CompositeSource struct {
+	Mode      string
+	Estimator EvmEstimator
+}
+
+type compositeEstimatorConfig interface {
+	Percentile() uint8
+	HistorySize() uint32
+	BandPercent() uint16
+}
+
+// compositeEstimator queries all of its sources and picks the estimate at the configured percentile of theirs. New
+// estimates are clamped to a band around the median of the last ones, so that a single misbehaving source can not move
+// the price too far at once. Bumps are never clamped, as they have to go up.
+type compositeEstimator struct {
+	services.StateMachine
+	lggr     logger.SugaredLogger
+	chainID  *big.Int
+	cfg      compositeEstimatorConfig
+	sources  []CompositeSource
+	l1Oracle rollups.L1Oracle
+
+	mu        sync.Mutex
+	gasPrices []*assets.Wei
+	feeCaps   []*assets.Wei
+	tipCaps   []*assets.Wei
+}
+
+// NewCompositeEstimator returns a new "Composite" estimator picking the estimate at the configured percentile of the
+// ones of sources.
+func NewCompositeEstimator(lggr logger.Logger, chainID *big.Int, cfg compositeEstimatorConfig, sources []CompositeSource, l1Oracle rollups.L1Oracle) EvmEstimator {
+	return &compositeEstimator{
+		lggr:     logger.Sugared(logger.Named(lggr, "CompositeEstimator")),
+		chainID:  chainID,
+		cfg:      cfg,
+		sources:  sources,
+		l1Oracle: l1Oracle,
+	}
+}
+
+func (c *compositeEstimator) Name() string { return c.lggr.Name() }
+
+func (c *compositeEstimator) L1Oracle() rollups.L1Oracle { return c.l1Oracle }
+
+func (c *compositeEstimator) Start(ctx context.Context) error {
+	return c.StartOnce("CompositeEstimator", func() error {
+		var ms services.MultiStart
+		for _, s := range c.sources {
+			if err := ms.Start(ctx, s.Estimator); err != nil {
+				return fmt.Errorf("failed to start %s estimator: %w", s.Mode, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (c *compositeEstimator) Close() error {
+	return c.StopOnce("CompositeEstimator", func() error {
+		var errs []error
+		for _, s := range c.sources {
+			errs = append(errs, s.Estimator.Close())
+		}
+		return errors.Join(errs...)
+	})
+}
+
+func (c *compositeEstimator) HealthReport() map[string]error {
+	report := map[string]error{c.Name(): c.Healthy()}
+	for _, s := range c.sources {
+		services.CopyHealth(report, s.Estimator.HealthReport())
+	}
+	return report
+}
+
+func (c *compositeEstimator) OnNewLongestChain(ctx context.Context, head *evmtypes.Head) {
+	for _, s := range c.sources {
+		s.Estimator.OnNewLongestChain(ctx, head)
+	}
+}
+
+// compositeEstimate is the estimate of a source.
+type compositeEstimate struct {
+	source   string
+	gasPrice *assets.Wei
+	fee      DynamicFee
+	gasLimit uint64
+}
+
+func (c *compositeEstimator) GetLegacyGas(ctx context.Context, calldata []byte, gasLimit uint64, maxGasPriceWei *assets.Wei, opts ...feetypes.Opt) (*assets.Wei, uint64, error) {
+	estimate, err := c.pick(func(e EvmEstimator) (compositeEstimate, error) {
+		gasPrice, chainSpecificGasLimit, err := e.GetLegacyGas(ctx, calldata, gasLimit, maxGasPriceWei, opts...)
+		return compositeEstimate{gasPrice: gasPrice, gasLimit: chainSpecificGasLimit}, err
+	}, func(e compositeEstimate) *assets.Wei { return e.gasPrice })
+	if err != nil {
+		return nil, 0, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	gasPrice := c.clamp(estimate.gasPrice, &c.gasPrices)
+	return assets.WeiMin(gasPrice, maxGasPriceWei), estimate.gasLimit, nil
+}
+
+func (c *compositeEstimator) BumpLegacyGas(ctx context.Context, originalGasPrice *assets.Wei, gasLimit uint64, maxGasPriceWei *assets.Wei, attempts []EvmPriorAttempt) (*assets.Wei, uint64, error) {
+	estimate, err := c.pick(func(e EvmEstimator) (compositeEstimate, error) {
+		gasPrice, chainSpecificGasLimit, err := e.BumpLegacyGas(ctx, originalGasPrice, gasLimit, maxGasPriceWei, attempts)
+		return compositeEstimate{gasPrice: gasPrice, gasLimit: chainSpecificGasLimit}, err
+	}, func(e compositeEstimate) *assets.Wei { return e.gasPrice })
+	if err != nil {
+		return nil, 0, err
+	}
+	return estimate.gasPrice, estimate.gasLimit, nil
+}
+
+func (c *compositeEstimator) GetDynamicFee(ctx context.Context, maxGasPriceWei *assets.Wei) (DynamicFee, error) {
+	estimate, err := c.pick(func(e EvmEstimator) (compositeEstimate, error) {
+		fee, err := e.GetDynamicFee(ctx, maxGasPriceWei)
+		return compositeEstimate{fee: fee}, err
+	}, func(e compositeEstimate) *assets.Wei { return e.fee.GasTipCap })
+	if err != nil {
+		return DynamicFee{}, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tipCap := c.clamp(estimate.fee.GasTipCap, &c.tipCaps)
+	feeCap := c.clamp(estimate.fee.GasFeeCap, &c.feeCaps)
+	// The fee cap must always cover the tip cap
+	feeCap = assets.WeiMin(assets.WeiMax(feeCap, tipCap), maxGasPriceWei)
+	return DynamicFee{GasFeeCap: feeCap, GasTipCap: assets.WeiMin(tipCap, feeCap)}, nil
+}
+
+func (c *compositeEstimator) BumpDynamicFee(ctx context.Context, original DynamicFee, maxGasPriceWei *assets.Wei, attempts []EvmPriorAttempt) (DynamicFee, error) {
+	estimate, err := c.pick(func(e EvmEstimator) (compositeEstimate, error) {
+		fee, err := e.BumpDynamicFee(ctx, original, maxGasPriceWei, attempts)
+		return compositeEstimate{fee: fee}, err
+	}, func(e compositeEstimate) *assets.Wei { return e.fee.GasTipCap })
+	if err != nil {
+		return DynamicFee{}, err
+	}
+	return estimate.fee, nil
+}
+
+// GetLegacyGasForInclusion picks the forecast at the configured percentile of the ones of the sources that can
+// forecast, without clamping it. Returns ErrNoSuitableTransactions if none can.
+func (c *compositeEstimator) GetLegacyGasForInclusion(ctx context.Context, target InclusionTarget, maxGasPriceWei *assets.Wei) (*assets.Wei, error) {
+	estimate, err := c.pickInclusion(func(e InclusionEstimator) (compositeEstimate, error) {
+		gasPrice, err := e.GetLegacyGasForInclusion(ctx, target, maxGasPriceWei)
+		return compositeEstimate{gasPrice: gasPrice}, err
+	}, func(e compositeEstimate) *assets.Wei { return e.gasPrice })
+	if err != nil {
+		return nil, err
+	}
+	return estimate.gasPrice, nil
+}
+
+// GetDynamicFeeForInclusion picks the forecast at the configured percentile of the ones of the sources that can
+// forecast, without clamping it. Returns ErrNoSuitableTransactions if none can.
+func (c *compositeEstimator) GetDynamicFeeForInclusion(ctx context.Context, target InclusionTarget, maxGasPriceWei *assets.Wei) (DynamicFee, error) {
+	estimate, err := c.pickInclusion(func(e InclusionEstimator) (compositeEstimate, error) {
+		fee, err := e.GetDynamicFeeForInclusion(ctx, target, maxGasPriceWei)
+		return compositeEstimate{fee: fee}, err
+	}, func(e compositeEstimate) *assets.Wei { return e.fee.GasTipCap })
+	if err != nil {
+		return DynamicFee{}, err
+	}
+	return estimate.fee, nil
+}
+
+// pickInclusion is like pick, for the sources that implement InclusionEstimator.
+func (c *compositeEstimator) pickInclusion(estimate func(InclusionEstimator) (compositeEstimate, error), price func(compositeEstimate) *assets.Wei) (compositeEstimate, error) {
+	var sources []CompositeSource
+	for _, s := range c.sources {
+		if _, ok := s.Estimator.(InclusionEstimator); ok {
+			sources = append(sources, s)
+		}
+	}
+	if len(sources) == 0 {
+		return compositeEstimate{}, ErrNoSuitableTransactions
+	}
+	return c.pickFrom(sources, func(e EvmEstimator) (compositeEstimate, error) {
+		return estimate(e.(InclusionEstimator))
+	}, price)
+}
+
+// pick returns the estimate at the configured percentile of the ones of the sources, ordered by price. Sources failing
+// to estimate are ignored, unless they all do.
+func (c *compositeEstimator) pick(estimate func(EvmEstimator) (compositeEstimate, error), price func(compositeEstimate) *assets.Wei) (compositeEstimate, error) {
+	return c.pickFrom(c.sources, estimate, price)
+}
+
+func (c *compositeEstimator) pickFrom(sources []CompositeSource, estimate func(EvmEstimator) (compositeEstimate, error), price func(compositeEstimate) *assets.Wei) (compositeEstimate, error) {
+	var estimates []compositeEstimate
+	var errs []error
+	for _, s := range sources {
+		e, err := estimate(s.Estimator)
+		if err != nil {
+			c.lggr.Debugw("Estimator failed, ignoring it", "source", s.Mode, "err", err)
+			errs = append(errs, fmt.Errorf("%s estimator failed: %w", s.Mode, err))
+			continue
+		}
+		e.source = s.Mode
+		estimates = append(estimates, e)
+	}
+	if len(estimates) == 0 {
+		return compositeEstimate{}, errors.Join(errs...)
+	}
+
+	slices.SortStableFunc(estimates, func(a, b compositeEstimate) int { return price(a).Cmp(price(b)) })
+	picked := estimates[percentileIndex(len(estimates), c.cfg.Percentile())]
+	c.lggr.Debugw("Picked estimate", "source", picked.source, "price", price(picked), "estimates", len(estimates), "failed", len(errs))
+	promCompositeEstimatorSourceSelected.WithLabelValues(c.chainID.String(), picked.source).Inc()
+	return picked, nil
+}
+
+// percentileIndex returns the index of the nearest-rank percentile of n sorted values.
+func percentileIndex(n int, percentile uint8) int {
+	i := (n*int(percentile)+99)/100 - 1
+	return min(max(i, 0), n-1)
+}
+
+// clamp returns price clamped to the band around the median of history, and adds the result to history. It must be
+// called with mu held.
+func (c *compositeEstimator) clamp(price *assets.Wei, history *[]*assets.Wei) *assets.Wei {
+	if price == nil {
+		return nil
+	}
+	if band := c.cfg.BandPercent(); band > 0 && len(*history) >= minCompositeBandSamples {
+		sorted := slices.Clone(*history)
+		slices.SortFunc(sorted, func(a, b *assets.Wei) int { return a.Cmp(b) })
+		median := sorted[len(sorted)/2]
+		upper := median.AddPercentage(band)
+		// median/(1+B/100), so that the band is as wide down as it is up
+		lower := assets.NewWei(new(big.Int).Div(new(big.Int).Mul(median.ToInt(), big.NewInt(100)), big.NewInt(100+int64(band))))
+		if price.Cmp(upper) > 0 {
+			c.lggr.Debugw("Clamping estimate to the historical band", "price", price, "upper", upper, "median", median)
+			promCompositeEstimatorClamped.WithLabelValues(c.chainID.String(), "down").Inc()
+			price = upper
+		} else if price.Cmp(lower) < 0 {
+			c.lggr.Debugw("Clamping estimate to the historical band", "price", price, "lower", lower, "median", median)
+			promCompositeEstimatorClamped.WithLabelValues(c.chainID.String(), "up").Inc()
+			price = lower
+		}
+	}
+	*history = append(*history, price)
+	if size := int(max(c.cfg.HistorySize(), 1)); len(*history) > size {
+		*history = (*history)[len(*history)-size:]
+	}
+	return price
+}
@@ -0,0 +1,204 @@
+package gas_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
+)
+
+type compositeConfig struct {
+	percentile  uint8
+	historySize uint32
+	bandPercent uint16
+}
+
+func (c *compositeConfig) Percentile() uint8   { return c.percentile }
+func (c *compositeConfig) HistorySize() uint32 { return c.historySize }
+func (c *compositeConfig) BandPercent() uint16 { return c.bandPercent }
+
+func newLegacySources(t *testing.T, prices ...int64) []gas.CompositeSource {
+	var sources []gas.CompositeSource
+	for i, price := range prices {
+		est := mocks.NewEvmEstimator(t)
+		if price < 0 {
+			est.On("GetLegacyGas", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, uint64(0), errors.New("boom")).Maybe()
+		} else {
+			est.On("GetLegacyGas", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(assets.NewWeiI(price), uint64(100+i), nil).Maybe()
+		}
+		sources = append(sources, gas.CompositeSource{Mode: []string{"BlockHistory", "FeeHistory", "SuggestedPrice"}[i], Estimator: est})
+	}
+	return sources
+}
+
+func TestCompositeEstimator_GetLegacyGas(t *testing.T) {
+	t.Parallel()
+	ctx := tests.Context(t)
+	chainID := big.NewInt(1)
+	maxPrice := assets.NewWeiI(1000)
+
+	t.Run("picks the estimate at the percentile", func(t *testing.T) {
+		for _, tc := range []struct {
+			percentile uint8
+			price      int64
+			limit      uint64
+		}{
+			{1, 10, 102},
+			{50, 20, 100},
+			{100, 30, 101},
+		} {
+			sources := newLegacySources(t, 20, 30, 10)
+			est := gas.NewCompositeEstimator(logger.Test(t), chainID, &compositeConfig{percentile: tc.percentile, historySize: 10}, sources, nil)
+			price, limit, err := est.GetLegacyGas(ctx, nil, 100, maxPrice)
+			require.NoError(t, err)
+			assert.Equal(t, assets.NewWeiI(tc.price), price, "percentile %d", tc.percentile)
+			assert.Equal(t, tc.limit, limit, "percentile %d", tc.percentile)
+		}
+	})
+
+	t.Run("ignores failing estimators unless they all fail", func(t *testing.T) {
+		est := gas.NewCompositeEstimator(logger.Test(t), chainID, &compositeConfig{percentile: 100, historySize: 10}, newLegacySources(t, -1, 30, -1), nil)
+		price, _, err := est.GetLegacyGas(ctx, nil, 100, maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(30), price)
+
+		est = gas.NewCompositeEstimator(logger.Test(t), chainID, &compositeConfig{percentile: 100, historySize: 10}, newLegacySources(t, -1, -1), nil)
+		_, _, err = est.GetLegacyGas(ctx, nil, 100, maxPrice)
+		require.ErrorContains(t, err, "BlockHistory estimator failed: boom")
+		require.ErrorContains(t, err, "FeeHistory estimator failed: boom")
+	})
+
+	t.Run("clamps estimates to the historical band", func(t *testing.T) {
+		source := mocks.NewEvmEstimator(t)
+		for _, price := range []int64{100, 100, 100, 500, 10} {
+			source.On("GetLegacyGas", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(assets.NewWeiI(price), uint64(100), nil).Once()
+		}
+		est := gas.NewCompositeEstimator(logger.Test(t), chainID, &compositeConfig{percentile: 50, historySize: 10, bandPercent: 100},
+			[]gas.CompositeSource{{Mode: "SuggestedPrice", Estimator: source}}, nil)
+
+		for _, expected := range []int64{100, 100, 100, 200, 50} {
+			price, _, err := est.GetLegacyGas(ctx, nil, 100, maxPrice)
+			require.NoError(t, err)
+			assert.Equal(t, assets.NewWeiI(expected), price)
+		}
+	})
+
+	t.Run("does not clamp with a band of 0", func(t *testing.T) {
+		source := mocks.NewEvmEstimator(t)
+		for _, price := range []int64{100, 100, 100, 500} {
+			source.On("GetLegacyGas", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(assets.NewWeiI(price), uint64(100), nil).Once()
+		}
+		est := gas.NewCompositeEstimator(logger.Test(t), chainID, &compositeConfig{percentile: 50, historySize: 10},
+			[]gas.CompositeSource{{Mode: "SuggestedPrice", Estimator: source}}, nil)
+
+		var price *assets.Wei
+		for range 4 {
+			var err error
+			price, _, err = est.GetLegacyGas(ctx, nil, 100, maxPrice)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, assets.NewWeiI(500), price)
+	})
+}
+
+func TestCompositeEstimator_DynamicFee(t *testing.T) {
+	t.Parallel()
+	ctx := tests.Context(t)
+	chainID := big.NewInt(1)
+	maxPrice := assets.NewWeiI(1000)
+	cfg := &compositeConfig{percentile: 100, historySize: 10, bandPercent: 50}
+
+	low := mocks.NewEvmEstimator(t)
+	high := mocks.NewEvmEstimator(t)
+	sources := []gas.CompositeSource{{Mode: "BlockHistory", Estimator: low}, {Mode: "FeeHistory", Estimator: high}}
+
+	t.Run("picks the fee with the tip cap at the percentile", func(t *testing.T) {
+		low.On("GetDynamicFee", mock.Anything, maxPrice).Return(gas.DynamicFee{GasFeeCap: assets.NewWeiI(300), GasTipCap: assets.NewWeiI(10)}, nil).Once()
+		high.On("GetDynamicFee", mock.Anything, maxPrice).Return(gas.DynamicFee{GasFeeCap: assets.NewWeiI(200), GasTipCap: assets.NewWeiI(20)}, nil).Once()
+		est := gas.NewCompositeEstimator(logger.Test(t), chainID, cfg, sources, nil)
+
+		fee, err := est.GetDynamicFee(ctx, maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, gas.DynamicFee{GasFeeCap: assets.NewWeiI(200), GasTipCap: assets.NewWeiI(20)}, fee)
+	})
+
+	t.Run("does not clamp bumps", func(t *testing.T) {
+		original := gas.DynamicFee{GasFeeCap: assets.NewWeiI(200), GasTipCap: assets.NewWeiI(20)}
+		low.On("BumpDynamicFee", mock.Anything, original, maxPrice, mock.Anything).Return(gas.DynamicFee{GasFeeCap: assets.NewWeiI(240), GasTipCap: assets.NewWeiI(24)}, nil).Once()
+		high.On("BumpDynamicFee", mock.Anything, original, maxPrice, mock.Anything).Return(gas.DynamicFee{GasFeeCap: assets.NewWeiI(900), GasTipCap: assets.NewWeiI(90)}, nil).Once()
+		est := gas.NewCompositeEstimator(logger.Test(t), chainID, cfg, sources, nil)
+
+		fee, err := est.BumpDynamicFee(ctx, original, maxPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, gas.DynamicFee{GasFeeCap: assets.NewWeiI(900), GasTipCap: assets.NewWeiI(90)}, fee)
+	})
+}
+
+// inclusionSource is a source that forecasts the fees for inclusion targets.
+type inclusionSource struct {
+	*mocks.EvmEstimator
+	gasPrice *assets.Wei
+	fee      gas.DynamicFee
+	err      error
+}
+
+func (s *inclusionSource) GetLegacyGasForInclusion(context.Context, gas.InclusionTarget, *assets.Wei) (*assets.Wei, error) {
+	return s.gasPrice, s.err
+}
+
+func (s *inclusionSource) GetDynamicFeeForInclusion(context.Context, gas.InclusionTarget, *assets.Wei) (gas.DynamicFee, error) {
+	return s.fee, s.err
+}
+
+func TestCompositeEstimator_Inclusion(t *testing.T) {
+	t.Parallel()
+	ctx := tests.Context(t)
+	chainID := big.NewInt(1)
+	maxPrice := assets.NewWeiI(1000)
+	cfg := &compositeConfig{percentile: 100, historySize: 10, bandPercent: 10}
+	target := gas.InclusionTarget{Blocks: 3, Confidence: gas.DefaultInclusionConfidence}
+
+	t.Run("picks the forecast at the percentile of the sources that forecast", func(t *testing.T) {
+		sources := []gas.CompositeSource{
+			{Mode: "BlockHistory", Estimator: &inclusionSource{EvmEstimator: mocks.NewEvmEstimator(t), gasPrice: assets.NewWeiI(100),
+				fee: gas.DynamicFee{GasFeeCap: assets.NewWeiI(300), GasTipCap: assets.NewWeiI(30)}}},
+			{Mode: "FeeHistory", Estimator: &inclusionSource{EvmEstimator: mocks.NewEvmEstimator(t), gasPrice: assets.NewWeiI(500),
+				fee: gas.DynamicFee{GasFeeCap: assets.NewWeiI(600), GasTipCap: assets.NewWeiI(60)}}},
+			{Mode: "SuggestedPrice", Estimator: mocks.NewEvmEstimator(t)},
+		}
+		est := gas.NewCompositeEstimator(logger.Test(t), chainID, cfg, sources, nil).(gas.InclusionEstimator)
+
+		price, err := est.GetLegacyGasForInclusion(ctx, target, maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(500), price)
+
+		fee, err := est.GetDynamicFeeForInclusion(ctx, target, maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, gas.DynamicFee{GasFeeCap: assets.NewWeiI(600), GasTipCap: assets.NewWeiI(60)}, fee)
+	})
+
+	t.Run("returns ErrNoSuitableTransactions without a source that can forecast", func(t *testing.T) {
+		sources := []gas.CompositeSource{
+			{Mode: "BlockHistory", Estimator: &inclusionSource{EvmEstimator: mocks.NewEvmEstimator(t), err: gas.ErrNoSuitableTransactions}},
+			{Mode: "SuggestedPrice", Estimator: mocks.NewEvmEstimator(t)},
+		}
+		est := gas.NewCompositeEstimator(logger.Test(t), chainID, cfg, sources, nil).(gas.InclusionEstimator)
+		_, err := est.GetLegacyGasForInclusion(ctx, target, maxPrice)
+		require.ErrorIs(t, err, gas.ErrNoSuitableTransactions)
+
+		est = gas.NewCompositeEstimator(logger.Test(t), chainID, cfg, sources[1:], nil).(gas.InclusionEstimator)
+		_, err = est.GetDynamicFeeForInclusion(ctx, target, maxPrice)
+		require.ErrorIs(t, err, gas.ErrNoSuitableTransactions)
+	})
+}
@@ -0,0 +1,196 @@
+package gas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+// DefaultInclusionConfidence is the confidence transactions with a deadline are priced to be included by it with.
+const DefaultInclusionConfidence = 95
+
+// InclusionTarget is the window a transaction should be included in with a probability of at least Confidence percent:
+// by its deadline, a time and/or a block number, or within Blocks blocks of its broadcast if it has none.
+type InclusionTarget struct {
+	Blocks        uint32
+	DeadlineTime  *time.Time
+	DeadlineBlock *int64
+	Confidence    uint8
+}
+
+// HasDeadline returns true if the window ends at a deadline rather than after Blocks blocks.
+func (t InclusionTarget) HasDeadline() bool {
+	return t.DeadlineTime != nil || t.DeadlineBlock != nil
+}
+
+func (t InclusionTarget) String() string {
+	switch {
+	case t.DeadlineTime != nil && t.DeadlineBlock != nil:
+		return fmt.Sprintf("by %s or block %d with %d%% confidence", t.DeadlineTime.Format(time.RFC3339), *t.DeadlineBlock, t.Confidence)
+	case t.DeadlineTime != nil:
+		return fmt.Sprintf("by %s with %d%% confidence", t.DeadlineTime.Format(time.RFC3339), t.Confidence)
+	case t.DeadlineBlock != nil:
+		return fmt.Sprintf("by block %d with %d%% confidence", *t.DeadlineBlock, t.Confidence)
+	default:
+		return fmt.Sprintf("within %d blocks with %d%% confidence", t.Blocks, t.Confidence)
+	}
+}
+
+// InclusionEstimator is implemented by the estimators that can forecast the fee a transaction needs to be included
+// within an InclusionTarget.
+type InclusionEstimator interface {
+	// GetLegacyGasForInclusion forecasts the gas price of a non-EIP1559 transaction included within target.
+	GetLegacyGasForInclusion(ctx context.Context, target InclusionTarget, maxGasPriceWei *assets.Wei) (*assets.Wei, error)
+	// GetDynamicFeeForInclusion forecasts the fee of an EIP1559 transaction included within target.
+	GetDynamicFeeForInclusion(ctx context.Context, target InclusionTarget, maxGasPriceWei *assets.Wei) (DynamicFee, error)
+}
+
+var _ InclusionEstimator = (*BlockHistoryEstimator)(nil)
+
+// blockInclusionQuantile returns the share of blocks a transaction must be priced high enough for, so that it is
+// included within target.Blocks blocks with target.Confidence. Assuming independent blocks, a transaction priced for a
+// share q of them misses n blocks in a row with a probability of (1-q)^n, so q = 1-(1-c)^(1/n).
+func blockInclusionQuantile(target InclusionTarget) float64 {
+	confidence := float64(min(target.Confidence, 100)) / 100
+	return 1 - math.Pow(1-confidence, 1/float64(max(target.Blocks, 1)))
+}
+
+// quantileWei returns the q quantile, between 0 and 1, of prices sorted in ascending order.
+func quantileWei(prices []*assets.Wei, q float64) *assets.Wei {
+	i := int(math.Ceil(q*float64(len(prices)))) - 1
+	return prices[min(max(i, 0), len(prices)-1)]
+}
+
+// withBlocks returns target with the number of blocks left until its deadline at now, according to the latest head and
+// the average block time of the block history. A deadline that is passed, or that can not be converted to blocks yet,
+// leaves a single block.
+func (b *BlockHistoryEstimator) withBlocks(target InclusionTarget, now time.Time) InclusionTarget {
+	if !target.HasDeadline() {
+		target.Blocks = max(target.Blocks, 1)
+		return target
+	}
+	blocks := int64(math.MaxUint32)
+	if target.DeadlineBlock != nil {
+		if latest := b.getLatestBlockNumber(); latest > 0 {
+			blocks = min(blocks, *target.DeadlineBlock-latest)
+		}
+	}
+	if target.DeadlineTime != nil {
+		if blockTime := b.averageBlockTime(); blockTime > 0 {
+			blocks = min(blocks, int64(target.DeadlineTime.Sub(now)/blockTime))
+		}
+	}
+	if blocks == math.MaxUint32 {
+		blocks = 1
+	}
+	target.Blocks = uint32(max(blocks, 1))
+	return target
+}
+
+func (b *BlockHistoryEstimator) getLatestBlockNumber() int64 {
+	b.latestMu.RLock()
+	defer b.latestMu.RUnlock()
+	if b.latest == nil {
+		return 0
+	}
+	return b.latest.Number
+}
+
+// averageBlockTime returns the average time between the blocks of the block history, or 0 if it is unknown.
+func (b *BlockHistoryEstimator) averageBlockTime() time.Duration {
+	var first, last *evmtypes.Block
+	for _, block := range b.getBlocks() {
+		if block.Timestamp.IsZero() {
+			continue
+		}
+		if first == nil || block.Number < first.Number {
+			first = &block
+		}
+		if last == nil || block.Number > last.Number {
+			last = &block
+		}
+	}
+	if first == nil || last.Number == first.Number {
+		return 0
+	}
+	return last.Timestamp.Sub(first.Timestamp) / time.Duration(last.Number-first.Number)
+}
+
+// inclusionPrices returns the lowest gas price and tip cap at which transactions are included within target, according
+// to the block history. The lowest price of the usable transactions of a block is taken as the price a transaction needed
+// to be included in it.
+func (b *BlockHistoryEstimator) inclusionPrices(target InclusionTarget, eip1559 bool) (gasPrice, tipCap *assets.Wei, err error) {
+	var minGasPrices, minTipCaps []*assets.Wei
+	for _, block := range b.getBlocks() {
+		gasPrices, tipCaps := b.getPricesFromBlocks([]evmtypes.Block{block}, eip1559)
+		if len(gasPrices) > 0 {
+			minGasPrices = append(minGasPrices, slices.MinFunc(gasPrices, (*assets.Wei).Cmp))
+		}
+		if len(tipCaps) > 0 {
+			minTipCaps = append(minTipCaps, slices.MinFunc(tipCaps, (*assets.Wei).Cmp))
+		}
+	}
+	if len(minGasPrices) == 0 || (eip1559 && len(minTipCaps) == 0) {
+		return nil, nil, ErrNoSuitableTransactions
+	}
+
+	q := blockInclusionQuantile(target)
+	slices.SortFunc(minGasPrices, (*assets.Wei).Cmp)
+	gasPrice = quantileWei(minGasPrices, q)
+	if eip1559 {
+		slices.SortFunc(minTipCaps, (*assets.Wei).Cmp)
+		tipCap = quantileWei(minTipCaps, q)
+	}
+	b.logger.Debugw("Forecast inclusion prices", "target", target, "blockQuantile", q, "blocks", len(minGasPrices), "gasPrice", gasPrice, "tipCap", tipCap)
+	return gasPrice, tipCap, nil
+}
+
+// GetLegacyGasForInclusion returns the gas price at which transactions were included within target in the block history.
+func (b *BlockHistoryEstimator) GetLegacyGasForInclusion(_ context.Context, target InclusionTarget, maxGasPriceWei *assets.Wei) (gasPrice *assets.Wei, err error) {
+	ok := b.IfStarted(func() {
+		gasPrice, _, err = b.inclusionPrices(b.withBlocks(target, time.Now()), false)
+	})
+	if !ok {
+		return nil, errors.New("BlockHistoryEstimator is not started; cannot estimate gas")
+	}
+	if err != nil {
+		return nil, err
+	}
+	gasPrice = assets.WeiMax(gasPrice, b.eConfig.PriceMin())
+	return capGasPrice(gasPrice, maxGasPriceWei, b.eConfig.PriceMax()), nil
+}
+
+// GetDynamicFeeForInclusion returns the tip cap at which transactions were included within target in the block history,
+// with a fee cap covering the largest base fee possible until the end of the window.
+func (b *BlockHistoryEstimator) GetDynamicFeeForInclusion(_ context.Context, target InclusionTarget, maxGasPriceWei *assets.Wei) (fee DynamicFee, err error) {
+	if !b.eConfig.EIP1559DynamicFees() {
+		return fee, errors.New("can't get dynamic fee, EIP1559 is disabled")
+	}
+	var tipCap *assets.Wei
+	ok := b.IfStarted(func() {
+		target = b.withBlocks(target, time.Now())
+		_, tipCap, err = b.inclusionPrices(target, true)
+	})
+	if !ok {
+		return fee, errors.New("BlockHistoryEstimator is not started; cannot estimate gas")
+	}
+	if err != nil {
+		return fee, err
+	}
+
+	maxGasPrice := assets.WeiMin(maxGasPriceWei, b.eConfig.PriceMax())
+	tipCap = assets.WeiMin(assets.WeiMax(tipCap, b.eConfig.TipCapMin()), maxGasPrice)
+	baseFee := b.getCurrentBaseFee()
+	if baseFee == nil {
+		return fee, errors.New("BlockHistoryEstimator: no value for latest block base fee; cannot estimate EIP-1559 base fee. Are you trying to run with EIP1559 enabled on a non-EIP1559 chain?")
+	}
+	fee.GasTipCap = tipCap
+	fee.GasFeeCap = calcFeeCap(baseFee, int(target.Blocks), tipCap, maxGasPrice)
+	return fee, nil
+}
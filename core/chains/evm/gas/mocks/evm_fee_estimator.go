@@ -230,6 +230,90 @@ func (_c *EvmFeeEstimator_GetFee_Call) RunAndReturn(run func(context.Context, []
 	return _c
 }
 
+// GetFeeForInclusion provides a mock function with given fields: ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, target, opts
+func (_m *EvmFeeEstimator) GetFeeForInclusion(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress *common.Address, toAddress *common.Address, target gas.InclusionTarget, opts ...types.Opt) (gas.EvmFee, uint64, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, target)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeeForInclusion")
+	}
+
+	var r0 gas.EvmFee
+	var r1 uint64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, uint64, *assets.Wei, *common.Address, *common.Address, gas.InclusionTarget, ...types.Opt) (gas.EvmFee, uint64, error)); ok {
+		return rf(ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, target, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, uint64, *assets.Wei, *common.Address, *common.Address, gas.InclusionTarget, ...types.Opt) gas.EvmFee); ok {
+		r0 = rf(ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, target, opts...)
+	} else {
+		r0 = ret.Get(0).(gas.EvmFee)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, uint64, *assets.Wei, *common.Address, *common.Address, gas.InclusionTarget, ...types.Opt) uint64); ok {
+		r1 = rf(ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, target, opts...)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []byte, uint64, *assets.Wei, *common.Address, *common.Address, gas.InclusionTarget, ...types.Opt) error); ok {
+		r2 = rf(ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, target, opts...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// EvmFeeEstimator_GetFeeForInclusion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFeeForInclusion'
+type EvmFeeEstimator_GetFeeForInclusion_Call struct {
+	*mock.Call
+}
+
+// GetFeeForInclusion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - calldata []byte
+//   - feeLimit uint64
+//   - maxFeePrice *assets.Wei
+//   - fromAddress *common.Address
+//   - toAddress *common.Address
+//   - target gas.InclusionTarget
+//   - opts ...types.Opt
+func (_e *EvmFeeEstimator_Expecter) GetFeeForInclusion(ctx interface{}, calldata interface{}, feeLimit interface{}, maxFeePrice interface{}, fromAddress interface{}, toAddress interface{}, target interface{}, opts ...interface{}) *EvmFeeEstimator_GetFeeForInclusion_Call {
+	return &EvmFeeEstimator_GetFeeForInclusion_Call{Call: _e.mock.On("GetFeeForInclusion",
+		append([]interface{}{ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, target}, opts...)...)}
+}
+
+func (_c *EvmFeeEstimator_GetFeeForInclusion_Call) Run(run func(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress *common.Address, toAddress *common.Address, target gas.InclusionTarget, opts ...types.Opt)) *EvmFeeEstimator_GetFeeForInclusion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]types.Opt, len(args)-7)
+		for i, a := range args[7:] {
+			if a != nil {
+				variadicArgs[i] = a.(types.Opt)
+			}
+		}
+		run(args[0].(context.Context), args[1].([]byte), args[2].(uint64), args[3].(*assets.Wei), args[4].(*common.Address), args[5].(*common.Address), args[6].(gas.InclusionTarget), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EvmFeeEstimator_GetFeeForInclusion_Call) Return(fee gas.EvmFee, estimatedFeeLimit uint64, err error) *EvmFeeEstimator_GetFeeForInclusion_Call {
+	_c.Call.Return(fee, estimatedFeeLimit, err)
+	return _c
+}
+
+func (_c *EvmFeeEstimator_GetFeeForInclusion_Call) RunAndReturn(run func(context.Context, []byte, uint64, *assets.Wei, *common.Address, *common.Address, gas.InclusionTarget, ...types.Opt) (gas.EvmFee, uint64, error)) *EvmFeeEstimator_GetFeeForInclusion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetMaxCost provides a mock function with given fields: ctx, amount, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, opts
 func (_m *EvmFeeEstimator) GetMaxCost(ctx context.Context, amount assets.Eth, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress *common.Address, toAddress *common.Address, opts ...types.Opt) (*big.Int, error) {
 	_va := make([]interface{}, len(opts))
@@ -2,8 +2,10 @@ package gas
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
@@ -35,6 +37,9 @@ type EvmFeeEstimator interface {
 	// L1Oracle returns the L1 gas price oracle only if the chain has one, e.g. OP stack L2s and Arbitrum.
 	L1Oracle() rollups.L1Oracle
 	GetFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...feetypes.Opt) (fee EvmFee, estimatedFeeLimit uint64, err error)
+	// GetFeeForInclusion is GetFee for a transaction that should be included within target. Estimators that are not an
+	// InclusionEstimator, or lack the history to forecast the fee, return the GetFee fee.
+	GetFeeForInclusion(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, target InclusionTarget, opts ...feetypes.Opt) (fee EvmFee, estimatedFeeLimit uint64, err error)
 	BumpFee(ctx context.Context, originalFee EvmFee, feeLimit uint64, maxFeePrice *assets.Wei, attempts []EvmPriorAttempt) (bumpedFee EvmFee, chainSpecificFeeLimit uint64, err error)
 
 	// GetMaxCost returns the total value = max price x fee units + transferred value
@@ -86,29 +91,53 @@ func NewEstimator(lggr logger.Logger, ethClient feeEstimatorClient, chaintype ch
 	}
 
 	var newEstimator func(logger.Logger) EvmEstimator
-	switch s {
+	if s == "Composite" {
+		c := geCfg.Composite()
+		newEstimators := make([]func(logger.Logger) EvmEstimator, len(c.Modes()))
+		for i, mode := range c.Modes() {
+			if newEstimators[i], err = newModeEstimator(lggr, mode, ethClient, chaintype, chainID, geCfg, l1Oracle); err != nil {
+				return nil, err
+			}
+		}
+		newEstimator = func(l logger.Logger) EvmEstimator {
+			sources := make([]CompositeSource, len(newEstimators))
+			for i, newSourceEstimator := range newEstimators {
+				sources[i] = CompositeSource{Mode: c.Modes()[i], Estimator: newSourceEstimator(l)}
+			}
+			return NewCompositeEstimator(lggr, chainID, c, sources, l1Oracle)
+		}
+	} else if newEstimator, err = newModeEstimator(lggr, s, ethClient, chaintype, chainID, geCfg, l1Oracle); err != nil {
+		return nil, err
+	}
+	return NewEvmFeeEstimator(lggr, newEstimator, df, geCfg, ethClient), nil
+}
+
+// newModeEstimator returns the constructor of the estimator of a single mode, i.e. any mode but Composite.
+func newModeEstimator(lggr logger.Logger, mode string, ethClient feeEstimatorClient, chaintype chaintype.ChainType, chainID *big.Int, geCfg evmconfig.GasEstimator, l1Oracle rollups.L1Oracle) (func(logger.Logger) EvmEstimator, error) {
+	bh := geCfg.BlockHistory()
+	switch mode {
 	case "Arbitrum":
 		arbOracle, err := rollups.NewArbitrumL1GasOracle(lggr, ethClient)
 		if err != nil {
 			return nil, fmt.Errorf("failed to initialize Arbitrum L1 oracle: %w", err)
 		}
-		newEstimator = func(l logger.Logger) EvmEstimator {
+		return func(l logger.Logger) EvmEstimator {
 			return NewArbitrumEstimator(lggr, geCfg, ethClient, arbOracle)
-		}
+		}, nil
 	case "BlockHistory":
-		newEstimator = func(l logger.Logger) EvmEstimator {
+		return func(l logger.Logger) EvmEstimator {
 			return NewBlockHistoryEstimator(lggr, ethClient, chaintype, geCfg, bh, chainID, l1Oracle)
-		}
+		}, nil
 	case "FixedPrice":
-		newEstimator = func(l logger.Logger) EvmEstimator {
+		return func(l logger.Logger) EvmEstimator {
 			return NewFixedPriceEstimator(geCfg, ethClient, bh, lggr, l1Oracle)
-		}
+		}, nil
 	case "L2Suggested", "SuggestedPrice":
-		newEstimator = func(l logger.Logger) EvmEstimator {
+		return func(l logger.Logger) EvmEstimator {
 			return NewSuggestedPriceEstimator(lggr, ethClient, geCfg, l1Oracle)
-		}
+		}, nil
 	case "FeeHistory":
-		newEstimator = func(l logger.Logger) EvmEstimator {
+		return func(l logger.Logger) EvmEstimator {
 			ccfg := FeeHistoryEstimatorConfig{
 				BumpPercent:      geCfg.BumpPercent(),
 				CacheTimeout:     geCfg.FeeHistory().CacheTimeout(),
@@ -117,15 +146,14 @@ func NewEstimator(lggr logger.Logger, ethClient feeEstimatorClient, chaintype ch
 				RewardPercentile: float64(geCfg.BlockHistory().TransactionPercentile()),
 			}
 			return NewFeeHistoryEstimator(lggr, ethClient, ccfg, chainID, l1Oracle)
-		}
+		}, nil
 
 	default:
-		lggr.Warnf("GasEstimator: unrecognised mode '%s', falling back to FixedPriceEstimator", s)
-		newEstimator = func(l logger.Logger) EvmEstimator {
+		lggr.Warnf("GasEstimator: unrecognised mode '%s', falling back to FixedPriceEstimator", mode)
+		return func(l logger.Logger) EvmEstimator {
 			return NewFixedPriceEstimator(geCfg, ethClient, bh, lggr, l1Oracle)
-		}
+		}, nil
 	}
-	return NewEvmFeeEstimator(lggr, newEstimator, df, geCfg, ethClient), nil
 }
 
 // DynamicFee encompasses both FeeCap and TipCap for EIP1559 transactions
@@ -300,6 +328,42 @@ func (e *evmFeeEstimator) GetFee(ctx context.Context, calldata []byte, feeLimit
 	return
 }
 
+func (e *evmFeeEstimator) GetFeeForInclusion(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, target InclusionTarget, opts ...feetypes.Opt) (fee EvmFee, estimatedFeeLimit uint64, err error) {
+	// The current fee is always estimated, for the fee limit and for the estimators without inclusion forecasts
+	current, estimatedFeeLimit, err := e.GetFee(ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, opts...)
+	if err != nil {
+		return current, estimatedFeeLimit, err
+	}
+	inclusionEstimator, ok := e.EvmEstimator.(InclusionEstimator)
+	if !ok {
+		return current, estimatedFeeLimit, nil
+	}
+	if e.EIP1559Enabled {
+		fee.DynamicFee, err = inclusionEstimator.GetDynamicFeeForInclusion(ctx, target, maxFeePrice)
+	} else {
+		fee.GasPrice, err = inclusionEstimator.GetLegacyGasForInclusion(ctx, target, maxFeePrice)
+	}
+	if errors.Is(err, ErrNoSuitableTransactions) {
+		e.lggr.Debugw("Not enough block history to forecast the fee for the inclusion target, using the current fee", "target", target)
+		return current, estimatedFeeLimit, nil
+	} else if err != nil {
+		return fee, 0, err
+	}
+
+	if slices.Contains(opts, feetypes.OptForceRefetch) {
+		// The fee is estimated again because the previous one was too low, so the forecast is not trusted below the
+		// current fee
+		if fee.GasPrice != nil && current.GasPrice != nil {
+			fee.GasPrice = assets.WeiMax(fee.GasPrice, current.GasPrice)
+		}
+		if fee.ValidDynamic() && current.ValidDynamic() {
+			fee.GasTipCap = assets.WeiMax(fee.GasTipCap, current.GasTipCap)
+			fee.GasFeeCap = assets.WeiMax(fee.GasFeeCap, current.GasFeeCap)
+		}
+	}
+	return fee, estimatedFeeLimit, nil
+}
+
 func (e *evmFeeEstimator) GetMaxCost(ctx context.Context, amount assets.Eth, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...feetypes.Opt) (*big.Int, error) {
 	fees, gasLimit, err := e.GetFee(ctx, calldata, feeLimit, maxFeePrice, fromAddress, toAddress, opts...)
 	if err != nil {
@@ -1,6 +1,7 @@
 package gas_test
 
 import (
+	"context"
 	"errors"
 	"math/big"
 	"testing"
@@ -14,6 +15,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
 	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
@@ -406,4 +408,54 @@ func TestWrappedEvmEstimator(t *testing.T) {
 		_, _, err = estimator.GetFee(ctx, []byte{}, 0, nil, &fromAddress, &toAddress)
 		require.Error(t, err)
 	})
+
+	t.Run("GetFeeForInclusion returns the GetFee fee for estimators without inclusion forecasts", func(t *testing.T) {
+		evmEstimator := mocks.NewEvmEstimator(t)
+		evmEstimator.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, mock.Anything).
+			Return(legacyFee, gasLimit, nil).Once()
+		geCfg.EstimateLimitF = false
+		getEst := func(logger.Logger) gas.EvmEstimator { return evmEstimator }
+		estimator := gas.NewEvmFeeEstimator(logger.Test(t), getEst, false, geCfg, nil)
+
+		fee, limit, err := estimator.GetFeeForInclusion(ctx, nil, gasLimit, nil, &fromAddress, &toAddress, gas.InclusionTarget{Blocks: 2, Confidence: 95})
+		require.NoError(t, err)
+		assert.Equal(t, legacyFee, fee.GasPrice)
+		assert.Equal(t, uint64(float32(gasLimit)*limitMultiplier), limit)
+	})
+
+	t.Run("GetFeeForInclusion does not forecast below the current fee when it is refetched", func(t *testing.T) {
+		evmEstimator := mocks.NewEvmEstimator(t)
+		evmEstimator.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, mock.Anything).
+			Return(legacyFee, gasLimit, nil).Once()
+		evmEstimator.On("GetLegacyGas", mock.Anything, mock.Anything, gasLimit, mock.Anything, feetypes.OptForceRefetch).
+			Return(legacyFee, gasLimit, nil).Once()
+		geCfg.EstimateLimitF = false
+		getEst := func(logger.Logger) gas.EvmEstimator {
+			return inclusionEstimator{EvmEstimator: evmEstimator, gasPrice: assets.NewWeiI(5)}
+		}
+		estimator := gas.NewEvmFeeEstimator(logger.Test(t), getEst, false, geCfg, nil)
+		target := gas.InclusionTarget{Blocks: 5, Confidence: 95}
+
+		fee, _, err := estimator.GetFeeForInclusion(ctx, nil, gasLimit, nil, &fromAddress, &toAddress, target)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(5), fee.GasPrice)
+
+		fee, _, err = estimator.GetFeeForInclusion(ctx, nil, gasLimit, nil, &fromAddress, &toAddress, target, feetypes.OptForceRefetch)
+		require.NoError(t, err)
+		assert.Equal(t, legacyFee, fee.GasPrice)
+	})
+}
+
+// inclusionEstimator forecasts gasPrice for every inclusion target.
+type inclusionEstimator struct {
+	*mocks.EvmEstimator
+	gasPrice *assets.Wei
+}
+
+func (e inclusionEstimator) GetLegacyGasForInclusion(context.Context, gas.InclusionTarget, *assets.Wei) (*assets.Wei, error) {
+	return e.gasPrice, nil
+}
+
+func (e inclusionEstimator) GetDynamicFeeForInclusion(context.Context, gas.InclusionTarget, *assets.Wei) (gas.DynamicFee, error) {
+	return gas.DynamicFee{}, errors.New("not implemented")
 }
@@ -89,6 +89,24 @@ func (hs *headSaver) MarkFinalized(ctx context.Context, finalized *evmtypes.Head
 	return hs.orm.TrimOldHeads(ctx, minBlockToKeep)
 }
 
+func (hs *headSaver) MarkSafe(ctx context.Context, safe *evmtypes.Head) error {
+	if !hs.heads.MarkSafe(safe.BlockHash()) {
+		return fmt.Errorf("failed to find %s block in the canonical chain to mark it as safe", safe)
+	}
+	return nil
+}
+
+func (hs *headSaver) SaveReorg(ctx context.Context, reorg httypes.Reorg) error {
+	return hs.orm.InsertReorg(ctx, &Reorg{
+		Depth:       reorg.Depth,
+		OldHeadHash: reorg.OldHeadHash,
+		NewHeadHash: reorg.NewHeadHash,
+		FromBlock:   reorg.FromBlock,
+		ToBlock:     reorg.ToBlock,
+		DetectedAt:  reorg.DetectedAt,
+	})
+}
+
 var NullSaver httypes.HeadSaver = &nullSaver{}
 
 type nullSaver struct{}
@@ -103,3 +121,7 @@ func (*nullSaver) Chain(hash common.Hash) *evmtypes.Head
 func (*nullSaver) MarkFinalized(ctx context.Context, latestFinalized *evmtypes.Head) error {
 	return nil
 }
+func (*nullSaver) MarkSafe(ctx context.Context, latestSafe *evmtypes.Head) error {
+	return nil
+}
+func (*nullSaver) SaveReorg(ctx context.Context, reorg httypes.Reorg) error { return nil }
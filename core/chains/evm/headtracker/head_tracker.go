@@ -53,3 +53,6 @@ func (*nullTracker) LatestChain() *evmtypes.Head { return nil }
 func (*nullTracker) LatestAndFinalizedBlock(ctx context.Context) (latest, finalized *evmtypes.Head, err error) {
 	return nil, nil, nil
 }
+func (*nullTracker) LatestSafeBlock(ctx context.Context) (safe *evmtypes.Head, err error) {
+	return nil, nil
+}
@@ -781,6 +781,16 @@ func TestHeadTracker_SwitchesToLongestChainWithHeadSamplingDisabled(t *testing.T
 
 	// default 10s may not be sufficient, so using tests.WaitTimeout(t)
 	lastLongestChainAwaiter.AwaitOrFail(t, tests.WaitTimeout(t))
+
+	// the reorg is recorded once the forked chain is backfilled
+	var reorg headtracker.Reorg
+	require.Eventually(t, func() bool {
+		return db.Get(&reorg, `SELECT * FROM evm.reorgs WHERE new_head_hash = $1`, blocksForked.Head(5).Hash) == nil
+	}, tests.WaitTimeout(t), tests.TestInterval)
+	assert.Equal(t, int64(2), reorg.FromBlock)
+	assert.Equal(t, reorg.ToBlock-reorg.FromBlock+1, reorg.Depth)
+	assert.Equal(t, blocks.Head(uint64(reorg.ToBlock)).Hash, reorg.OldHeadHash)
+
 	ht.Stop(t)
 	assert.Equal(t, int64(5), ht.headSaver.LatestChain().Number)
 
@@ -937,15 +947,52 @@ func testHeadTrackerBackfill(t *testing.T, newORM func(t *testing.T) headtracker
 		}
 
 		htu.ethClient.On("LatestFinalizedBlock", mock.Anything).Return(h14, nil).Once()
+		htu.ethClient.On("LatestSafeBlock", mock.Anything).Return(h14, nil).Once()
 		err := htu.headTracker.Backfill(ctx, h15)
 		require.NoError(t, err)
 		assertFinalized(true, "expected heads to be marked as finalized after backfill", h14, h13, h12, h11)
 		assertFinalized(false, "expected heads to remain unfinalized", h15, &head10)
 	})
+	t.Run("Marks all blocks in chain that are older than safe", func(t *testing.T) {
+		htu := newHeadTrackerUniverse(t, opts{Heads: heads, FinalityTagEnabled: true})
+
+		assertSafe := func(expectedSafe bool, msg string, heads ...*evmtypes.Head) {
+			for _, h := range heads {
+				storedHead := htu.headSaver.Chain(h.Hash)
+				assert.Equal(t, expectedSafe, storedHead != nil && storedHead.IsSafe.Load(), msg, "block_number", h.Number)
+			}
+		}
+
+		htu.ethClient.On("LatestFinalizedBlock", mock.Anything).Return(h12, nil).Once()
+		htu.ethClient.On("LatestSafeBlock", mock.Anything).Return(h14, nil).Once()
+		err := htu.headTracker.Backfill(ctx, h15)
+		require.NoError(t, err)
+		assertSafe(true, "expected heads to be marked as safe after backfill", h14, h13, h12, h11)
+		assertSafe(false, "expected heads to remain unsafe", h15, &head10)
+
+		latest := htu.headSaver.Chain(h15.Hash)
+		require.NotNil(t, latest)
+		assert.Equal(t, h14.Hash, latest.LatestSafeHead().BlockHash())
+		assert.Equal(t, h12.Hash, latest.LatestFinalizedHead().BlockHash())
+	})
+	t.Run("falls back to finalized blocks as safe if latest safe block fails", func(t *testing.T) {
+		htu := newHeadTrackerUniverse(t, opts{Heads: heads, FinalityTagEnabled: true})
+		htu.ethClient.On("LatestFinalizedBlock", mock.Anything).Return(h12, nil).Once()
+		htu.ethClient.On("LatestSafeBlock", mock.Anything).Return(nil, errors.New("safe tag is not supported")).Once()
+		err := htu.headTracker.Backfill(ctx, h15)
+		require.NoError(t, err)
+
+		latest := htu.headSaver.Chain(h15.Hash)
+		require.NotNil(t, latest)
+		// finalized blocks are safe
+		assert.Equal(t, h12.Hash, latest.LatestSafeHead().BlockHash())
+		assert.False(t, htu.headSaver.Chain(h13.Hash).IsSafe.Load())
+	})
 
 	t.Run("fetches a missing head", func(t *testing.T) {
 		htu := newHeadTrackerUniverse(t, opts{Heads: heads, FinalityTagEnabled: true})
 		htu.ethClient.On("LatestFinalizedBlock", mock.Anything).Return(h9, nil).Once()
+		htu.ethClient.On("LatestSafeBlock", mock.Anything).Return(h9, nil).Once()
 		htu.ethClient.On("HeadByHash", mock.Anything, head10.Hash).
 			Return(&head10, nil)
 
@@ -967,6 +1014,7 @@ func testHeadTrackerBackfill(t *testing.T, newORM func(t *testing.T) headtracker
 	t.Run("fetches only heads that are missing", func(t *testing.T) {
 		htu := newHeadTrackerUniverse(t, opts{Heads: heads, FinalityTagEnabled: true})
 		htu.ethClient.On("LatestFinalizedBlock", mock.Anything).Return(&head8, nil).Once()
+		htu.ethClient.On("LatestSafeBlock", mock.Anything).Return(&head8, nil).Once()
 
 		htu.ethClient.On("HeadByHash", mock.Anything, head10.Hash).
 			Return(&head10, nil)
@@ -1048,6 +1096,7 @@ func testHeadTrackerBackfill(t *testing.T, newORM func(t *testing.T) headtracker
 		htu := newHeadTrackerUniverse(t, opts{Heads: []*evmtypes.Head{h15}, FinalityTagEnabled: true})
 		finalizedH15 := h15 // copy h15 to have different addresses
 		htu.ethClient.On("LatestFinalizedBlock", mock.Anything).Return(finalizedH15, nil).Once()
+		htu.ethClient.On("LatestSafeBlock", mock.Anything).Return(finalizedH15, nil).Once()
 		err := htu.headTracker.Backfill(ctx, h15)
 		require.NoError(t, err)
 
@@ -1068,6 +1117,8 @@ func testHeadTrackerBackfill(t *testing.T, newORM func(t *testing.T) headtracker
 		htu.ethClient.On("HeadByHash", mock.Anything, h12.Hash).Return(h12, nil).Once()
 		htu.ethClient.On("HeadByHash", mock.Anything, h13.Hash).Return(h13, nil).Once()
 		htu.ethClient.On("HeadByHash", mock.Anything, h14.Hash).Return(h14, nil).Once()
+		// the latest safe block is offset by FinalizedBlockOffset too
+		htu.ethClient.On("LatestSafeBlock", mock.Anything).Return(h14, nil).Once()
 		err := htu.headTracker.Backfill(ctx, h15)
 		require.NoError(t, err)
 
@@ -1079,6 +1130,7 @@ func testHeadTrackerBackfill(t *testing.T, newORM func(t *testing.T) headtracker
 		}
 
 		assert.True(t, h.IsFinalized.Load())
+		assert.True(t, h.IsSafe.Load())
 		assert.Equal(t, h12.BlockNumber(), h.BlockNumber())
 		assert.Equal(t, h12.Hash, h.Hash)
 	})
@@ -1262,6 +1314,87 @@ func TestHeadTracker_LatestAndFinalizedBlock(t *testing.T) {
 	})
 }
 
+func TestHeadTracker_LatestSafeBlock(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+
+	h11 := testutils.Head(11)
+	h11.ParentHash = utils.NewHash()
+
+	h12 := testutils.Head(12)
+	h12.ParentHash = h11.Hash
+
+	h13 := testutils.Head(13)
+	h13.ParentHash = h12.Hash
+
+	// newHeadTrackerUniverse returns a head tracker whose latest chain is loaded from heads.
+	newHeadTrackerUniverse := func(t *testing.T, heads ...*evmtypes.Head) *headTrackerUniverse {
+		evmcfg := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+			c.FinalityTagEnabled = ptr(true)
+		})
+
+		db := pgtest.NewSqlxDB(t)
+		orm := headtracker.NewORM(*testutils.FixtureChainID, db)
+		for i := range heads {
+			require.NoError(t, orm.IdempotentInsertHead(tests.Context(t), heads[i]))
+		}
+		ethClient := evmtest.NewEthClientMock(t)
+		ethClient.On("ConfiguredChainID", mock.Anything).Return(testutils.FixtureChainID, nil)
+		ht := createHeadTracker(t, ethClient, evmcfg.EVM(), evmcfg.EVM().HeadTracker(), orm)
+		_, err := ht.headSaver.Load(tests.Context(t), 0)
+		require.NoError(t, err)
+		return ht
+	}
+	t.Run("returns latest safe block from RPC if no heads are tracked", func(t *testing.T) {
+		htu := newHeadTrackerUniverse(t)
+		htu.ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(h13, nil).Once()
+		htu.ethClient.On("LatestFinalizedBlock", mock.Anything).Return(h11, nil).Once()
+		htu.ethClient.On("LatestSafeBlock", mock.Anything).Return(h12, nil).Once()
+
+		actual, err := htu.headTracker.LatestSafeBlock(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, h12.Number, actual.Number)
+	})
+	t.Run("returns latest safe block from RPC if no safe block is marked", func(t *testing.T) {
+		htu := newHeadTrackerUniverse(t, h13, h12, h11)
+		htu.ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(h13, nil).Once()
+		htu.ethClient.On("LatestFinalizedBlock", mock.Anything).Return(h11, nil).Once()
+		htu.ethClient.On("LatestSafeBlock", mock.Anything).Return(h12, nil).Once()
+
+		actual, err := htu.headTracker.LatestSafeBlock(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, h12.Number, actual.Number)
+	})
+	t.Run("returns error if failed to get latest safe block from RPC", func(t *testing.T) {
+		htu := newHeadTrackerUniverse(t)
+		htu.ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(h13, nil).Once()
+		htu.ethClient.On("LatestFinalizedBlock", mock.Anything).Return(h11, nil).Once()
+		const expectedError = "failed to get latest safe block"
+		htu.ethClient.On("LatestSafeBlock", mock.Anything).Return(nil, errors.New(expectedError)).Once()
+
+		_, err := htu.headTracker.LatestSafeBlock(ctx)
+		require.ErrorContains(t, err, expectedError)
+	})
+	t.Run("returns latest safe block marked without calling RPC", func(t *testing.T) {
+		htu := newHeadTrackerUniverse(t, h13, h12, h11)
+		require.NoError(t, htu.headSaver.MarkSafe(ctx, h12))
+
+		actual, err := htu.headTracker.LatestSafeBlock(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, h12.Number, actual.Number)
+		assert.Equal(t, h12.Hash, actual.Hash)
+	})
+	t.Run("returns latest finalized block marked without calling RPC", func(t *testing.T) {
+		htu := newHeadTrackerUniverse(t, h13, h12, h11)
+		require.NoError(t, htu.headSaver.MarkFinalized(ctx, h11))
+
+		actual, err := htu.headTracker.LatestSafeBlock(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, h11.Number, actual.Number)
+	})
+}
+
 func createHeadTracker(t testing.TB, ethClient *evmclimocks.Client, config commontypes.Config, htConfig commontypes.HeadTrackerConfig, orm headtracker.ORM) *headTrackerUniverse {
 	lggr, ob := logger.TestObserved(t, zap.DebugLevel)
 	hb := headtracker.NewHeadBroadcaster(lggr)
@@ -24,6 +24,8 @@ type Heads interface {
 	// MarkFinalized - finds `finalized` in the LatestHead and marks it and all direct ancestors as finalized.
 	// Trims old blocks whose height is smaller than minBlockToKeep
 	MarkFinalized(finalized common.Hash, minBlockToKeep int64) bool
+	// MarkSafe - finds `safe` in the collection and marks it and all direct ancestors as safe.
+	MarkSafe(safe common.Hash) bool
 }
 
 type heads struct {
@@ -111,6 +113,28 @@ func markFinalized(head *evmtypes.Head) {
 	}
 }
 
+// MarkSafe - marks block with hash equal to safe and all it's direct ancestors as safe.
+func (h *heads) MarkSafe(safe common.Hash) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	safeHead, ok := h.headsByHash[safe]
+	if !ok {
+		return false
+	}
+
+	markSafe(safeHead)
+	return true
+}
+
+func markSafe(head *evmtypes.Head) {
+	// we can assume that if a head was previously marked as safe all its ancestors were marked as safe
+	for head != nil && !head.IsSafe.Load() {
+		head.IsSafe.Store(true)
+		head = head.Parent.Load()
+	}
+}
+
 func (h *heads) ensureNoCycles(newHead *evmtypes.Head) error {
 	if newHead.ParentHash == newHead.Hash {
 		return fmt.Errorf("cycle detected: newHeads reference itself newHead(%s)", newHead.String())
@@ -146,6 +170,7 @@ func (h *heads) AddHeads(newHeads ...*evmtypes.Head) error {
 
 		// heads now owns the newHead - reset values that are populated by heads
 		newHead.IsFinalized.Store(false)
+		newHead.IsSafe.Store(false)
 		newHead.Parent.Store(nil)
 
 		// prefer newer head to set as highest
@@ -172,6 +197,10 @@ func (h *heads) AddHeads(newHeads ...*evmtypes.Head) error {
 				// mark newHead as finalized if any of its children is finalized
 				markFinalized(newHead)
 			}
+			if child.IsSafe.Load() {
+				// mark newHead as safe if any of its children is safe
+				markSafe(newHead)
+			}
 		}
 	}
 
@@ -195,6 +195,38 @@ func TestHeads_MarkFinalized(t *testing.T) {
 	assert.True(t, heads.HeadByHash(h2.Hash).IsFinalized.Load())
 }
 
+func TestHeads_MarkSafe(t *testing.T) {
+	t.Parallel()
+
+	heads := headtracker.NewHeads()
+
+	// create chain
+	// H0 <- H1 <- H2 <- H3 - Canonical
+	//          	//           H2Uncle
+	newHead := func(num int, parent common.Hash) *evmtypes.Head {
+		h := evmtypes.NewHead(big.NewInt(int64(num)), utils.NewHash(), parent, ubig.NewI(0))
+		return &h
+	}
+	h0 := newHead(0, utils.NewHash())
+	h1 := newHead(1, h0.Hash)
+	h2 := newHead(2, h1.Hash)
+	h3 := newHead(3, h2.Hash)
+	h2Uncle := newHead(2, h1.Hash)
+
+	assert.NoError(t, heads.AddHeads(h0, h1, h2, h2Uncle, h3))
+	require.False(t, heads.MarkSafe(utils.NewHash()), "expected false if safe hash was not found")
+	// mark h2 and all ancestors as safe
+	require.True(t, heads.MarkSafe(h2.Hash), "expected MarkSafe succeed")
+
+	for _, head := range []*evmtypes.Head{h2, h1, h0} {
+		assert.True(t, heads.HeadByHash(head.Hash).IsSafe.Load(), "expected h2 and all ancestors to be safe", head.BlockNumber())
+		assert.False(t, heads.HeadByHash(head.Hash).IsFinalized.Load(), "expected safe blocks not to be marked as finalized", head.BlockNumber())
+	}
+	assert.False(t, heads.HeadByHash(h3.Hash).IsSafe.Load(), "expected h3 not to be safe")
+	assert.False(t, heads.HeadByHash(h2Uncle.Hash).IsSafe.Load(), "expected uncle block not to be marked as safe")
+	assert.Equal(t, h2.Hash, heads.LatestHead().LatestSafeHead().BlockHash())
+}
+
 func BenchmarkEarliestHeadInChain(b *testing.B) {
 	const latestBlockNum = 200_000
 	blocks := NewBlocks(b, latestBlockNum+1)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	pkgerrors "github.com/pkg/errors"
@@ -26,6 +27,20 @@ type ORM interface {
 	LatestHeads(ctx context.Context, minBlockNumber int64) (heads []*evmtypes.Head, err error)
 	// HeadByHash fetches the head with the given hash from the db, returns nil if none exists
 	HeadByHash(ctx context.Context, hash common.Hash) (head *evmtypes.Head, err error)
+	// InsertReorg records a reorg of the canonical chain
+	InsertReorg(ctx context.Context, reorg *Reorg) error
+}
+
+// Reorg is a row of the evm.reorgs table.
+type Reorg struct {
+	ID          int64
+	EVMChainID  ubig.Big
+	Depth       int64
+	OldHeadHash common.Hash
+	NewHeadHash common.Hash
+	FromBlock   int64
+	ToBlock     int64
+	DetectedAt  time.Time
 }
 
 var _ ORM = &DbORM{}
@@ -84,6 +99,43 @@ func (orm *DbORM) HeadByHash(ctx context.Context, hash common.Hash) (head *evmty
 	return head, err
 }
 
+func (orm *DbORM) InsertReorg(ctx context.Context, reorg *Reorg) error {
+	query := `INSERT INTO evm.reorgs (evm_chain_id, depth, old_head_hash, new_head_hash, from_block, to_block, detected_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id`
+	err := orm.ds.GetContext(ctx, &reorg.ID, query, orm.chainID, reorg.Depth, reorg.OldHeadHash, reorg.NewHeadHash, reorg.FromBlock, reorg.ToBlock, reorg.DetectedAt)
+	return pkgerrors.Wrap(err, "InsertReorg failed to insert reorg")
+}
+
+// ReorgORM lists the reorgs recorded for all chains.
+type ReorgORM interface {
+	// Reorgs returns a page of the reorgs of chainID, or of all chains if nil, most recent first, and the total count.
+	Reorgs(ctx context.Context, chainID *big.Int, offset, limit int) ([]Reorg, int, error)
+}
+
+type reorgORM struct {
+	ds sqlutil.DataSource
+}
+
+var _ ReorgORM = &reorgORM{}
+
+func NewReorgORM(ds sqlutil.DataSource) ReorgORM {
+	return &reorgORM{ds: ds}
+}
+
+func (orm *reorgORM) Reorgs(ctx context.Context, chainID *big.Int, offset, limit int) (reorgs []Reorg, count int, err error) {
+	var evmChainID *ubig.Big
+	if chainID != nil {
+		evmChainID = ubig.New(chainID)
+	}
+	err = orm.ds.GetContext(ctx, &count, `SELECT count(*) FROM evm.reorgs WHERE $1::numeric IS NULL OR evm_chain_id = $1`, evmChainID)
+	if err != nil {
+		return nil, 0, pkgerrors.Wrap(err, "Reorgs failed to count reorgs")
+	}
+	err = orm.ds.SelectContext(ctx, &reorgs, `SELECT * FROM evm.reorgs WHERE $1::numeric IS NULL OR evm_chain_id = $1
+	ORDER BY detected_at DESC, id DESC LIMIT $2 OFFSET $3`, evmChainID, limit, offset)
+	return reorgs, count, pkgerrors.Wrap(err, "Reorgs failed to load reorgs")
+}
+
 type nullORM struct{}
 
 func NewNullORM() ORM {
@@ -109,3 +161,7 @@ func (orm *nullORM) LatestHeads(ctx context.Context, minBlockNumer int64) (heads
 func (orm *nullORM) HeadByHash(ctx context.Context, hash common.Hash) (head *evmtypes.Head, err error) {
 	return nil, nil
 }
+
+func (orm *nullORM) InsertReorg(ctx context.Context, reorg *Reorg) error {
+	return nil
+}
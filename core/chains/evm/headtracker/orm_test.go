@@ -1,7 +1,9 @@
 package headtracker_test
 
 import (
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
@@ -110,3 +112,40 @@ func TestORM_LatestHeads_NoRows(t *testing.T) {
 	require.Zero(t, len(heads))
 	require.NoError(t, err)
 }
+
+func TestORM_Reorgs(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	ctx := tests.Context(t)
+	otherChainID := big.NewInt(1337)
+
+	for i, chainID := range []*big.Int{testutils.FixtureChainID, testutils.FixtureChainID, otherChainID} {
+		orm := headtracker.NewORM(*chainID, db)
+		reorg := &headtracker.Reorg{
+			Depth:       int64(i + 1),
+			OldHeadHash: testutils.NewHash(),
+			NewHeadHash: testutils.NewHash(),
+			FromBlock:   10,
+			ToBlock:     int64(10 + i),
+			DetectedAt:  time.Now().Add(time.Duration(i) * time.Second),
+		}
+		require.NoError(t, orm.InsertReorg(ctx, reorg))
+		assert.NotZero(t, reorg.ID)
+	}
+
+	reorgORM := headtracker.NewReorgORM(db)
+	reorgs, count, err := reorgORM.Reorgs(ctx, nil, 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, reorgs, 2)
+	assert.Equal(t, int64(3), reorgs[0].Depth)
+	assert.Equal(t, otherChainID, reorgs[0].EVMChainID.ToInt())
+	assert.Equal(t, int64(2), reorgs[1].Depth)
+
+	reorgs, count, err = reorgORM.Reorgs(ctx, testutils.FixtureChainID, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, reorgs, 1)
+	assert.Equal(t, int64(1), reorgs[0].Depth)
+}
@@ -53,6 +53,24 @@ func (ht *simulatedHeadTracker) LatestAndFinalizedBlock(ctx context.Context) (*e
 	return latest, finalizedBlock, nil
 }
 
+func (ht *simulatedHeadTracker) LatestSafeBlock(ctx context.Context) (*evmtypes.Head, error) {
+	if !ht.useFinalityTag {
+		_, finalizedBlock, err := ht.LatestAndFinalizedBlock(ctx)
+		return finalizedBlock, err
+	}
+
+	safeBlock, err := ht.ec.LatestSafeBlock(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("simulatedHeadTracker failed to get safe block")
+	}
+
+	if safeBlock == nil {
+		return nil, fmt.Errorf("expected safe block to be valid")
+	}
+
+	return safeBlock, nil
+}
+
 func (ht *simulatedHeadTracker) LatestChain() *evmtypes.Head {
 	return nil
 }
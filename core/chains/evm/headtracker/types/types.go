@@ -25,5 +25,6 @@ type (
 	HeadTrackable   = headtracker.HeadTrackable[*evmtypes.Head, common.Hash]
 	HeadListener    = headtracker.HeadListener[*evmtypes.Head, common.Hash]
 	HeadBroadcaster = headtracker.HeadBroadcaster[*evmtypes.Head, common.Hash]
+	Reorg           = headtracker.Reorg[common.Hash]
 	Client          = htrktypes.Client[*evmtypes.Head, ethereum.Subscription, *big.Int, common.Hash]
 )
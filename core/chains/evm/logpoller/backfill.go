@@ -0,0 +1,359 @@
+package logpoller
+
+import (
+	"cmp"
+	"context"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	pkgerrors "github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+)
+
+const (
+	// backfillWorkersPerRPC is the number of chunks of a parallel backfill fetched from each RPC at a time.
+	backfillWorkersPerRPC = 2
+	// backfillRetryInterval is how often unfinished parallel backfills are resumed after a failure.
+	backfillRetryInterval = time.Minute
+)
+
+var (
+	ErrBackfillInProgress  = pkgerrors.New("backfill of the filter is already in progress")
+	ErrFilterNotRegistered = pkgerrors.New("filter is not registered")
+)
+
+// ParallelClient is implemented by clients backed by several RPCs, which parallel backfills spread their chunks across.
+type ParallelClient interface {
+	LogFilterers(ctx context.Context) ([]client.LogFilterer, error)
+}
+
+// BackfillFilter starts a parallel backfill of the logs matching a registered filter, from fromBlock up to the latest
+// finalized block, and replays the later blocks once it completes. The range is split into chunks which are fetched
+// in parallel from all alive RPCs, and chunks with too many results are split again. The progress is saved, so that
+// the backfill resumes where it stopped after a failure or a restart.
+// BackfillFilter returns once the backfill is scheduled, its progress is reported by Backfills.
+func (lp *logPoller) BackfillFilter(ctx context.Context, name string, fromBlock int64) error {
+	if !lp.HasFilter(name) {
+		return pkgerrors.Wrapf(ErrFilterNotRegistered, "failed to backfill filter %q", name)
+	}
+	if fromBlock < 1 {
+		return pkgerrors.Errorf("Invalid backfill block number %v, must be positive", fromBlock)
+	}
+
+	lp.backfillMu.Lock()
+	defer lp.backfillMu.Unlock()
+	if _, running := lp.backfillCancels[name]; running {
+		return ErrBackfillInProgress
+	}
+
+	savedFinalizedBlockNumber, err := lp.savedFinalizedBlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	if fromBlock > savedFinalizedBlockNumber {
+		// Nothing is finalized in the range yet, a replay is enough
+		lp.ReplayAsync(fromBlock)
+		return nil
+	}
+
+	if err = lp.orm.UpsertBackfill(ctx, name, fromBlock, savedFinalizedBlockNumber); err != nil {
+		return pkgerrors.Wrap(err, "error saving backfill")
+	}
+	lp.lggr.Infow("Scheduled backfill", "filter", name, "fromBlock", fromBlock, "toBlock", savedFinalizedBlockNumber)
+	lp.triggerBackfills()
+	return nil
+}
+
+// Backfills returns the progress of the backfills started by BackfillFilter, including the completed ones.
+func (lp *logPoller) Backfills(ctx context.Context) ([]Backfill, error) {
+	return lp.orm.SelectBackfills(ctx)
+}
+
+func (lp *logPoller) triggerBackfills() {
+	select {
+	case lp.backfillTrigger <- struct{}{}:
+	default:
+	}
+}
+
+// cancelBackfill stops the backfill of a filter if it is running.
+func (lp *logPoller) cancelBackfill(name string) {
+	lp.backfillMu.Lock()
+	defer lp.backfillMu.Unlock()
+	if cancel, ok := lp.backfillCancels[name]; ok {
+		cancel()
+	}
+}
+
+func (lp *logPoller) backfillWorkerRun() {
+	defer lp.wg.Done()
+	ctx, cancel := lp.stopCh.NewCtx()
+	defer cancel()
+
+	retryTicker := services.NewTicker(backfillRetryInterval)
+	defer retryTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-lp.backfillTrigger:
+		case <-retryTicker.C:
+		}
+		lp.runBackfills(ctx)
+	}
+}
+
+// runBackfills runs the unfinished backfills one after the other. Backfills of filters that are not loaded yet are
+// skipped, they are resumed once filters are loaded.
+func (lp *logPoller) runBackfills(ctx context.Context) {
+	backfills, err := lp.orm.SelectBackfills(ctx)
+	if err != nil {
+		lp.lggr.Errorw("Unable to load backfills", "err", err)
+		return
+	}
+	filters := lp.GetFilters()
+	for _, b := range backfills {
+		if b.CompletedAt != nil {
+			continue
+		}
+		filter, ok := filters[b.FilterName]
+		if !ok {
+			continue
+		}
+		if err = lp.runBackfill(ctx, b, filter); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			lp.lggr.Warnw("Backfill failed, resuming later", "filter", b.FilterName, "nextBlock", b.NextBlock, "err", err)
+		}
+	}
+}
+
+func (lp *logPoller) runBackfill(ctx context.Context, b Backfill, filter Filter) error {
+	lp.backfillMu.Lock()
+	if _, running := lp.backfillCancels[b.FilterName]; running {
+		lp.backfillMu.Unlock()
+		return ErrBackfillInProgress
+	}
+	// Backfills can wait, so that they do not use up the budget of the nodes for transactions and new heads
+	ctx, cancel := context.WithCancel(client.CtxWithRPCPriority(ctx, client.RPCPriorityLow))
+	defer cancel()
+	lp.backfillCancels[b.FilterName] = cancel
+	lp.backfillMu.Unlock()
+	defer func() {
+		lp.backfillMu.Lock()
+		delete(lp.backfillCancels, b.FilterName)
+		lp.backfillMu.Unlock()
+	}()
+
+	lp.lggr.Infow("Backfilling logs of filter", "filter", b.FilterName, "fromBlock", b.FromBlock, "nextBlock", b.NextBlock, "toBlock", b.ToBlock)
+
+	var progressMu sync.Mutex
+	savedNextBlock := b.NextBlock
+	saveProgress := func(nextBlock int64) error {
+		progressMu.Lock()
+		defer progressMu.Unlock()
+		if nextBlock <= savedNextBlock {
+			return nil
+		}
+		if err := lp.orm.UpdateBackfillProgress(ctx, b.FilterName, nextBlock, ""); err != nil {
+			return pkgerrors.Wrap(err, "error saving backfill progress")
+		}
+		savedNextBlock = nextBlock
+		return nil
+	}
+
+	q := newBackfillQueue(b.NextBlock, b.ToBlock, lp.backfillBatchSize)
+	if err := lp.parallelBackfill(ctx, q, filter, saveProgress); err != nil {
+		if ctx.Err() == nil {
+			if perr := lp.orm.UpdateBackfillProgress(ctx, b.FilterName, savedNextBlock, err.Error()); perr != nil {
+				lp.lggr.Warnw("Unable to save backfill error", "filter", b.FilterName, "err", perr)
+			}
+		}
+		return err
+	}
+
+	// The blocks after the backfilled range may have been polled before the filter was registered
+	if err := lp.Replay(ctx, b.ToBlock+1); err != nil {
+		return pkgerrors.Wrap(err, "error replaying blocks after backfill")
+	}
+	if err := lp.orm.CompleteBackfill(ctx, b.FilterName); err != nil {
+		return pkgerrors.Wrap(err, "error completing backfill")
+	}
+	lp.lggr.Infow("Backfill completed", "filter", b.FilterName, "fromBlock", b.FromBlock, "toBlock", b.ToBlock)
+	return nil
+}
+
+// parallelBackfill backfills the chunks of q with the logs matching filter, calling saveProgress whenever the first
+// block that is not backfilled yet advances.
+func (lp *logPoller) parallelBackfill(ctx context.Context, q *backfillQueue, filter Filter, saveProgress func(nextBlock int64) error) error {
+	filterers := lp.backfillFilterers(ctx)
+	eg, ctx := errgroup.WithContext(ctx)
+	for i := 0; i < len(filterers)*backfillWorkersPerRPC; i++ {
+		filterer := filterers[i%len(filterers)]
+		eg.Go(func() error {
+			for {
+				r, ok := q.take()
+				if !ok {
+					return nil
+				}
+				err := lp.backfillChunk(ctx, filterer, filter, r)
+				if err != nil {
+					if !client.IsTooManyResults(err, lp.clientErrors) {
+						lp.lggr.Errorw("Unable to backfill logs", "err", err, "filter", filter.Name, "from", r.from, "to", r.to)
+						return err
+					}
+					if r.from == r.to {
+						lp.lggr.Criticalw("Too many log results in a single block, failed to retrieve logs! Node may be running in a degraded state.", "err", err, "filter", filter.Name, "block", r.from)
+						return err
+					}
+					chunkSize := q.split(r)
+					lp.lggr.Warnw("Too many log results, splitting block range", "err", err, "filter", filter.Name, "from", r.from, "to", r.to, "newChunkSize", chunkSize)
+					continue
+				}
+				if nextBlock, advanced := q.complete(r); advanced {
+					if err = saveProgress(nextBlock); err != nil {
+						return err
+					}
+				}
+			}
+		})
+	}
+	return eg.Wait()
+}
+
+// backfillFilterers returns the RPCs to spread the chunks of a backfill across, or the client itself if it does not
+// expose them.
+func (lp *logPoller) backfillFilterers(ctx context.Context) []client.LogFilterer {
+	if pc, ok := lp.ec.(ParallelClient); ok {
+		filterers, err := pc.LogFilterers(ctx)
+		if err == nil && len(filterers) > 0 {
+			return filterers
+		}
+		lp.lggr.Warnw("Unable to get RPCs for parallel backfill, falling back to the selected RPC", "err", err)
+	}
+	return []client.LogFilterer{lp.ec}
+}
+
+// backfillChunk saves the logs matching filter in the block range r. Unlike backfill, it does not save blocks, since
+// chunks complete out of order.
+func (lp *logPoller) backfillChunk(ctx context.Context, filterer client.LogFilterer, filter Filter, r blockRange) error {
+	gethLogs, err := filterer.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: big.NewInt(r.from),
+		ToBlock:   big.NewInt(r.to),
+		Addresses: filter.Addresses,
+		Topics:    [][]common.Hash{filter.EventSigs},
+	})
+	if err != nil {
+		return err
+	}
+	if len(gethLogs) == 0 {
+		return nil
+	}
+
+	numbers := make([]uint64, len(gethLogs))
+	for i, log := range gethLogs {
+		numbers[i] = log.BlockNumber
+	}
+	blocks, err := lp.GetBlocksRange(ctx, numbers)
+	if err != nil {
+		return err
+	}
+
+	lp.lggr.Debugw("Backfill found logs", "filter", filter.Name, "from", r.from, "to", r.to, "logs", len(gethLogs))
+	logs := convertLogs(gethLogs, blocks, lp.lggr, lp.ec.ConfiguredChainID())
+	if err = lp.orm.InsertLogs(ctx, logs); err != nil {
+		return err
+	}
+	lp.notifySubscriptions(r.from, r.to, logs)
+	return nil
+}
+
+type blockRange struct {
+	from, to int64
+}
+
+// backfillQueue hands out the chunks of the block range of a parallel backfill, and tracks the first block that is not
+// backfilled yet.
+type backfillQueue struct {
+	mu        sync.Mutex
+	next      int64 // first block not handed out yet
+	end       int64
+	chunkSize int64
+	halves    []blockRange // halves of chunks with too many results, handed out first
+	inFlight  map[int64]blockRange
+}
+
+func newBackfillQueue(start, end, chunkSize int64) *backfillQueue {
+	return &backfillQueue{
+		next:      start,
+		end:       end,
+		chunkSize: max(chunkSize, 1),
+		inFlight:  make(map[int64]blockRange),
+	}
+}
+
+// take returns the next chunk to backfill, or false if all chunks have been handed out.
+func (q *backfillQueue) take() (blockRange, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var r blockRange
+	switch {
+	case len(q.halves) > 0:
+		r = q.halves[0]
+		q.halves = q.halves[1:]
+	case q.next <= q.end:
+		r = blockRange{from: q.next, to: min(q.next+q.chunkSize-1, q.end)}
+		q.next = r.to + 1
+	default:
+		return blockRange{}, false
+	}
+	q.inFlight[r.from] = r
+	return r, true
+}
+
+// split requeues the halves of a chunk with too many results, and shrinks the chunks handed out from then on. It
+// returns the new chunk size.
+func (q *backfillQueue) split(r blockRange) int64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	delete(q.inFlight, r.from)
+	half := (r.to - r.from + 1) / 2
+	q.halves = append(q.halves, blockRange{from: r.from, to: r.from + half - 1}, blockRange{from: r.from + half, to: r.to})
+	slices.SortFunc(q.halves, func(a, b blockRange) int { return cmp.Compare(a.from, b.from) })
+	q.chunkSize = max(min(q.chunkSize, half), 1)
+	return q.chunkSize
+}
+
+// complete marks a chunk as backfilled, and returns the first block that is not backfilled yet if it advanced.
+func (q *backfillQueue) complete(r blockRange) (int64, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	before := q.nextBlock()
+	delete(q.inFlight, r.from)
+	after := q.nextBlock()
+	return after, after > before
+}
+
+func (q *backfillQueue) nextBlock() int64 {
+	next := q.next
+	for from := range q.inFlight {
+		next = min(next, from)
+	}
+	for _, r := range q.halves {
+		next = min(next, r.from)
+	}
+	return next
+}
@@ -0,0 +1,125 @@
+package logpoller
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	htMocks "github.com/smartcontractkit/chainlink/v2/common/headtracker/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestBackfillQueue(t *testing.T) {
+	t.Parallel()
+
+	q := newBackfillQueue(10, 29, 5)
+	assert.Equal(t, int64(10), q.nextBlock())
+
+	r1, ok := q.take()
+	require.True(t, ok)
+	assert.Equal(t, blockRange{from: 10, to: 14}, r1)
+	r2, ok := q.take()
+	require.True(t, ok)
+	assert.Equal(t, blockRange{from: 15, to: 19}, r2)
+
+	t.Run("out of order chunks do not advance progress", func(t *testing.T) {
+		next, advanced := q.complete(r2)
+		assert.False(t, advanced)
+		assert.Equal(t, int64(10), next)
+	})
+
+	t.Run("split chunks are handed out first", func(t *testing.T) {
+		assert.Equal(t, int64(2), q.split(r1))
+		r, ok := q.take()
+		require.True(t, ok)
+		assert.Equal(t, blockRange{from: 10, to: 11}, r)
+		next, advanced := q.complete(r)
+		assert.True(t, advanced)
+		assert.Equal(t, int64(12), next)
+
+		r, ok = q.take()
+		require.True(t, ok)
+		assert.Equal(t, blockRange{from: 12, to: 14}, r)
+		next, advanced = q.complete(r)
+		assert.True(t, advanced)
+		assert.Equal(t, int64(20), next, "skips the chunk completed out of order")
+	})
+
+	t.Run("smaller chunks are handed out after a split", func(t *testing.T) {
+		var taken []blockRange
+		for {
+			r, ok := q.take()
+			if !ok {
+				break
+			}
+			taken = append(taken, r)
+		}
+		assert.Equal(t, []blockRange{{20, 21}, {22, 23}, {24, 25}, {26, 27}, {28, 29}}, taken)
+		for _, r := range taken {
+			q.complete(r)
+		}
+		assert.Equal(t, int64(30), q.nextBlock())
+	})
+}
+
+func TestLogPoller_parallelBackfill(t *testing.T) {
+	t.Parallel()
+
+	lggr := logger.Test(t)
+	ec := evmclimocks.NewClient(t)
+	headTracker := htMocks.NewHeadTracker[*evmtypes.Head, common.Hash](t)
+	lp := NewLogPoller(nil, ec, lggr, headTracker, Opts{
+		PollPeriod:        time.Hour,
+		FinalityDepth:     2,
+		BackfillBatchSize: 20,
+		RpcBatchSize:      10,
+	})
+
+	tooLargeErr := client.JsonError{
+		Code:    -32005,
+		Message: "query returned more than 10000 results. Try with this block range [0x1, 0x10].",
+	}
+
+	var mu sync.Mutex
+	var queried []blockRange
+	ec.On("FilterLogs", mock.Anything, mock.Anything).Return(func(ctx context.Context, fq ethereum.FilterQuery) ([]types.Log, error) {
+		r := blockRange{from: fq.FromBlock.Int64(), to: fq.ToBlock.Int64()}
+		if r.to-r.from+1 > 5 {
+			return nil, tooLargeErr
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		queried = append(queried, r)
+		return nil, nil
+	})
+
+	filter := Filter{Name: "filter", EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID}, Addresses: []common.Address{testutils.NewAddress()}}
+	var progress int64
+	q := newBackfillQueue(1, 100, 20)
+	require.NoError(t, lp.parallelBackfill(testutils.Context(t), q, filter, func(nextBlock int64) error {
+		mu.Lock()
+		defer mu.Unlock()
+		progress = max(progress, nextBlock)
+		return nil
+	}))
+
+	var backfilled int64
+	for _, r := range queried {
+		backfilled += r.to - r.from + 1
+	}
+	assert.Equal(t, int64(100), backfilled, "every block is backfilled once")
+	assert.Equal(t, int64(101), progress)
+}
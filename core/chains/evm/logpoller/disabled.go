@@ -38,6 +38,16 @@ func (disabled) Replay(ctx context.Context, fromBlock int64) error { return ErrD
 
 func (disabled) ReplayAsync(fromBlock int64) {}
 
+func (disabled) BackfillFilter(ctx context.Context, name string, fromBlock int64) error {
+	return ErrDisabled
+}
+
+func (disabled) Backfills(ctx context.Context) ([]Backfill, error) { return nil, ErrDisabled }
+
+func (disabled) Subscribe(ctx context.Context, filterName string, from LogCursor) (*Subscription, error) {
+	return nil, ErrDisabled
+}
+
 func (disabled) RegisterFilter(ctx context.Context, filter Filter) error { return ErrDisabled }
 
 func (disabled) UnregisterFilter(ctx context.Context, name string) error { return ErrDisabled }
@@ -40,6 +40,9 @@ type LogPoller interface {
 	Healthy() error
 	Replay(ctx context.Context, fromBlock int64) error
 	ReplayAsync(fromBlock int64)
+	BackfillFilter(ctx context.Context, name string, fromBlock int64) error
+	Backfills(ctx context.Context) ([]Backfill, error)
+	Subscribe(ctx context.Context, filterName string, from LogCursor) (*Subscription, error)
 	RegisterFilter(ctx context.Context, filter Filter) error
 	UnregisterFilter(ctx context.Context, name string) error
 	HasFilter(name string) bool
@@ -92,6 +95,7 @@ type Client interface {
 
 type HeadTracker interface {
 	LatestAndFinalizedBlock(ctx context.Context) (latest, finalized *evmtypes.Head, err error)
+	LatestSafeBlock(ctx context.Context) (safe *evmtypes.Head, err error)
 }
 
 var (
@@ -127,8 +131,16 @@ type logPoller struct {
 
 	replayStart    chan int64
 	replayComplete chan error
-	stopCh         services.StopChan
-	wg             sync.WaitGroup
+
+	backfillTrigger chan struct{}
+	backfillMu      sync.Mutex
+	backfillCancels map[string]context.CancelFunc // of the running parallel backfills by filter name
+
+	subsMu sync.RWMutex
+	subs   map[*Subscription]struct{}
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
 	// This flag is raised whenever the log poller detects that the chain's finality has been violated.
 	// It can happen when reorg is deeper than the latest finalized block that LogPoller saw in a previous PollAndSave tick.
 	// Usually the only way to recover is to manually remove the offending logs and block from the database.
@@ -169,6 +181,9 @@ func NewLogPoller(orm ORM, ec Client, lggr logger.Logger, headTracker HeadTracke
 		lggr:                     logger.Sugared(logger.Named(lggr, "LogPoller")),
 		replayStart:              make(chan int64),
 		replayComplete:           make(chan error),
+		backfillTrigger:          make(chan struct{}, 1),
+		backfillCancels:          make(map[string]context.CancelFunc),
+		subs:                     make(map[*Subscription]struct{}),
 		pollPeriod:               opts.PollPeriod,
 		backupPollerBlockDelay:   opts.BackupPollerBlockDelay,
 		finalityDepth:            opts.FinalityDepth,
@@ -315,9 +330,11 @@ func (lp *logPoller) UnregisterFilter(ctx context.Context, name string) error {
 		return nil
 	}
 
+	lp.cancelBackfill(name)
 	if err := lp.orm.DeleteFilter(ctx, name); err != nil {
 		return pkgerrors.Wrap(err, "error deleting filter")
 	}
+	lp.stopSubscriptions(name)
 	delete(lp.filters, name)
 	lp.filterDirty = true
 	return nil
@@ -504,9 +521,10 @@ func (lp *logPoller) ReplayAsync(fromBlock int64) {
 
 func (lp *logPoller) Start(context.Context) error {
 	return lp.StartOnce("LogPoller", func() error {
-		lp.wg.Add(2)
+		lp.wg.Add(3)
 		go lp.run()
 		go lp.backgroundWorkerRun()
+		go lp.backfillWorkerRun()
 		return nil
 	})
 }
@@ -519,6 +537,9 @@ func (lp *logPoller) Close() error {
 		}
 		close(lp.stopCh)
 		lp.wg.Wait()
+		for _, s := range lp.stopSubscriptions("") {
+			s.wg.Wait()
+		}
 		return nil
 	})
 }
@@ -624,6 +645,8 @@ func (lp *logPoller) run() {
 					continue
 				}
 				filtersLoaded = true
+				// resume the backfills that did not complete before the restart
+				lp.triggerBackfills()
 			}
 
 			// Always start from the latest block in the db.
@@ -866,6 +889,7 @@ func (lp *logPoller) blocksFromLogs(ctx context.Context, logs []types.Log, endBl
 // Retries until ctx cancelled. Will return an error if cancelled
 // or if there is an error backfilling.
 func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
+	ctx = client.CtxWithRPCPriority(ctx, client.RPCPriorityLow)
 	batchSize := lp.backfillBatchSize
 	for from := start; from <= end; from += batchSize {
 		to := mathutil.Min(from+batchSize-1, end)
@@ -887,6 +911,7 @@ func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
 			continue
 		}
 		if len(gethLogs) == 0 {
+			lp.notifySubscriptions(from, to, nil)
 			continue
 		}
 		blocks, err := lp.blocksFromLogs(ctx, gethLogs, uint64(to))
@@ -901,11 +926,13 @@ func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
 		}
 
 		lp.lggr.Debugw("Backfill found logs", "from", from, "to", to, "logs", len(gethLogs), "blocks", blocks)
-		err = lp.orm.InsertLogsWithBlock(ctx, convertLogs(gethLogs, blocks, lp.lggr, lp.ec.ConfiguredChainID()), endblock)
+		logs := convertLogs(gethLogs, blocks, lp.lggr, lp.ec.ConfiguredChainID())
+		err = lp.orm.InsertLogsWithBlock(ctx, logs, endblock)
 		if err != nil {
 			lp.lggr.Warnw("Unable to insert logs, retrying", "err", err, "from", from, "to", to)
 			return err
 		}
+		lp.notifySubscriptions(from, to, logs)
 	}
 	return nil
 }
@@ -982,6 +1009,7 @@ func (lp *logPoller) getCurrentBlockMaybeHandleReorg(ctx context.Context, curren
 			// We return an error here which will cause us to restart polling from lastBlockSaved + 1
 			return nil, err2
 		}
+		lp.notifyReorg(blockAfterLCA.Number)
 		return blockAfterLCA, nil
 	}
 	// No reorg, return current block.
@@ -1022,6 +1050,7 @@ func (lp *logPoller) PollAndSaveLogs(ctx context.Context, currentBlockNumber int
 		return
 	}
 	currentBlockNumber = currentBlock.Number
+	latestSafeBlockNumber := lp.latestSafeBlockNumber(ctx, latestFinalizedBlockNumber)
 
 	// backfill finalized blocks if we can for performance. If we crash during backfill, we
 	// may reprocess logs.  Log insertion is idempotent so this is ok.
@@ -1065,16 +1094,15 @@ func (lp *logPoller) PollAndSaveLogs(ctx context.Context, currentBlockNumber int
 			BlockNumber:          currentBlockNumber,
 			BlockTimestamp:       currentBlock.Timestamp,
 			FinalizedBlockNumber: latestFinalizedBlockNumber,
+			SafeBlockNumber:      latestSafeBlockNumber,
 		}
-		err = lp.orm.InsertLogsWithBlock(
-			ctx,
-			convertLogs(logs, []LogPollerBlock{block}, lp.lggr, lp.ec.ConfiguredChainID()),
-			block,
-		)
+		lgs := convertLogs(logs, []LogPollerBlock{block}, lp.lggr, lp.ec.ConfiguredChainID())
+		err = lp.orm.InsertLogsWithBlock(ctx, lgs, block)
 		if err != nil {
 			lp.lggr.Warnw("Unable to save logs resuming from last saved block + 1", "err", err, "block", currentBlockNumber)
 			return
 		}
+		lp.notifySubscriptions(currentBlockNumber, currentBlockNumber, lgs)
 		// Update current block.
 		// Same reorg detection on unfinalized blocks.
 		currentBlockNumber++
@@ -1103,6 +1131,23 @@ func (lp *logPoller) latestBlocks(ctx context.Context) (*evmtypes.Head, int64, e
 	return latest, finalizedBN, nil
 }
 
+// latestSafeBlockNumber returns the number of the latest safe block provided by HeadTracker. Without finality tags, or
+// if the safe block can not be retrieved, it falls back to latestFinalizedBlockNumber, as a finalized block is safe.
+func (lp *logPoller) latestSafeBlockNumber(ctx context.Context, latestFinalizedBlockNumber int64) int64 {
+	if !lp.useFinalityTag {
+		return latestFinalizedBlockNumber
+	}
+	safe, err := lp.headTracker.LatestSafeBlock(ctx)
+	if err != nil {
+		lp.lggr.Warnw("Unable to get latest safe block, falling back to latest finalized block", "err", err, "finalized", latestFinalizedBlockNumber)
+		return latestFinalizedBlockNumber
+	}
+	if safe == nil {
+		return latestFinalizedBlockNumber
+	}
+	return max(safe.BlockNumber(), latestFinalizedBlockNumber)
+}
+
 // Find the first place where our chain and their chain have the same block,
 // that block number is the LCA. Return the block after that, where we want to resume polling.
 func (lp *logPoller) findBlockAfterLCA(ctx context.Context, current *evmtypes.Head, latestFinalizedBlockNumber int64) (*evmtypes.Head, error) {
@@ -1530,7 +1575,11 @@ func (lp *logPoller) IndexedLogsWithSigsExcluding(ctx context.Context, address c
 
 // DeleteLogsAndBlocksAfter - removes blocks and logs starting from the specified block
 func (lp *logPoller) DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error {
-	return lp.orm.DeleteLogsAndBlocksAfter(ctx, start)
+	if err := lp.orm.DeleteLogsAndBlocksAfter(ctx, start); err != nil {
+		return err
+	}
+	lp.notifyReorg(start)
+	return nil
 }
 
 func (lp *logPoller) FindLCA(ctx context.Context) (*LogPollerBlock, error) {
@@ -583,6 +583,39 @@ func Test_latestBlockAndFinalityDepth(t *testing.T) {
 	})
 }
 
+func Test_latestSafeBlockNumber(t *testing.T) {
+	lggr := logger.Test(t)
+
+	lpOpts := Opts{
+		PollPeriod:               time.Hour,
+		UseFinalityTag:           true,
+		BackfillBatchSize:        3,
+		RpcBatchSize:             3,
+		KeepFinalizedBlocksDepth: 20,
+	}
+
+	t.Run("returns finalized block without finality tag", func(t *testing.T) {
+		opts := lpOpts
+		opts.UseFinalityTag = false
+		lp := NewLogPoller(nil, nil, lggr, htMocks.NewHeadTracker[*evmtypes.Head, common.Hash](t), opts)
+		assert.Equal(t, int64(2), lp.latestSafeBlockNumber(tests.Context(t), 2))
+	})
+	t.Run("falls back to finalized block if headTracker returns an error", func(t *testing.T) {
+		headTracker := htMocks.NewHeadTracker[*evmtypes.Head, common.Hash](t)
+		headTracker.On("LatestSafeBlock", mock.Anything).Return(nil, errors.New("safe block is not available yet"))
+
+		lp := NewLogPoller(nil, nil, lggr, headTracker, lpOpts)
+		assert.Equal(t, int64(2), lp.latestSafeBlockNumber(tests.Context(t), 2))
+	})
+	t.Run("returns safe block ahead of finalized block", func(t *testing.T) {
+		headTracker := htMocks.NewHeadTracker[*evmtypes.Head, common.Hash](t)
+		headTracker.On("LatestSafeBlock", mock.Anything).Return(&evmtypes.Head{Number: 5}, nil)
+
+		lp := NewLogPoller(nil, nil, lggr, headTracker, lpOpts)
+		assert.Equal(t, int64(5), lp.latestSafeBlockNumber(tests.Context(t), 2))
+	})
+}
+
 func Test_FetchBlocks(t *testing.T) {
 	lggr := logger.Test(t)
 	chainID := testutils.FixtureChainID
@@ -1210,6 +1210,81 @@ func TestLogPoller_PollAndSaveLogsDeepReorg(t *testing.T) {
 	}
 }
 
+func TestLogPoller_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	lpOpts := logpoller.Opts{
+		FinalityDepth:            3,
+		BackfillBatchSize:        50,
+		RpcBatchSize:             50,
+		KeepFinalizedBlocksDepth: 1000,
+	}
+	th := SetupTH(t, lpOpts)
+	ctx := testutils.Context(t)
+	filter := logpoller.Filter{
+		Name:      "Test Emitter",
+		EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID},
+		Addresses: []common.Address{th.EmitterAddress1},
+	}
+	require.NoError(t, th.LogPoller.RegisterFilter(ctx, filter))
+
+	nextEvent := func(sub *logpoller.Subscription) logpoller.SubscriptionEvent {
+		select {
+		case ev := <-sub.Events():
+			return ev
+		case <-time.After(testutils.WaitTimeout(t)):
+			require.FailNow(t, "timed out waiting for subscription event")
+		}
+		return logpoller.SubscriptionEvent{}
+	}
+
+	// Chain gen <- 1 <- 2 (L1_1)
+	_, err := th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(1)})
+	require.NoError(t, err)
+	th.Backend.Commit()
+	newStart := th.PollAndSaveLogs(ctx, 1)
+	assert.Equal(t, int64(3), newStart)
+
+	sub, err := th.LogPoller.Subscribe(ctx, filter.Name, logpoller.LogCursor{BlockNumber: 1})
+	require.NoError(t, err)
+	defer sub.Close()
+
+	// Saved logs are read from the db
+	ev := nextEvent(sub)
+	require.Len(t, ev.Logs, 1)
+	assert.Equal(t, int64(2), ev.Logs[0].BlockNumber)
+	assert.Equal(t, hexutil.MustDecode(`0x0000000000000000000000000000000000000000000000000000000000000001`), ev.Logs[0].Data)
+	assert.Equal(t, logpoller.LogCursor{BlockNumber: 3}, ev.Cursor)
+
+	// Chain gen <- 1 <- 2 (L1_1) <- 3 (L1_2)
+	_, err = th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(2)})
+	require.NoError(t, err)
+	th.Backend.Commit()
+	newStart = th.PollAndSaveLogs(ctx, newStart)
+	assert.Equal(t, int64(4), newStart)
+
+	// New logs are pushed
+	ev = nextEvent(sub)
+	require.Len(t, ev.Logs, 1)
+	assert.Equal(t, int64(3), ev.Logs[0].BlockNumber)
+	assert.Equal(t, hexutil.MustDecode(`0x0000000000000000000000000000000000000000000000000000000000000002`), ev.Logs[0].Data)
+
+	// Chain gen <- 1 <- 2 (L1_1) <- 3 (L1_2)
+	//                            \ 3' <- 4'
+	lca, err := th.Client.BlockByNumber(ctx, big.NewInt(2))
+	require.NoError(t, err)
+	require.NoError(t, th.Backend.Fork(lca.Hash()))
+	th.Backend.Commit()
+	th.Backend.Commit()
+	th.PollAndSaveLogs(ctx, newStart)
+
+	// Logs removed by the reorg are notified
+	ev = nextEvent(sub)
+	assert.Empty(t, ev.Logs)
+	assert.Equal(t, int64(3), ev.RemovedFromBlock)
+	assert.Equal(t, logpoller.LogCursor{BlockNumber: 3}, ev.Cursor)
+}
+
 func TestLogPoller_LoadFilters(t *testing.T) {
 	t.Parallel()
 
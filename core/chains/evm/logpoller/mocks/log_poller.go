@@ -31,6 +31,112 @@ func (_m *LogPoller) EXPECT() *LogPoller_Expecter {
 	return &LogPoller_Expecter{mock: &_m.Mock}
 }
 
+// BackfillFilter provides a mock function with given fields: ctx, name, fromBlock
+func (_m *LogPoller) BackfillFilter(ctx context.Context, name string, fromBlock int64) error {
+	ret := _m.Called(ctx, name, fromBlock)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BackfillFilter")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) error); ok {
+		r0 = rf(ctx, name, fromBlock)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LogPoller_BackfillFilter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BackfillFilter'
+type LogPoller_BackfillFilter_Call struct {
+	*mock.Call
+}
+
+// BackfillFilter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - fromBlock int64
+func (_e *LogPoller_Expecter) BackfillFilter(ctx interface{}, name interface{}, fromBlock interface{}) *LogPoller_BackfillFilter_Call {
+	return &LogPoller_BackfillFilter_Call{Call: _e.mock.On("BackfillFilter", ctx, name, fromBlock)}
+}
+
+func (_c *LogPoller_BackfillFilter_Call) Run(run func(ctx context.Context, name string, fromBlock int64)) *LogPoller_BackfillFilter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *LogPoller_BackfillFilter_Call) Return(_a0 error) *LogPoller_BackfillFilter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LogPoller_BackfillFilter_Call) RunAndReturn(run func(context.Context, string, int64) error) *LogPoller_BackfillFilter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Backfills provides a mock function with given fields: ctx
+func (_m *LogPoller) Backfills(ctx context.Context) ([]logpoller.Backfill, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Backfills")
+	}
+
+	var r0 []logpoller.Backfill
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]logpoller.Backfill, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []logpoller.Backfill); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]logpoller.Backfill)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogPoller_Backfills_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Backfills'
+type LogPoller_Backfills_Call struct {
+	*mock.Call
+}
+
+// Backfills is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *LogPoller_Expecter) Backfills(ctx interface{}) *LogPoller_Backfills_Call {
+	return &LogPoller_Backfills_Call{Call: _e.mock.On("Backfills", ctx)}
+}
+
+func (_c *LogPoller_Backfills_Call) Run(run func(ctx context.Context)) *LogPoller_Backfills_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *LogPoller_Backfills_Call) Return(_a0 []logpoller.Backfill, _a1 error) *LogPoller_Backfills_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogPoller_Backfills_Call) RunAndReturn(run func(context.Context) ([]logpoller.Backfill, error)) *LogPoller_Backfills_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with given fields:
 func (_m *LogPoller) Close() error {
 	ret := _m.Called()
@@ -1809,6 +1915,66 @@ func (_c *LogPoller_Start_Call) RunAndReturn(run func(context.Context) error) *L
 	return _c
 }
 
+// Subscribe provides a mock function with given fields: ctx, filterName, from
+func (_m *LogPoller) Subscribe(ctx context.Context, filterName string, from logpoller.LogCursor) (*logpoller.Subscription, error) {
+	ret := _m.Called(ctx, filterName, from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 *logpoller.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, logpoller.LogCursor) (*logpoller.Subscription, error)); ok {
+		return rf(ctx, filterName, from)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, logpoller.LogCursor) *logpoller.Subscription); ok {
+		r0 = rf(ctx, filterName, from)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logpoller.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, logpoller.LogCursor) error); ok {
+		r1 = rf(ctx, filterName, from)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogPoller_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type LogPoller_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filterName string
+//   - from logpoller.LogCursor
+func (_e *LogPoller_Expecter) Subscribe(ctx interface{}, filterName interface{}, from interface{}) *LogPoller_Subscribe_Call {
+	return &LogPoller_Subscribe_Call{Call: _e.mock.On("Subscribe", ctx, filterName, from)}
+}
+
+func (_c *LogPoller_Subscribe_Call) Run(run func(ctx context.Context, filterName string, from logpoller.LogCursor)) *LogPoller_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(logpoller.LogCursor))
+	})
+	return _c
+}
+
+func (_c *LogPoller_Subscribe_Call) Return(_a0 *logpoller.Subscription, _a1 error) *LogPoller_Subscribe_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogPoller_Subscribe_Call) RunAndReturn(run func(context.Context, string, logpoller.LogCursor) (*logpoller.Subscription, error)) *LogPoller_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UnregisterFilter provides a mock function with given fields: ctx, name
 func (_m *LogPoller) UnregisterFilter(ctx context.Context, name string) error {
 	ret := _m.Called(ctx, name)
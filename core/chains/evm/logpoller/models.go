@@ -19,7 +19,36 @@ type LogPollerBlock struct {
 	BlockNumber          int64
 	BlockTimestamp       time.Time
 	FinalizedBlockNumber int64
-	CreatedAt            time.Time
+	// SafeBlockNumber is the latest safe block when the block was saved, it is never lower than FinalizedBlockNumber.
+	SafeBlockNumber int64
+	CreatedAt       time.Time
+}
+
+// Backfill is the progress of the parallel backfill of the logs of a filter,
+// see LogPoller.BackfillFilter.
+type Backfill struct {
+	EvmChainId *big.Big
+	FilterName string
+	FromBlock  int64
+	ToBlock    int64
+	// NextBlock is the first block of the range that is not backfilled yet.
+	NextBlock   int64
+	LastError   string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	CompletedAt *time.Time
+}
+
+// LogCursor is a position in the logs of a chain, ordered by block number and
+// log index. The zero log index of a block is the position of its first log.
+type LogCursor struct {
+	BlockNumber int64
+	LogIndex    int64
+}
+
+// Before reports whether c is an earlier position than other.
+func (c LogCursor) Before(other LogCursor) bool {
+	return c.BlockNumber < other.BlockNumber || (c.BlockNumber == other.BlockNumber && c.LogIndex < other.LogIndex)
 }
 
 // Log represents an EVM log.
@@ -56,3 +85,8 @@ func (l *Log) ToGethLog() types.Log {
 		Index:       uint(l.LogIndex),
 	}
 }
+
+// After returns the position right after the log.
+func (l *Log) After() LogCursor {
+	return LogCursor{BlockNumber: l.BlockNumber, LogIndex: l.LogIndex + 1}
+}
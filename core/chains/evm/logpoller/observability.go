@@ -124,6 +124,30 @@ func (o *ObservedORM) DeleteFilter(ctx context.Context, name string) error {
 	})
 }
 
+func (o *ObservedORM) UpsertBackfill(ctx context.Context, filterName string, fromBlock, toBlock int64) error {
+	return withObservedExec(o, "UpsertBackfill", create, func() error {
+		return o.ORM.UpsertBackfill(ctx, filterName, fromBlock, toBlock)
+	})
+}
+
+func (o *ObservedORM) UpdateBackfillProgress(ctx context.Context, filterName string, nextBlock int64, lastError string) error {
+	return withObservedExec(o, "UpdateBackfillProgress", create, func() error {
+		return o.ORM.UpdateBackfillProgress(ctx, filterName, nextBlock, lastError)
+	})
+}
+
+func (o *ObservedORM) CompleteBackfill(ctx context.Context, filterName string) error {
+	return withObservedExec(o, "CompleteBackfill", create, func() error {
+		return o.ORM.CompleteBackfill(ctx, filterName)
+	})
+}
+
+func (o *ObservedORM) SelectBackfills(ctx context.Context) ([]Backfill, error) {
+	return withObservedQueryAndResults(o, "SelectBackfills", func() ([]Backfill, error) {
+		return o.ORM.SelectBackfills(ctx)
+	})
+}
+
 func (o *ObservedORM) DeleteBlocksBefore(ctx context.Context, end int64, limit int64) (int64, error) {
 	return withObservedExecAndRowsAffected(o, "DeleteBlocksBefore", del, func() (int64, error) {
 		return o.ORM.DeleteBlocksBefore(ctx, end, limit)
@@ -190,6 +214,12 @@ func (o *ObservedORM) SelectLogsWithSigs(ctx context.Context, start, end int64,
 	})
 }
 
+func (o *ObservedORM) SelectLogsAfterCursor(ctx context.Context, cursor LogCursor, end int64, addresses []common.Address, eventSigs []common.Hash, limit int64) ([]Log, error) {
+	return withObservedQueryAndResults(o, "SelectLogsAfterCursor", func() ([]Log, error) {
+		return o.ORM.SelectLogsAfterCursor(ctx, cursor, end, addresses, eventSigs, limit)
+	})
+}
+
 func (o *ObservedORM) SelectLogsCreatedAfter(ctx context.Context, address common.Address, eventSig common.Hash, after time.Time, confs evmtypes.Confirmations) ([]Log, error) {
 	return withObservedQueryAndResults(o, "SelectLogsCreatedAfter", func() ([]Log, error) {
 		return o.ORM.SelectLogsCreatedAfter(ctx, address, eventSig, after, confs)
@@ -34,6 +34,11 @@ type ORM interface {
 	LoadFilters(ctx context.Context) (map[string]Filter, error)
 	DeleteFilter(ctx context.Context, name string) error
 
+	UpsertBackfill(ctx context.Context, filterName string, fromBlock, toBlock int64) error
+	UpdateBackfillProgress(ctx context.Context, filterName string, nextBlock int64, lastError string) error
+	CompleteBackfill(ctx context.Context, filterName string) error
+	SelectBackfills(ctx context.Context) ([]Backfill, error)
+
 	DeleteLogsByRowID(ctx context.Context, rowIDs []uint64) (int64, error)
 	InsertBlock(ctx context.Context, blockHash common.Hash, blockNumber int64, blockTimestamp time.Time, finalizedBlock int64) error
 	DeleteBlocksBefore(ctx context.Context, end int64, limit int64) (int64, error)
@@ -55,6 +60,7 @@ type ORM interface {
 	SelectLatestLogEventSigsAddrsWithConfs(ctx context.Context, fromBlock int64, addresses []common.Address, eventSigs []common.Hash, confs evmtypes.Confirmations) ([]Log, error)
 	SelectLatestBlockByEventSigsAddrsWithConfs(ctx context.Context, fromBlock int64, eventSigs []common.Hash, addresses []common.Address, confs evmtypes.Confirmations) (int64, error)
 	SelectLogsByBlockRange(ctx context.Context, start, end int64) ([]Log, error)
+	SelectLogsAfterCursor(ctx context.Context, cursor LogCursor, end int64, addresses []common.Address, eventSigs []common.Hash, limit int64) ([]Log, error)
 
 	SelectIndexedLogs(ctx context.Context, address common.Address, eventSig common.Hash, topicIndex int, topicValues []common.Hash, confs evmtypes.Confirmations) ([]Log, error)
 	SelectIndexedLogsByBlockRange(ctx context.Context, start, end int64, address common.Address, eventSig common.Hash, topicIndex int, topicValues []common.Hash) ([]Log, error)
@@ -97,18 +103,29 @@ func (o *DSORM) new(ds sqlutil.DataSource) *DSORM { return NewORM(o.chainID, ds,
 
 // InsertBlock is idempotent to support replays.
 func (o *DSORM) InsertBlock(ctx context.Context, blockHash common.Hash, blockNumber int64, blockTimestamp time.Time, finalizedBlock int64) error {
+	return o.insertBlock(ctx, LogPollerBlock{
+		BlockHash:            blockHash,
+		BlockNumber:          blockNumber,
+		BlockTimestamp:       blockTimestamp,
+		FinalizedBlockNumber: finalizedBlock,
+		SafeBlockNumber:      finalizedBlock,
+	})
+}
+
+func (o *DSORM) insertBlock(ctx context.Context, block LogPollerBlock) error {
 	args, err := newQueryArgs(o.chainID).
-		withField("block_hash", blockHash).
-		withField("block_number", blockNumber).
-		withField("block_timestamp", blockTimestamp).
-		withField("finalized_block_number", finalizedBlock).
+		withField("block_hash", block.BlockHash).
+		withField("block_number", block.BlockNumber).
+		withField("block_timestamp", block.BlockTimestamp).
+		withField("finalized_block_number", block.FinalizedBlockNumber).
+		withField("safe_block_number", max(block.SafeBlockNumber, block.FinalizedBlockNumber)).
 		toArgs()
 	if err != nil {
 		return err
 	}
 	query := `INSERT INTO evm.log_poller_blocks
-				(evm_chain_id, block_hash, block_number, block_timestamp, finalized_block_number, created_at)
-      		VALUES (:evm_chain_id, :block_hash, :block_number, :block_timestamp, :finalized_block_number, NOW())
+				(evm_chain_id, block_hash, block_number, block_timestamp, finalized_block_number, safe_block_number, created_at)
+      		VALUES (:evm_chain_id, :block_hash, :block_number, :block_timestamp, :finalized_block_number, :safe_block_number, NOW())
 			ON CONFLICT DO NOTHING`
 	_, err = o.ds.NamedExecContext(ctx, query, args)
 	return err
@@ -159,14 +176,67 @@ func (o *DSORM) InsertFilter(ctx context.Context, filter Filter) (err error) {
 	return err
 }
 
-// DeleteFilter removes all events,address pairs associated with the Filter
+// DeleteFilter removes all events,address pairs associated with the Filter, and the progress of its backfill
 func (o *DSORM) DeleteFilter(ctx context.Context, name string) error {
+	return o.Transact(ctx, func(orm *DSORM) error {
+		if _, err := orm.ds.ExecContext(ctx,
+			`DELETE FROM evm.log_poller_backfills WHERE filter_name = $1 AND evm_chain_id = $2`,
+			name, ubig.New(orm.chainID)); err != nil {
+			return pkgerrors.Wrap(err, "failed to delete backfill")
+		}
+		_, err := orm.ds.ExecContext(ctx,
+			`DELETE FROM evm.log_poller_filters WHERE name = $1 AND evm_chain_id = $2`,
+			name, ubig.New(orm.chainID))
+		return err
+	})
+}
+
+// UpsertBackfill starts the backfill of the logs of a filter in the block range [fromBlock, toBlock],
+// replacing any previous backfill of the filter.
+func (o *DSORM) UpsertBackfill(ctx context.Context, filterName string, fromBlock, toBlock int64) error {
+	_, err := o.ds.ExecContext(ctx,
+		`INSERT INTO evm.log_poller_backfills
+			(evm_chain_id, filter_name, from_block, to_block, next_block, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $3, NOW(), NOW())
+		ON CONFLICT (evm_chain_id, filter_name) DO UPDATE SET
+			from_block = EXCLUDED.from_block,
+			to_block = EXCLUDED.to_block,
+			next_block = EXCLUDED.next_block,
+			last_error = '',
+			updated_at = NOW(),
+			completed_at = NULL`,
+		ubig.New(o.chainID), filterName, fromBlock, toBlock)
+	return err
+}
+
+// UpdateBackfillProgress records that every block of the backfill of a filter before nextBlock has been backfilled,
+// and the last error it ran into, if any.
+func (o *DSORM) UpdateBackfillProgress(ctx context.Context, filterName string, nextBlock int64, lastError string) error {
+	_, err := o.ds.ExecContext(ctx,
+		`UPDATE evm.log_poller_backfills SET next_block = $3, last_error = $4, updated_at = NOW()
+		WHERE evm_chain_id = $1 AND filter_name = $2`,
+		ubig.New(o.chainID), filterName, nextBlock, lastError)
+	return err
+}
+
+// CompleteBackfill marks the backfill of a filter as completed.
+func (o *DSORM) CompleteBackfill(ctx context.Context, filterName string) error {
 	_, err := o.ds.ExecContext(ctx,
-		`DELETE FROM evm.log_poller_filters WHERE name = $1 AND evm_chain_id = $2`,
-		name, ubig.New(o.chainID))
+		`UPDATE evm.log_poller_backfills SET next_block = to_block + 1, last_error = '', updated_at = NOW(), completed_at = NOW()
+		WHERE evm_chain_id = $1 AND filter_name = $2`,
+		ubig.New(o.chainID), filterName)
 	return err
 }
 
+// SelectBackfills returns the backfills of all filters for this chain, including the completed ones.
+func (o *DSORM) SelectBackfills(ctx context.Context) ([]Backfill, error) {
+	var backfills []Backfill
+	err := o.ds.SelectContext(ctx, &backfills,
+		`SELECT * FROM evm.log_poller_backfills WHERE evm_chain_id = $1 ORDER BY filter_name`,
+		ubig.New(o.chainID))
+	return backfills, err
+}
+
 // LoadFilters returns all filters for this chain
 func (o *DSORM) LoadFilters(ctx context.Context) (map[string]Filter, error) {
 	query := `SELECT name,
@@ -214,9 +284,13 @@ func withConfs(query string, tableAlias string, confs evmtypes.Confirmations) st
 	if tableAlias != "" {
 		tablePrefix = tableAlias + "."
 	}
-	if confs == evmtypes.Finalized {
+	switch confs {
+	case evmtypes.Finalized:
 		lastConfirmedBlock = `finalized_block_number`
-	} else {
+	case evmtypes.Safe:
+		// blocks saved without a safe block number fall back to the finalized one
+		lastConfirmedBlock = `greatest(safe_block_number, finalized_block_number)`
+	default:
 		lastConfirmedBlock = `block_number - :confs`
 	}
 	return fmt.Sprintf(`%s %sblock_number <= (
@@ -535,7 +609,7 @@ func (o *DSORM) InsertLogs(ctx context.Context, logs []Log) error {
 func (o *DSORM) InsertLogsWithBlock(ctx context.Context, logs []Log, block LogPollerBlock) error {
 	// Optimization, don't open TX when there is only a block to be persisted
 	if len(logs) == 0 {
-		return o.InsertBlock(ctx, block.BlockHash, block.BlockNumber, block.BlockTimestamp, block.FinalizedBlockNumber)
+		return o.insertBlock(ctx, block)
 	}
 
 	if err := o.validateLogs(logs); err != nil {
@@ -544,7 +618,7 @@ func (o *DSORM) InsertLogsWithBlock(ctx context.Context, logs []Log, block LogPo
 
 	// Block and logs goes with the same TX to ensure atomicity
 	return o.Transact(ctx, func(orm *DSORM) error {
-		err := orm.InsertBlock(ctx, block.BlockHash, block.BlockNumber, block.BlockTimestamp, block.FinalizedBlockNumber)
+		err := orm.insertBlock(ctx, block)
 		if err != nil {
 			return err
 		}
@@ -709,6 +783,42 @@ func (o *DSORM) SelectLogsWithSigs(ctx context.Context, start, end int64, addres
 	return logs, err
 }
 
+// SelectLogsAfterCursor returns up to limit logs matching any of the addresses and event sigs, from the cursor up to
+// and including block end, ordered by block number and log index.
+func (o *DSORM) SelectLogsAfterCursor(ctx context.Context, cursor LogCursor, end int64, addresses []common.Address, eventSigs []common.Hash, limit int64) (logs []Log, err error) {
+	args, err := newQueryArgs(o.chainID).
+		withAddressArray(addresses).
+		withEventSigArray(eventSigs).
+		withStartBlock(cursor.BlockNumber).
+		withField("start_log_index", cursor.LogIndex).
+		withEndBlock(end).
+		withField("limit", limit).
+		toArgs()
+	if err != nil {
+		return nil, err
+	}
+
+	query := logsQuery(`
+		WHERE evm_chain_id = :evm_chain_id
+		AND address = ANY(:address_array)
+		AND event_sig = ANY(:event_sig_array)
+		AND (block_number, log_index) >= (:start_block, :start_log_index)
+		AND block_number <= :end_block
+		ORDER BY block_number, log_index
+		LIMIT :limit`)
+
+	query, sqlArgs, err := o.ds.BindNamed(query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = o.ds.SelectContext(ctx, &logs, query, sqlArgs...)
+	if pkgerrors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return logs, err
+}
+
 func (o *DSORM) GetBlocksRange(ctx context.Context, start int64, end int64) ([]LogPollerBlock, error) {
 	args, err := newQueryArgs(o.chainID).
 		withStartBlock(start).
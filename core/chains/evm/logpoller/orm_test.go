@@ -1403,6 +1403,63 @@ func TestORM_DeleteBlocksBefore(t *testing.T) {
 	require.Equal(t, err, sql.ErrNoRows)
 }
 
+func TestORM_Backfills(t *testing.T) {
+	th := SetupTH(t, lpOpts)
+	o1, o2 := th.ORM, th.ORM2
+	ctx := testutils.Context(t)
+
+	filter := logpoller.Filter{
+		Name:      "backfilled",
+		EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID},
+		Addresses: []common.Address{th.EmitterAddress1},
+	}
+	require.NoError(t, o1.InsertFilter(ctx, filter))
+	require.NoError(t, o1.UpsertBackfill(ctx, filter.Name, 10, 100))
+
+	backfills, err := o1.SelectBackfills(ctx)
+	require.NoError(t, err)
+	require.Len(t, backfills, 1)
+	assert.Equal(t, ubig.New(th.ChainID), backfills[0].EvmChainId)
+	assert.Equal(t, filter.Name, backfills[0].FilterName)
+	assert.Equal(t, int64(10), backfills[0].FromBlock)
+	assert.Equal(t, int64(100), backfills[0].ToBlock)
+	assert.Equal(t, int64(10), backfills[0].NextBlock)
+	assert.Nil(t, backfills[0].CompletedAt)
+
+	backfills, err = o2.SelectBackfills(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, backfills, "backfills are scoped by chain")
+
+	require.NoError(t, o1.UpdateBackfillProgress(ctx, filter.Name, 50, "boom"))
+	backfills, err = o1.SelectBackfills(ctx)
+	require.NoError(t, err)
+	require.Len(t, backfills, 1)
+	assert.Equal(t, int64(50), backfills[0].NextBlock)
+	assert.Equal(t, "boom", backfills[0].LastError)
+
+	require.NoError(t, o1.CompleteBackfill(ctx, filter.Name))
+	backfills, err = o1.SelectBackfills(ctx)
+	require.NoError(t, err)
+	require.Len(t, backfills, 1)
+	assert.Equal(t, int64(101), backfills[0].NextBlock)
+	assert.Empty(t, backfills[0].LastError)
+	assert.NotNil(t, backfills[0].CompletedAt)
+
+	// Upserting restarts the backfill
+	require.NoError(t, o1.UpsertBackfill(ctx, filter.Name, 5, 200))
+	backfills, err = o1.SelectBackfills(ctx)
+	require.NoError(t, err)
+	require.Len(t, backfills, 1)
+	assert.Equal(t, int64(5), backfills[0].NextBlock)
+	assert.Equal(t, int64(200), backfills[0].ToBlock)
+	assert.Nil(t, backfills[0].CompletedAt)
+
+	require.NoError(t, o1.DeleteFilter(ctx, filter.Name))
+	backfills, err = o1.SelectBackfills(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, backfills, "deleting a filter deletes its backfill")
+}
+
 func TestLogPoller_Logs(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -30,7 +30,7 @@ var (
 	logsFields                = [...]string{"evm_chain_id", "log_index", "block_hash", "block_number",
 		"address", "event_sig", "topics", "tx_hash", "data", "created_at", "block_timestamp"}
 	blocksFields = [...]string{"evm_chain_id", "block_hash", "block_number", "block_timestamp",
-		"finalized_block_number", "created_at"}
+		"finalized_block_number", "safe_block_number", "created_at"}
 )
 
 // The parser builds SQL expressions piece by piece for each Accept function call and resets the error and expression
@@ -67,9 +67,9 @@ func (v *pgDSLParser) Confidence(p primitives.Confidence) {
 	switch p.ConfidenceLevel {
 	case primitives.Finalized:
 		// the highest level of confidence maps to finalized
-		v.expression = v.nestedConfQuery(true, 0)
+		v.expression = v.nestedConfQuery(evmtypes.Finalized)
 	case primitives.Unconfirmed:
-		v.expression = v.nestedConfQuery(false, 0)
+		v.expression = v.nestedConfQuery(evmtypes.Unconfirmed)
 	default:
 		v.err = errors.New("unrecognized confidence level; use confidence to confirmations mappings instead")
 
@@ -129,7 +129,7 @@ func (v *pgDSLParser) VisitEventSigFilter(p *eventSigFilter) {
 	)
 }
 
-func (v *pgDSLParser) nestedConfQuery(finalized bool, confs uint64) string {
+func (v *pgDSLParser) nestedConfQuery(confs evmtypes.Confirmations) string {
 	var (
 		from     = "FROM evm.log_poller_blocks "
 		where    = "WHERE evm_chain_id = :evm_chain_id "
@@ -137,11 +137,15 @@ func (v *pgDSLParser) nestedConfQuery(finalized bool, confs uint64) string {
 		selector string
 	)
 
-	if finalized {
+	switch confs {
+	case evmtypes.Finalized:
 		selector = "SELECT finalized_block_number "
-	} else {
+	case evmtypes.Safe:
+		// blocks saved without a safe block number fall back to the finalized one
+		selector = "SELECT greatest(safe_block_number, finalized_block_number) "
+	default:
 		selector = fmt.Sprintf("SELECT greatest(block_number - :%s, 0) ",
-			v.args.withIndexedField("confs", confs),
+			v.args.withIndexedField("confs", uint64(confs)),
 		)
 	}
 
@@ -198,9 +202,9 @@ func (v *pgDSLParser) VisitConfirmationsFilter(p *confirmationsFilter) {
 	switch p.Confirmations {
 	case evmtypes.Finalized:
 		// the highest level of confidence maps to finalized
-		v.expression = v.nestedConfQuery(true, 0)
+		v.expression = v.nestedConfQuery(evmtypes.Finalized)
 	default:
-		v.expression = v.nestedConfQuery(false, uint64(p.Confirmations))
+		v.expression = v.nestedConfQuery(p.Confirmations)
 	}
 }
 
@@ -0,0 +1,355 @@
+package logpoller
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"sync"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+)
+
+const (
+	// subscriptionBufferSize is the number of events buffered for the consumer of a Subscription.
+	subscriptionBufferSize = 16
+	// subscriptionPageSize is the maximum number of logs sent in a single event, and read from the db at a time when
+	// a Subscription catches up.
+	subscriptionPageSize = 1000
+	// subscriptionRetryInterval is how long a Subscription waits before catching up again after failing to read logs.
+	subscriptionRetryInterval = 5 * time.Second
+)
+
+var errSubscriptionFilterUnregistered = pkgerrors.New("filter of the subscription was unregistered")
+
+// SubscriptionEvent is sent by a Subscription, either with new logs or as a notice that a reorg removed logs it sent.
+type SubscriptionEvent struct {
+	// Logs are ordered by block number and log index.
+	Logs []Log
+	// RemovedFromBlock is only set on reorg notices. The logs that were sent from this block on are no longer
+	// canonical, and the logs of the new canonical blocks are sent next.
+	RemovedFromBlock int64
+	// Cursor is the position of the Subscription after the event. Consumers can persist it once they handled the
+	// event, and pass it to Subscribe to resume from there.
+	Cursor LogCursor
+}
+
+// Subscription streams the logs matching a filter as LogPoller saves them, see LogPoller.Subscribe.
+// Delivery is at least once: logs of blocks that are replayed or backfilled again are sent again.
+type Subscription struct {
+	lp         *logPoller
+	filterName string
+	from       LogCursor
+	events     chan SubscriptionEvent
+	wake       chan struct{}
+
+	mu          sync.Mutex
+	cursor      LogCursor // position of the next log to send
+	synced      int64     // the logs up to this block were sent or are pending
+	head        int64     // latest block saved by LogPoller
+	pending     []Log     // logs pushed by LogPoller, not sent yet
+	lastSent    int64     // block of the last log sent
+	removedFrom int64     // block of the pending reorg notice, 0 if none
+	gen         uint64    // incremented whenever the subscription rewinds, invalidating db reads in flight
+
+	stopCh   services.StopChan
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// Subscribe streams the logs of the addresses and event sigs of a registered filter, from the cursor on, followed by
+// the logs LogPoller saves from then on, and notices of reorgs removing logs that were sent. Use
+// LogCursor{BlockNumber: fromBlock} to start from a block. The events channel of the Subscription is closed once it
+// is closed, the filter is unregistered or LogPoller is closed.
+func (lp *logPoller) Subscribe(ctx context.Context, filterName string, from LogCursor) (*Subscription, error) {
+	if !lp.HasFilter(filterName) {
+		return nil, pkgerrors.Errorf("filter %q is not registered", filterName)
+	}
+	if from.BlockNumber < 0 || from.LogIndex < 0 {
+		return nil, pkgerrors.Errorf("invalid subscription cursor %+v", from)
+	}
+
+	head := from.BlockNumber - 1
+	latest, err := lp.orm.SelectLatestBlock(ctx)
+	if err == nil {
+		head = max(head, latest.BlockNumber)
+	} else if !pkgerrors.Is(err, sql.ErrNoRows) {
+		return nil, pkgerrors.Wrap(err, "error reading latest block")
+	}
+
+	s := &Subscription{
+		lp:         lp,
+		filterName: filterName,
+		from:       from,
+		events:     make(chan SubscriptionEvent, subscriptionBufferSize),
+		wake:       make(chan struct{}, 1),
+		cursor:     from,
+		synced:     from.BlockNumber - 1,
+		head:       head,
+		lastSent:   from.BlockNumber - 1,
+		stopCh:     make(chan struct{}),
+	}
+
+	lp.subsMu.Lock()
+	lp.subs[s] = struct{}{}
+	lp.subsMu.Unlock()
+
+	s.wg.Add(1)
+	go s.run()
+	s.signal()
+	return s, nil
+}
+
+// Events returns the channel the events of the subscription are sent on.
+func (s *Subscription) Events() <-chan SubscriptionEvent {
+	return s.events
+}
+
+// Close stops the subscription and closes its events channel.
+func (s *Subscription) Close() {
+	s.stop()
+	s.lp.subsMu.Lock()
+	delete(s.lp.subs, s)
+	s.lp.subsMu.Unlock()
+	s.wg.Wait()
+}
+
+func (s *Subscription) stop() {
+	s.stopOnce.Do(func() { close(s.stopCh) })
+}
+
+func (s *Subscription) signal() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Subscription) run() {
+	defer s.wg.Done()
+	defer close(s.events)
+	ctx, cancel := s.stopCh.NewCtx()
+	defer cancel()
+
+	var retry <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.wake:
+		case <-retry:
+		}
+		retry = nil
+
+		for {
+			ev, ok, err := s.next(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				if pkgerrors.Is(err, errSubscriptionFilterUnregistered) {
+					s.lp.lggr.Infow("Closing subscription", "filter", s.filterName, "err", err)
+					return
+				}
+				s.lp.lggr.Warnw("Unable to read logs for subscription, retrying", "filter", s.filterName, "err", err)
+				retry = time.After(subscriptionRetryInterval)
+				break
+			}
+			if !ok {
+				break
+			}
+			select {
+			case s.events <- ev:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// next returns the next event to send, or false once the subscription is up to date. Logs pushed by LogPoller are
+// sent as they are, the subscription only reads logs from the db when it fell behind.
+func (s *Subscription) next(ctx context.Context) (SubscriptionEvent, bool, error) {
+	for {
+		s.mu.Lock()
+		if s.removedFrom != 0 {
+			ev := SubscriptionEvent{RemovedFromBlock: s.removedFrom, Cursor: s.cursor}
+			s.removedFrom = 0
+			s.mu.Unlock()
+			return ev, true, nil
+		}
+		if len(s.pending) > 0 {
+			n := min(len(s.pending), subscriptionPageSize)
+			logs := s.pending[:n:n]
+			s.pending = s.pending[n:]
+			s.sentLocked(logs)
+			ev := SubscriptionEvent{Logs: logs, Cursor: s.cursor}
+			s.mu.Unlock()
+			return ev, true, nil
+		}
+		if s.synced >= s.head {
+			s.advanceLocked(LogCursor{BlockNumber: s.synced + 1})
+			s.mu.Unlock()
+			return SubscriptionEvent{}, false, nil
+		}
+		cursor, end, gen := s.cursor, s.head, s.gen
+		s.mu.Unlock()
+
+		filter, ok := s.lp.subscriptionFilter(s.filterName)
+		if !ok {
+			return SubscriptionEvent{}, false, errSubscriptionFilterUnregistered
+		}
+		logs, err := s.lp.orm.SelectLogsAfterCursor(ctx, cursor, end, filter.Addresses, filter.EventSigs, subscriptionPageSize)
+		if err != nil {
+			return SubscriptionEvent{}, false, err
+		}
+
+		s.mu.Lock()
+		if s.gen != gen {
+			// Rewound by a reorg or a replay while reading
+			s.mu.Unlock()
+			continue
+		}
+		if len(logs) < subscriptionPageSize {
+			s.synced = end
+		}
+		if len(logs) == 0 {
+			s.mu.Unlock()
+			continue
+		}
+		s.sentLocked(logs)
+		ev := SubscriptionEvent{Logs: logs, Cursor: s.cursor}
+		s.mu.Unlock()
+		return ev, true, nil
+	}
+}
+
+// sentLocked moves the cursor after logs, and after the synced blocks once no logs are pending.
+func (s *Subscription) sentLocked(logs []Log) {
+	last := logs[len(logs)-1]
+	s.lastSent = last.BlockNumber
+	s.advanceLocked(last.After())
+	if len(s.pending) == 0 {
+		s.advanceLocked(LogCursor{BlockNumber: s.synced + 1})
+	}
+}
+
+func (s *Subscription) advanceLocked(c LogCursor) {
+	if s.cursor.Before(c) {
+		s.cursor = c
+	}
+}
+
+// rewindLocked makes the subscription send the logs from block on again, but never the logs before it started from.
+func (s *Subscription) rewindLocked(block int64) {
+	c := LogCursor{BlockNumber: block}
+	if c.Before(s.from) {
+		c = s.from
+	}
+	if c.Before(s.cursor) {
+		s.cursor = c
+	}
+	s.synced = min(s.synced, c.BlockNumber-1)
+	s.pending = slices.DeleteFunc(s.pending, func(l Log) bool { return l.BlockNumber >= block })
+	s.gen++
+}
+
+// push hands the subscription the logs matching its filter that LogPoller saved for the blocks from start to end.
+func (s *Subscription) push(start, end int64, logs []Log) {
+	s.mu.Lock()
+	switch {
+	case start <= s.synced:
+		// Blocks that were synced already were saved again by a replay or a backfill
+		if len(logs) > 0 {
+			s.rewindLocked(logs[0].BlockNumber)
+		}
+	case start == s.synced+1 && s.synced >= s.head:
+		for _, l := range logs {
+			if !(LogCursor{BlockNumber: l.BlockNumber, LogIndex: l.LogIndex}).Before(s.cursor) {
+				s.pending = append(s.pending, l)
+			}
+		}
+		s.synced = end
+	}
+	// Otherwise the subscription fell behind, and catches up from the db
+	s.head = max(s.head, end)
+	s.mu.Unlock()
+	s.signal()
+}
+
+// removed notifies the subscription that a reorg removed the logs from block on.
+func (s *Subscription) removed(block int64) {
+	s.mu.Lock()
+	if s.lastSent >= block {
+		if s.removedFrom == 0 || block < s.removedFrom {
+			s.removedFrom = block
+		}
+		s.lastSent = block - 1
+	}
+	s.rewindLocked(block)
+	s.head = min(s.head, block-1)
+	s.mu.Unlock()
+	s.signal()
+}
+
+// notifySubscriptions pushes the logs saved for the blocks from start to end to the subscriptions of their filters.
+func (lp *logPoller) notifySubscriptions(start, end int64, logs []Log) {
+	lp.filterMu.RLock()
+	defer lp.filterMu.RUnlock()
+	lp.subsMu.RLock()
+	defer lp.subsMu.RUnlock()
+
+	for s := range lp.subs {
+		filter, ok := lp.filters[s.filterName]
+		if !ok {
+			continue
+		}
+		var matching []Log
+		for _, l := range logs {
+			if (LogCursor{BlockNumber: l.BlockNumber, LogIndex: l.LogIndex}).Before(s.from) {
+				continue
+			}
+			if slices.Contains(filter.Addresses, l.Address) && slices.Contains(filter.EventSigs, l.EventSig) {
+				matching = append(matching, l)
+			}
+		}
+		s.push(start, end, matching)
+	}
+}
+
+// notifyReorg notifies the subscriptions that a reorg removed the logs from block on.
+func (lp *logPoller) notifyReorg(block int64) {
+	lp.subsMu.RLock()
+	defer lp.subsMu.RUnlock()
+
+	for s := range lp.subs {
+		s.removed(block)
+	}
+}
+
+func (lp *logPoller) subscriptionFilter(name string) (Filter, bool) {
+	lp.filterMu.RLock()
+	defer lp.filterMu.RUnlock()
+
+	filter, ok := lp.filters[name]
+	return filter, ok
+}
+
+// stopSubscriptions stops the subscriptions of a filter, or all of them if name is empty. It must not wait for them,
+// since it may be called with filterMu held.
+func (lp *logPoller) stopSubscriptions(name string) []*Subscription {
+	lp.subsMu.Lock()
+	defer lp.subsMu.Unlock()
+
+	var stopped []*Subscription
+	for s := range lp.subs {
+		if name == "" || s.filterName == name {
+			s.stop()
+			delete(lp.subs, s)
+			stopped = append(stopped, s)
+		}
+	}
+	return stopped
+}
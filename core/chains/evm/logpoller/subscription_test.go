@@ -0,0 +1,183 @@
+package logpoller
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+// subscriptionORM keeps the logs and latest block in memory, for the queries made by subscriptions.
+type subscriptionORM struct {
+	ORM
+	mu     sync.Mutex
+	logs   []Log
+	latest int64
+}
+
+func (o *subscriptionORM) SelectLatestBlock(ctx context.Context) (*LogPollerBlock, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.latest == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &LogPollerBlock{BlockNumber: o.latest}, nil
+}
+
+func (o *subscriptionORM) SelectLogsAfterCursor(ctx context.Context, cursor LogCursor, end int64, addresses []common.Address, eventSigs []common.Hash, limit int64) ([]Log, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var logs []Log
+	for _, l := range o.logs {
+		if (LogCursor{BlockNumber: l.BlockNumber, LogIndex: l.LogIndex}).Before(cursor) || l.BlockNumber > end {
+			continue
+		}
+		if slices.Contains(addresses, l.Address) && slices.Contains(eventSigs, l.EventSig) && int64(len(logs)) < limit {
+			logs = append(logs, l)
+		}
+	}
+	return logs, nil
+}
+
+// save stores logs and pushes them to the subscriptions, like LogPoller does once it saved them.
+func (o *subscriptionORM) save(lp *logPoller, start, end int64, logs ...Log) {
+	o.mu.Lock()
+	o.logs = slices.DeleteFunc(o.logs, func(l Log) bool { return l.BlockNumber >= start && l.BlockNumber <= end })
+	o.logs = append(o.logs, logs...)
+	slices.SortFunc(o.logs, func(a, b Log) int {
+		if a.BlockNumber != b.BlockNumber {
+			return int(a.BlockNumber - b.BlockNumber)
+		}
+		return int(a.LogIndex - b.LogIndex)
+	})
+	o.latest = max(o.latest, end)
+	o.mu.Unlock()
+	lp.notifySubscriptions(start, end, logs)
+}
+
+// reorg removes the logs from block on, like LogPoller does when it finds a reorg.
+func (o *subscriptionORM) reorg(lp *logPoller, block int64) {
+	o.mu.Lock()
+	o.logs = slices.DeleteFunc(o.logs, func(l Log) bool { return l.BlockNumber >= block })
+	o.latest = block - 1
+	o.mu.Unlock()
+	lp.notifyReorg(block)
+}
+
+func nextEvent(t *testing.T, sub *Subscription) SubscriptionEvent {
+	select {
+	case ev, ok := <-sub.Events():
+		require.True(t, ok, "subscription closed")
+		return ev
+	case <-time.After(tests.WaitTimeout(t)):
+		require.FailNow(t, "timed out waiting for subscription event")
+	}
+	return SubscriptionEvent{}
+}
+
+func requireNoEvent(t *testing.T, sub *Subscription) {
+	select {
+	case ev := <-sub.Events():
+		require.FailNow(t, "unexpected subscription event", "%+v", ev)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestLogPoller_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	orm := &subscriptionORM{}
+	lp := NewLogPoller(orm, nil, logger.Test(t), nil, Opts{PollPeriod: time.Hour})
+
+	addr, other := testutils.NewAddress(), testutils.NewAddress()
+	sig := EmitterABI.Events["Log1"].ID
+	lp.filters["filter"] = Filter{Name: "filter", Addresses: []common.Address{addr}, EventSigs: []common.Hash{sig}}
+	newLog := func(block, index int64, address common.Address) Log {
+		return Log{BlockNumber: block, LogIndex: index, Address: address, EventSig: sig, Data: []byte{byte(block), byte(index)}}
+	}
+
+	orm.save(lp, 1, 3, newLog(1, 0, addr), newLog(2, 0, addr), newLog(2, 1, other), newLog(3, 0, addr))
+
+	_, err := lp.Subscribe(ctx, "unknown", LogCursor{BlockNumber: 1})
+	require.ErrorContains(t, err, `filter "unknown" is not registered`)
+
+	sub, err := lp.Subscribe(ctx, "filter", LogCursor{BlockNumber: 2})
+	require.NoError(t, err)
+	t.Cleanup(sub.Close)
+
+	t.Run("catches up from the db", func(t *testing.T) {
+		ev := nextEvent(t, sub)
+		assert.Equal(t, []Log{newLog(2, 0, addr), newLog(3, 0, addr)}, ev.Logs)
+		assert.Equal(t, LogCursor{BlockNumber: 4}, ev.Cursor)
+	})
+
+	t.Run("streams the logs saved by LogPoller", func(t *testing.T) {
+		orm.save(lp, 4, 4, newLog(4, 0, other), newLog(4, 1, addr))
+		ev := nextEvent(t, sub)
+		assert.Equal(t, []Log{newLog(4, 1, addr)}, ev.Logs)
+		assert.Equal(t, LogCursor{BlockNumber: 5}, ev.Cursor)
+
+		orm.save(lp, 5, 5)
+		requireNoEvent(t, sub)
+	})
+
+	t.Run("notifies reorgs removing logs that were sent", func(t *testing.T) {
+		orm.reorg(lp, 5)
+		requireNoEvent(t, sub)
+
+		orm.reorg(lp, 4)
+		ev := nextEvent(t, sub)
+		assert.Empty(t, ev.Logs)
+		assert.Equal(t, int64(4), ev.RemovedFromBlock)
+		assert.Equal(t, LogCursor{BlockNumber: 4}, ev.Cursor)
+
+		orm.save(lp, 4, 4, newLog(4, 2, addr))
+		ev = nextEvent(t, sub)
+		assert.Equal(t, []Log{newLog(4, 2, addr)}, ev.Logs)
+	})
+
+	t.Run("sends replayed logs again", func(t *testing.T) {
+		orm.save(lp, 3, 4, newLog(3, 0, addr), newLog(4, 2, addr))
+		ev := nextEvent(t, sub)
+		assert.Equal(t, []Log{newLog(3, 0, addr), newLog(4, 2, addr)}, ev.Logs)
+		assert.Equal(t, LogCursor{BlockNumber: 5}, ev.Cursor)
+	})
+
+	t.Run("resumes from a cursor", func(t *testing.T) {
+		resumed, err := lp.Subscribe(ctx, "filter", LogCursor{BlockNumber: 2, LogIndex: 1})
+		require.NoError(t, err)
+		defer resumed.Close()
+
+		ev := nextEvent(t, resumed)
+		assert.Equal(t, []Log{newLog(3, 0, addr), newLog(4, 2, addr)}, ev.Logs)
+
+		orm.reorg(lp, 1)
+		ev = nextEvent(t, resumed)
+		assert.Equal(t, int64(1), ev.RemovedFromBlock)
+		assert.Equal(t, LogCursor{BlockNumber: 2, LogIndex: 1}, ev.Cursor, "never rewinds before the cursor it started from")
+	})
+
+	t.Run("closes when the filter is unregistered", func(t *testing.T) {
+		lp.stopSubscriptions("filter")
+		select {
+		case _, ok := <-sub.Events():
+			for ok {
+				_, ok = <-sub.Events()
+			}
+		case <-time.After(tests.WaitTimeout(t)):
+			require.FailNow(t, "timed out waiting for subscription to close")
+		}
+	})
+}
@@ -166,6 +166,52 @@ func (_c *BalanceMonitor_HealthReport_Call) RunAndReturn(run func() map[string]e
 	return _c
 }
 
+// IsLowBalance provides a mock function with given fields: _a0
+func (_m *BalanceMonitor) IsLowBalance(_a0 common.Address) bool {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsLowBalance")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(common.Address) bool); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// BalanceMonitor_IsLowBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsLowBalance'
+type BalanceMonitor_IsLowBalance_Call struct {
+	*mock.Call
+}
+
+// IsLowBalance is a helper method to define mock.On call
+//   - _a0 common.Address
+func (_e *BalanceMonitor_Expecter) IsLowBalance(_a0 interface{}) *BalanceMonitor_IsLowBalance_Call {
+	return &BalanceMonitor_IsLowBalance_Call{Call: _e.mock.On("IsLowBalance", _a0)}
+}
+
+func (_c *BalanceMonitor_IsLowBalance_Call) Run(run func(_a0 common.Address)) *BalanceMonitor_IsLowBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(common.Address))
+	})
+	return _c
+}
+
+func (_c *BalanceMonitor_IsLowBalance_Call) Return(_a0 bool) *BalanceMonitor_IsLowBalance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BalanceMonitor_IsLowBalance_Call) RunAndReturn(run func(common.Address) bool) *BalanceMonitor_IsLowBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Name provides a mock function with given fields:
 func (_m *BalanceMonitor) Name() string {
 	ret := _m.Called()
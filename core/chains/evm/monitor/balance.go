@@ -29,6 +29,9 @@ type (
 	BalanceMonitor interface {
 		httypes.HeadTrackable
 		GetEthBalance(gethCommon.Address) *assets.Eth
+		// IsLowBalance returns true if the last balance of the address is below the low balance threshold, or zero if
+		// there is no threshold. Addresses whose balance was never fetched are not flagged.
+		IsLowBalance(gethCommon.Address) bool
 		services.Service
 	}
 
@@ -36,13 +39,14 @@ type (
 		services.Service
 		eng *services.Engine
 
-		ethClient      evmclient.Client
-		chainID        *big.Int
-		chainIDStr     string
-		ethKeyStore    keystore.Eth
-		ethBalances    map[gethCommon.Address]*assets.Eth
-		ethBalancesMtx sync.RWMutex
-		sleeperTask    *utils.SleeperTask
+		ethClient           evmclient.Client
+		chainID             *big.Int
+		chainIDStr          string
+		ethKeyStore         keystore.Eth
+		lowBalanceThreshold *assets.Wei
+		ethBalances         map[gethCommon.Address]*assets.Eth
+		ethBalancesMtx      sync.RWMutex
+		sleeperTask         *utils.SleeperTask
 	}
 
 	NullBalanceMonitor struct{}
@@ -51,14 +55,15 @@ type (
 var _ BalanceMonitor = (*balanceMonitor)(nil)
 
 // NewBalanceMonitor returns a new balanceMonitor
-func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, lggr logger.Logger) *balanceMonitor {
+func NewBalanceMonitor(ethClient evmclient.Client, ethKeyStore keystore.Eth, lowBalanceThreshold *assets.Wei, lggr logger.Logger) *balanceMonitor {
 	chainId := ethClient.ConfiguredChainID()
 	bm := &balanceMonitor{
-		ethClient:   ethClient,
-		chainID:     chainId,
-		chainIDStr:  chainId.String(),
-		ethKeyStore: ethKeyStore,
-		ethBalances: make(map[gethCommon.Address]*assets.Eth),
+		ethClient:           ethClient,
+		chainID:             chainId,
+		chainIDStr:          chainId.String(),
+		ethKeyStore:         ethKeyStore,
+		lowBalanceThreshold: lowBalanceThreshold,
+		ethBalances:         make(map[gethCommon.Address]*assets.Eth),
 	}
 	bm.Service, bm.eng = services.Config{
 		Name:  "BalanceMonitor",
@@ -119,6 +124,17 @@ func (bm *balanceMonitor) GetEthBalance(address gethCommon.Address) *assets.Eth
 	return bm.ethBalances[address]
 }
 
+func (bm *balanceMonitor) IsLowBalance(address gethCommon.Address) bool {
+	bal := bm.GetEthBalance(address)
+	if bal == nil {
+		return false
+	}
+	if bm.lowBalanceThreshold == nil {
+		return bal.ToInt().Sign() == 0
+	}
+	return bal.ToInt().Cmp(bm.lowBalanceThreshold.ToInt()) < 0
+}
+
 var promETHBalance = promauto.NewGaugeVec(
 	prometheus.GaugeOpts{
 		Name: "eth_balance",
@@ -168,7 +184,7 @@ func (w *worker) Work(ctx context.Context) {
 const ethFetchTimeout = 15 * time.Second
 
 func (w *worker) checkAccountBalance(ctx context.Context, address gethCommon.Address) {
-	ctx, cancel := context.WithTimeout(ctx, ethFetchTimeout)
+	ctx, cancel := context.WithTimeout(evmclient.CtxWithRPCPriority(ctx, evmclient.RPCPriorityLow), ethFetchTimeout)
 	defer cancel()
 
 	bal, err := w.bm.ethClient.BalanceAt(ctx, address, nil)
@@ -192,6 +208,10 @@ func (*NullBalanceMonitor) GetEthBalance(gethCommon.Address) *assets.Eth {
 	return nil
 }
 
+func (*NullBalanceMonitor) IsLowBalance(gethCommon.Address) bool {
+	return false
+}
+
 // Start does noop for NullBalanceMonitor.
 func (*NullBalanceMonitor) Start(context.Context) error                                { return nil }
 func (*NullBalanceMonitor) Close() error                                               { return nil }
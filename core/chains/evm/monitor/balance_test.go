@@ -44,7 +44,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 			Return([]common.Address{k0Addr, k1Addr}, nil)
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, nil, logger.Test(t))
 
 		k0bal := big.NewInt(42)
 		k1bal := big.NewInt(43)
@@ -71,7 +71,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 			Return([]common.Address{k0Addr}, nil)
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, nil, logger.Test(t))
 		k0bal := big.NewInt(42)
 
 		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(k0bal, nil)
@@ -90,7 +90,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 			Return([]common.Address{k0Addr}, nil)
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, nil, logger.Test(t))
 		ctxCancelledAwaiter := testutils.NewAwaiter()
 
 		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Run(func(args mock.Arguments) {
@@ -119,7 +119,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 			Return([]common.Address{k0Addr}, nil)
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, nil, logger.Test(t))
 
 		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).
 			Once().
@@ -144,7 +144,7 @@ func TestBalanceMonitor_OnNewLongestChain_UpdatesBalance(t *testing.T) {
 			Return([]common.Address{k0Addr, k1Addr}, nil)
 		ethClient := newEthClientMock(t)
 
-		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, nil, logger.Test(t))
 		k0bal := big.NewInt(42)
 		// Deliberately larger than a 64 bit unsigned integer to test overflow
 		k1bal := big.NewInt(0)
@@ -184,6 +184,40 @@ func TestBalanceMonitor_OnNewLongestChain_UpdatesBalance(t *testing.T) {
 	})
 }
 
+func TestBalanceMonitor_IsLowBalance(t *testing.T) {
+	t.Parallel()
+
+	k0Addr := testutils.NewAddress()
+	k1Addr := testutils.NewAddress()
+	k2Addr := testutils.NewAddress()
+	newStartedBalanceMonitor := func(t *testing.T, lowBalanceThreshold *assets.Wei) monitor.BalanceMonitor {
+		ethKeyStore := ksmocks.NewEth(t)
+		ethKeyStore.On("EnabledAddressesForChain", mock.Anything, mock.Anything).
+			Return([]common.Address{k0Addr, k1Addr}, nil)
+		ethClient := newEthClientMock(t)
+		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(0), nil)
+		ethClient.On("BalanceAt", mock.Anything, k1Addr, nilBigInt).Once().Return(big.NewInt(100), nil)
+
+		bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, lowBalanceThreshold, logger.Test(t))
+		servicetest.RunHealthy(t, bm)
+		return bm
+	}
+
+	t.Run("flags zero balances without threshold", func(t *testing.T) {
+		bm := newStartedBalanceMonitor(t, nil)
+		assert.True(t, bm.IsLowBalance(k0Addr))
+		assert.False(t, bm.IsLowBalance(k1Addr))
+		assert.False(t, bm.IsLowBalance(k2Addr))
+	})
+
+	t.Run("flags balances below the threshold", func(t *testing.T) {
+		bm := newStartedBalanceMonitor(t, assets.NewWeiI(101))
+		assert.True(t, bm.IsLowBalance(k0Addr))
+		assert.True(t, bm.IsLowBalance(k1Addr))
+		assert.False(t, bm.IsLowBalance(k2Addr))
+	})
+}
+
 func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
 	t.Parallel()
 
@@ -193,7 +227,7 @@ func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
 
 	ethClient := newEthClientMock(t)
 
-	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, logger.Test(t))
+	bm := monitor.NewBalanceMonitor(ethClient, ethKeyStore, nil, logger.Test(t))
 	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).
 		Once().
 		Return(big.NewInt(1), nil)
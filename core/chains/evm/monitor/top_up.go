@@ -0,0 +1,293 @@
+package monitor
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+var promTopUps = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "eth_key_top_ups",
+	Help: "Number of top-ups of the keys of a chain sent, or recorded only in dry-run mode, from the treasury key",
+}, []string{"account", "evmChainID", "dryRun"})
+
+// topUpWindow is the period over which the spending of the treasury is capped.
+const topUpWindow = 24 * time.Hour
+
+// TopUp is a row of the evm.key_top_ups audit table.
+type TopUp struct {
+	ID              int64
+	EVMChainID      ubig.Big
+	TreasuryAddress gethCommon.Address
+	Address         gethCommon.Address
+	// Balance is the balance of the key when it was topped up.
+	Balance assets.Wei
+	Amount  assets.Wei
+	// TxID is the ID of the transaction sending Amount, unless DryRun.
+	TxID      *int64
+	DryRun    bool
+	CreatedAt time.Time
+}
+
+// TopUpORM records the top-ups sent by a TopUpper.
+type TopUpORM interface {
+	InsertTopUp(ctx context.Context, t *TopUp) error
+	// SetTopUpTxID links the top-up id to the transaction txID sending it.
+	SetTopUpTxID(ctx context.Context, id int64, txID int64) error
+	// DeleteTopUp deletes the top-up id, whose transaction failed to be queued.
+	DeleteTopUp(ctx context.Context, id int64) error
+	// SpentSince returns the sum of the top-ups sent, or only recorded if dryRun, by treasury since the given time.
+	SpentSince(ctx context.Context, chainID *big.Int, treasury gethCommon.Address, since time.Time, dryRun bool) (*assets.Wei, error)
+	// PendingTopUp returns true if the last top-up of address is still in flight, or was recorded at the given balance,
+	// meaning the balance of the key did not change since.
+	PendingTopUp(ctx context.Context, chainID *big.Int, address gethCommon.Address, balance *assets.Wei) (bool, error)
+}
+
+type topUpORM struct {
+	ds sqlutil.DataSource
+}
+
+var _ TopUpORM = (*topUpORM)(nil)
+
+func NewTopUpORM(ds sqlutil.DataSource) TopUpORM {
+	return &topUpORM{ds: ds}
+}
+
+func (o *topUpORM) InsertTopUp(ctx context.Context, t *TopUp) error {
+	query := `INSERT INTO evm.key_top_ups (evm_chain_id, treasury_address, address, balance, amount, tx_id, dry_run, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id`
+	err := o.ds.GetContext(ctx, &t.ID, query, t.EVMChainID, t.TreasuryAddress, t.Address, t.Balance, t.Amount, t.TxID, t.DryRun, t.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("InsertTopUp failed to insert evm.key_top_ups: %w", err)
+	}
+	return nil
+}
+
+func (o *topUpORM) SetTopUpTxID(ctx context.Context, id int64, txID int64) error {
+	if _, err := o.ds.ExecContext(ctx, `UPDATE evm.key_top_ups SET tx_id = $2 WHERE id = $1`, id, txID); err != nil {
+		return fmt.Errorf("SetTopUpTxID failed to update evm.key_top_ups: %w", err)
+	}
+	return nil
+}
+
+func (o *topUpORM) DeleteTopUp(ctx context.Context, id int64) error {
+	if _, err := o.ds.ExecContext(ctx, `DELETE FROM evm.key_top_ups WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("DeleteTopUp failed to delete evm.key_top_ups: %w", err)
+	}
+	return nil
+}
+
+func (o *topUpORM) SpentSince(ctx context.Context, chainID *big.Int, treasury gethCommon.Address, since time.Time, dryRun bool) (*assets.Wei, error) {
+	var spent assets.Wei
+	query := `SELECT COALESCE(SUM(amount), 0) FROM evm.key_top_ups
+WHERE evm_chain_id = $1 AND treasury_address = $2 AND created_at > $3 AND dry_run = $4`
+	if err := o.ds.GetContext(ctx, &spent, query, ubig.New(chainID), treasury, since, dryRun); err != nil {
+		return nil, fmt.Errorf("SpentSince failed to load evm.key_top_ups: %w", err)
+	}
+	return &spent, nil
+}
+
+func (o *topUpORM) PendingTopUp(ctx context.Context, chainID *big.Int, address gethCommon.Address, balance *assets.Wei) (bool, error) {
+	var pending bool
+	query := `SELECT COALESCE(tx.state IN ('unstarted', 'in_progress', 'unconfirmed'), false)
+	OR (t.balance = $3 AND (t.dry_run OR tx.state IS NULL OR tx.state <> 'fatal_error'))
+FROM evm.key_top_ups t LEFT JOIN evm.txes tx ON tx.id = t.tx_id
+WHERE t.evm_chain_id = $1 AND t.address = $2
+ORDER BY t.created_at DESC, t.id DESC LIMIT 1`
+	err := o.ds.GetContext(ctx, &pending, query, ubig.New(chainID), address, balance)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("PendingTopUp failed to load evm.key_top_ups: %w", err)
+	}
+	return pending, nil
+}
+
+type topUpBalances interface {
+	GetEthBalance(gethCommon.Address) *assets.Eth
+}
+
+type topUpKeyStore interface {
+	CheckEnabled(ctx context.Context, address gethCommon.Address, chainID *big.Int) error
+	EnabledAddressesForChain(ctx context.Context, chainID *big.Int) (addresses []gethCommon.Address, err error)
+}
+
+type topUpTxManager interface {
+	SendNativeToken(ctx context.Context, chainID *big.Int, from, to gethCommon.Address, value big.Int, gasLimit uint64) (etx txmgr.Tx, err error)
+}
+
+// TopUpper funds the enabled keys of a chain whose balance, as last seen by the balance monitor, dropped below a
+// threshold. Top-ups are sent from a treasury key held in the keystore, up to a target balance, and capped by the amount
+// spent by the treasury over the last 24 hours. Every top-up is recorded in the evm.key_top_ups table, including those
+// only recorded in dry-run mode.
+type TopUpper struct {
+	services.Service
+	eng *services.Engine
+
+	chainID     *big.Int
+	treasury    gethCommon.Address
+	cfg         config.TopUp
+	gasLimit    uint64
+	balances    topUpBalances
+	keyStore    topUpKeyStore
+	txm         topUpTxManager
+	orm         TopUpORM
+	sleeperTask *utils.SleeperTask
+}
+
+// NewTopUpper returns a TopUpper for the keys of chainID, sending top-ups of gasLimit from the treasury of cfg, which
+// must be set.
+func NewTopUpper(chainID *big.Int, cfg config.TopUp, gasLimit uint64, balances topUpBalances, keyStore topUpKeyStore, txm topUpTxManager, orm TopUpORM, lggr logger.Logger) *TopUpper {
+	t := &TopUpper{
+		chainID:  chainID,
+		treasury: cfg.TreasuryAddress().Address(),
+		cfg:      cfg,
+		gasLimit: gasLimit,
+		balances: balances,
+		keyStore: keyStore,
+		txm:      txm,
+		orm:      orm,
+	}
+	t.Service, t.eng = services.Config{
+		Name:  "TopUpper",
+		Close: t.close,
+	}.NewServiceEngine(lggr)
+	t.sleeperTask = utils.NewSleeperTaskCtx(&topUpWorker{t: t})
+	return t
+}
+
+func (t *TopUpper) close() error {
+	return t.sleeperTask.Stop()
+}
+
+// OnNewLongestChain tops up the keys with a low balance
+func (t *TopUpper) OnNewLongestChain(_ context.Context, _ *evmtypes.Head) {
+	if !t.sleeperTask.WakeUpIfStarted() {
+		t.eng.Debugw("TopUpper: ignoring OnNewLongestChain call, top-upper is not started", "state", t.sleeperTask.State())
+	}
+}
+
+// TopUp tops up every enabled key, other than the treasury, whose balance is below the threshold and that has no pending
+// top-up, as long as the daily spend cap of the treasury allows it.
+func (t *TopUpper) TopUp(ctx context.Context) error {
+	if err := t.keyStore.CheckEnabled(ctx, t.treasury, t.chainID); err != nil {
+		return fmt.Errorf("treasury key is not usable: %w", err)
+	}
+	addresses, err := t.keyStore.EnabledAddressesForChain(ctx, t.chainID)
+	if err != nil {
+		return fmt.Errorf("failed to get enabled addresses: %w", err)
+	}
+	dryRun := t.cfg.DryRun()
+	spent, err := t.orm.SpentSince(ctx, t.chainID, t.treasury, time.Now().Add(-topUpWindow), dryRun)
+	if err != nil {
+		return err
+	}
+	var treasuryBalance *assets.Wei
+	if bal := t.balances.GetEthBalance(t.treasury); bal != nil {
+		treasuryBalance = assets.NewWei(bal.ToInt())
+	}
+
+	for _, address := range addresses {
+		if address == t.treasury {
+			continue
+		}
+		bal := t.balances.GetEthBalance(address)
+		if bal == nil {
+			continue
+		}
+		balance := assets.NewWei(bal.ToInt())
+		if balance.Cmp(t.cfg.Threshold()) >= 0 {
+			continue
+		}
+		lggr := logger.With(t.eng, "address", address, "balance", balance, "treasury", t.treasury, "dryRun", dryRun)
+
+		pending, err := t.orm.PendingTopUp(ctx, t.chainID, address, balance)
+		if err != nil {
+			return err
+		}
+		if pending {
+			lggr.Debug("TopUpper: skipping key with a pending top-up")
+			continue
+		}
+		amount := t.cfg.Target().Sub(balance)
+		if spent.Add(amount).Cmp(t.cfg.DailySpendCap()) > 0 {
+			lggr.Warnw("TopUpper: skipping top-up exceeding the daily spend cap of the treasury", "amount", amount,
+				"spent", spent, "dailySpendCap", t.cfg.DailySpendCap())
+			continue
+		}
+		if !dryRun && treasuryBalance != nil && treasuryBalance.Cmp(amount) < 0 {
+			lggr.Errorw("TopUpper: treasury balance is too low to top up key", "amount", amount, "treasuryBalance", treasuryBalance)
+			continue
+		}
+
+		topUp := TopUp{
+			EVMChainID:      *ubig.New(t.chainID),
+			TreasuryAddress: t.treasury,
+			Address:         address,
+			Balance:         *balance,
+			Amount:          *amount,
+			DryRun:          dryRun,
+			CreatedAt:       time.Now(),
+		}
+		// The top-up is recorded before its transaction is queued, so that it is never sent without being accounted
+		// for in the spending of the treasury
+		if err := t.orm.InsertTopUp(ctx, &topUp); err != nil {
+			return err
+		}
+		if !dryRun {
+			etx, err := t.txm.SendNativeToken(ctx, t.chainID, t.treasury, address, *amount.ToInt(), t.gasLimit)
+			if err != nil {
+				lggr.Errorw("TopUpper: failed to send top-up", "amount", amount, "err", err)
+				if err := t.orm.DeleteTopUp(ctx, topUp.ID); err != nil {
+					return err
+				}
+				continue
+			}
+			topUp.TxID = &etx.ID
+			if err := t.orm.SetTopUpTxID(ctx, topUp.ID, etx.ID); err != nil {
+				return err
+			}
+			if treasuryBalance != nil {
+				treasuryBalance = treasuryBalance.Sub(amount)
+			}
+		}
+		spent = spent.Add(amount)
+		promTopUps.WithLabelValues(address.Hex(), t.chainID.String(), strconv.FormatBool(dryRun)).Inc()
+		lggr.Infow("TopUpper: topped up key", "amount", amount, "txID", topUp.TxID)
+	}
+	return nil
+}
+
+type topUpWorker struct {
+	t *TopUpper
+}
+
+func (*topUpWorker) Name() string {
+	return "TopUpperWorker"
+}
+
+func (w *topUpWorker) Work(ctx context.Context) {
+	if err := w.t.TopUp(ctx); err != nil {
+		w.t.eng.Errorw("TopUpper: failed to top up keys", "err", err)
+	}
+}
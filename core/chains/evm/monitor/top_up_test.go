@@ -0,0 +1,239 @@
+package monitor_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	ksmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/keystore/mocks"
+	evmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/monitor"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+)
+
+type topUpConfig struct {
+	treasury types.EIP55Address
+	dryRun   bool
+}
+
+func (c *topUpConfig) TreasuryAddress() *types.EIP55Address { return &c.treasury }
+func (c *topUpConfig) Threshold() *assets.Wei               { return assets.Ether(1) }
+func (c *topUpConfig) Target() *assets.Wei                  { return assets.Ether(3) }
+func (c *topUpConfig) DailySpendCap() *assets.Wei           { return assets.Ether(5) }
+func (c *topUpConfig) DryRun() bool                         { return c.dryRun }
+
+type fakeTopUpORM struct {
+	topUps  []monitor.TopUp
+	spent   *assets.Wei
+	pending map[common.Address]bool
+}
+
+func (o *fakeTopUpORM) InsertTopUp(_ context.Context, t *monitor.TopUp) error {
+	t.ID = int64(len(o.topUps)) + 1
+	o.topUps = append(o.topUps, *t)
+	return nil
+}
+
+func (o *fakeTopUpORM) SetTopUpTxID(_ context.Context, id int64, txID int64) error {
+	for i := range o.topUps {
+		if o.topUps[i].ID == id {
+			o.topUps[i].TxID = &txID
+		}
+	}
+	return nil
+}
+
+func (o *fakeTopUpORM) DeleteTopUp(_ context.Context, id int64) error {
+	o.topUps = slices.DeleteFunc(o.topUps, func(t monitor.TopUp) bool { return t.ID == id })
+	return nil
+}
+
+func (o *fakeTopUpORM) SpentSince(context.Context, *big.Int, common.Address, time.Time, bool) (*assets.Wei, error) {
+	return o.spent, nil
+}
+
+func (o *fakeTopUpORM) PendingTopUp(_ context.Context, _ *big.Int, address common.Address, _ *assets.Wei) (bool, error) {
+	return o.pending[address], nil
+}
+
+type fakeTopUpTxManager struct {
+	sent   map[common.Address]*big.Int
+	failed map[common.Address]bool
+}
+
+func (m *fakeTopUpTxManager) SendNativeToken(_ context.Context, _ *big.Int, _, to common.Address, value big.Int, _ uint64) (txmgr.Tx, error) {
+	if m.failed[to] {
+		return txmgr.Tx{}, errors.New("failed to queue tx")
+	}
+	m.sent[to] = &value
+	return txmgr.Tx{ID: int64(len(m.sent))}, nil
+}
+
+func TestTopUpper_TopUp(t *testing.T) {
+	t.Parallel()
+
+	chainID := big.NewInt(0)
+	treasury := testutils.NewAddress()
+	eth := func(w *assets.Wei) *assets.Eth { return (*assets.Eth)(w.ToInt()) }
+
+	// newTestTopUpper returns a TopUpper of the enabled keys addresses, whose balances are given, with a threshold of
+	// 1 ether, a target of 3 ether and a daily spend cap of 5 ether.
+	newTestTopUpper := func(t *testing.T, dryRun bool, balances map[common.Address]*assets.Eth, addresses ...common.Address) (*monitor.TopUpper, *fakeTopUpORM, *fakeTopUpTxManager) {
+		ks := ksmocks.NewEth(t)
+		ks.On("CheckEnabled", mock.Anything, treasury, chainID).Return(nil)
+		ks.On("EnabledAddressesForChain", mock.Anything, chainID).Return(append(addresses, treasury), nil)
+		bm := evmmocks.NewBalanceMonitor(t)
+		bm.On("GetEthBalance", mock.Anything).Return(func(address common.Address) *assets.Eth {
+			if address == treasury {
+				return eth(assets.Ether(100))
+			}
+			return balances[address]
+		})
+		orm := &fakeTopUpORM{spent: assets.NewWeiI(0), pending: make(map[common.Address]bool)}
+		txm := &fakeTopUpTxManager{sent: make(map[common.Address]*big.Int), failed: make(map[common.Address]bool)}
+		cfg := &topUpConfig{treasury: types.EIP55AddressFromAddress(treasury), dryRun: dryRun}
+		return monitor.NewTopUpper(chainID, cfg, 21000, bm, ks, txm, orm, logger.Test(t)), orm, txm
+	}
+
+	t.Run("tops up keys below the threshold to the target", func(t *testing.T) {
+		ctx := tests.Context(t)
+		low := testutils.NewAddress()
+		above := testutils.NewAddress()
+		unknown := testutils.NewAddress()
+		pending := testutils.NewAddress()
+		topUpper, orm, txm := newTestTopUpper(t, false, map[common.Address]*assets.Eth{
+			low:     eth(assets.Ether(1).Sub(assets.NewWeiI(1))),
+			above:   eth(assets.Ether(1)),
+			pending: eth(assets.NewWeiI(0)),
+		}, low, above, unknown, pending)
+		orm.pending[pending] = true
+
+		require.NoError(t, topUpper.TopUp(ctx))
+		assert.Equal(t, map[common.Address]*big.Int{low: assets.Ether(2).Add(assets.NewWeiI(1)).ToInt()}, txm.sent)
+		require.Len(t, orm.topUps, 1)
+		assert.Equal(t, low, orm.topUps[0].Address)
+		assert.Equal(t, treasury, orm.topUps[0].TreasuryAddress)
+		assert.Equal(t, int64(1), *orm.topUps[0].TxID)
+		assert.False(t, orm.topUps[0].DryRun)
+	})
+
+	t.Run("skips top-ups exceeding the daily spend cap", func(t *testing.T) {
+		ctx := tests.Context(t)
+		k0 := testutils.NewAddress()
+		k1 := testutils.NewAddress()
+		k2 := testutils.NewAddress()
+		topUpper, orm, txm := newTestTopUpper(t, false, map[common.Address]*assets.Eth{
+			k0: eth(assets.NewWeiI(0)),
+			k1: eth(assets.NewWeiI(0)),
+			k2: eth(assets.Ether(2).Sub(assets.NewWeiI(1))),
+		}, k0, k1, k2)
+		orm.spent = assets.Ether(1)
+
+		require.NoError(t, topUpper.TopUp(ctx))
+		assert.Equal(t, map[common.Address]*big.Int{k0: assets.Ether(3).ToInt()}, txm.sent)
+		assert.Len(t, orm.topUps, 1)
+	})
+
+	t.Run("deletes top-ups whose transaction fails to be queued", func(t *testing.T) {
+		ctx := tests.Context(t)
+		failed := testutils.NewAddress()
+		low := testutils.NewAddress()
+		topUpper, orm, txm := newTestTopUpper(t, false, map[common.Address]*assets.Eth{
+			failed: eth(assets.NewWeiI(0)),
+			low:    eth(assets.NewWeiI(0)),
+		}, failed, low)
+		txm.failed[failed] = true
+
+		require.NoError(t, topUpper.TopUp(ctx))
+		assert.Equal(t, map[common.Address]*big.Int{low: assets.Ether(3).ToInt()}, txm.sent)
+		require.Len(t, orm.topUps, 1)
+		assert.Equal(t, low, orm.topUps[0].Address)
+		assert.Equal(t, int64(1), *orm.topUps[0].TxID)
+	})
+
+	t.Run("records top-ups without sending them in dry-run mode", func(t *testing.T) {
+		ctx := tests.Context(t)
+		low := testutils.NewAddress()
+		topUpper, orm, txm := newTestTopUpper(t, true, map[common.Address]*assets.Eth{
+			low: eth(assets.NewWeiI(0)),
+		}, low)
+
+		require.NoError(t, topUpper.TopUp(ctx))
+		assert.Empty(t, txm.sent)
+		require.Len(t, orm.topUps, 1)
+		assert.Equal(t, *assets.Ether(3), orm.topUps[0].Amount)
+		assert.Nil(t, orm.topUps[0].TxID)
+		assert.True(t, orm.topUps[0].DryRun)
+	})
+
+	t.Run("fails if the treasury key is not enabled", func(t *testing.T) {
+		ctx := tests.Context(t)
+		ks := ksmocks.NewEth(t)
+		ks.On("CheckEnabled", mock.Anything, treasury, chainID).Return(errors.New("key not found"))
+		cfg := &topUpConfig{treasury: types.EIP55AddressFromAddress(treasury)}
+		topUpper := monitor.NewTopUpper(chainID, cfg, 21000, evmmocks.NewBalanceMonitor(t), ks, nil, &fakeTopUpORM{}, logger.Test(t))
+
+		require.ErrorContains(t, topUpper.TopUp(ctx), "treasury key is not usable: key not found")
+	})
+}
+
+func TestTopUpORM(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	orm := monitor.NewTopUpORM(pgtest.NewSqlxDB(t))
+	chainID := big.NewInt(0)
+	treasury := testutils.NewAddress()
+	address := testutils.NewAddress()
+
+	newTopUp := func(dryRun bool) *monitor.TopUp {
+		return &monitor.TopUp{
+			EVMChainID:      *ubig.New(chainID),
+			TreasuryAddress: treasury,
+			Address:         address,
+			Balance:         *assets.Ether(1),
+			Amount:          *assets.Ether(2),
+			DryRun:          dryRun,
+			CreatedAt:       time.Now(),
+		}
+	}
+	for _, dryRun := range []bool{true, false} {
+		require.NoError(t, orm.InsertTopUp(ctx, newTopUp(dryRun)))
+	}
+	deleted := newTopUp(true)
+	require.NoError(t, orm.InsertTopUp(ctx, deleted))
+	require.NoError(t, orm.DeleteTopUp(ctx, deleted.ID))
+
+	spent, err := orm.SpentSince(ctx, chainID, treasury, time.Now().Add(-time.Hour), true)
+	require.NoError(t, err)
+	assert.Equal(t, assets.Ether(2).String(), spent.String())
+	spent, err = orm.SpentSince(ctx, chainID, treasury, time.Now(), false)
+	require.NoError(t, err)
+	assert.True(t, spent.IsZero())
+
+	pending, err := orm.PendingTopUp(ctx, chainID, address, assets.Ether(1))
+	require.NoError(t, err)
+	assert.True(t, pending)
+	pending, err = orm.PendingTopUp(ctx, chainID, address, assets.Ether(3))
+	require.NoError(t, err)
+	assert.False(t, pending)
+	pending, err = orm.PendingTopUp(ctx, chainID, testutils.NewAddress(), assets.Ether(1))
+	require.NoError(t, err)
+	assert.False(t, pending)
+}
@@ -56,7 +56,11 @@ func (c *evmTxAttemptBuilder) NewTxAttempt(ctx context.Context, etx Tx, lggr log
 // used for L2 re-estimation on broadcasting (note EIP1559 must be disabled otherwise this will fail with mismatched fees + tx type)
 func (c *evmTxAttemptBuilder) NewTxAttemptWithType(ctx context.Context, etx Tx, lggr logger.Logger, txType int, opts ...feetypes.Opt) (attempt TxAttempt, fee gas.EvmFee, feeLimit uint64, retryable bool, err error) {
 	keySpecificMaxGasPriceWei := c.feeConfig.PriceMaxKey(etx.FromAddress)
-	fee, feeLimit, err = c.EvmFeeEstimator.GetFee(ctx, etx.EncodedPayload, etx.FeeLimit, keySpecificMaxGasPriceWei, &etx.FromAddress, &etx.ToAddress, opts...)
+	if target, ok := inclusionTarget(etx); ok {
+		fee, feeLimit, err = c.EvmFeeEstimator.GetFeeForInclusion(ctx, etx.EncodedPayload, etx.FeeLimit, keySpecificMaxGasPriceWei, &etx.FromAddress, &etx.ToAddress, target, opts...)
+	} else {
+		fee, feeLimit, err = c.EvmFeeEstimator.GetFee(ctx, etx.EncodedPayload, etx.FeeLimit, keySpecificMaxGasPriceWei, &etx.FromAddress, &etx.ToAddress, opts...)
+	}
 	if err != nil {
 		return attempt, fee, feeLimit, true, pkgerrors.Wrap(err, "failed to get fee") // estimator errors are retryable
 	}
@@ -74,20 +78,58 @@ func (c *evmTxAttemptBuilder) NewBumpTxAttempt(ctx context.Context, etx Tx, prev
 	if err != nil {
 		return attempt, bumpedFee, bumpedFeeLimit, true, pkgerrors.Wrap(err, "failed to bump fee") // estimator errors are retryable
 	}
+	if target, ok := inclusionTarget(etx); ok && !previousAttempt.IsPurgeAttempt {
+		// The window left until the deadline shrinks with every bump, so the forecast fee rises as the deadline nears
+		bumpedFee = c.raiseToInclusionFee(ctx, etx, bumpedFee, keySpecificMaxGasPriceWei, target, lggr)
+	}
 	// If transaction's previous attempt is marked for purge, ensure the new bumped attempt also sends empty payload, 0 value, and LimitDefault as fee limit
 	if previousAttempt.IsPurgeAttempt {
 		etx.EncodedPayload = []byte{}
 		etx.Value = *big.NewInt(0)
 		bumpedFeeLimit = c.feeConfig.LimitDefault()
 	}
+	// Cancel attempts are also sent to the from address
+	if previousAttempt.IsCancelAttempt {
+		etx.ToAddress = etx.FromAddress
+	}
 	attempt, retryable, err = c.NewCustomTxAttempt(ctx, etx, bumpedFee, bumpedFeeLimit, previousAttempt.TxType, lggr)
 	// If transaction's previous attempt is marked for purge, ensure the new bumped attempt is also marked for purge
 	if previousAttempt.IsPurgeAttempt {
 		attempt.IsPurgeAttempt = true
 	}
+	attempt.IsCancelAttempt = previousAttempt.IsCancelAttempt
 	return attempt, bumpedFee, bumpedFeeLimit, retryable, err
 }
 
+// raiseToInclusionFee returns fee raised to the one forecast for etx to be included within target.
+func (c *evmTxAttemptBuilder) raiseToInclusionFee(ctx context.Context, etx Tx, fee gas.EvmFee, maxFeePrice *assets.Wei, target gas.InclusionTarget, lggr logger.Logger) gas.EvmFee {
+	forecast, _, err := c.EvmFeeEstimator.GetFeeForInclusion(ctx, etx.EncodedPayload, etx.FeeLimit, maxFeePrice, &etx.FromAddress, &etx.ToAddress, target)
+	if err != nil {
+		lggr.Warnw("Failed to forecast the fee for the inclusion target, using the bumped fee", "target", target, "err", err)
+		return fee
+	}
+	if fee.GasPrice != nil && forecast.GasPrice != nil {
+		fee.GasPrice = assets.WeiMax(fee.GasPrice, forecast.GasPrice)
+	}
+	if fee.ValidDynamic() && forecast.ValidDynamic() {
+		fee.GasTipCap = assets.WeiMax(fee.GasTipCap, forecast.GasTipCap)
+		fee.GasFeeCap = assets.WeiMax(fee.GasFeeCap, assets.WeiMax(forecast.GasFeeCap, fee.GasTipCap))
+	}
+	return fee
+}
+
+// inclusionTarget returns the inclusion target of etx, if it has a deadline.
+func inclusionTarget(etx Tx) (gas.InclusionTarget, bool) {
+	if etx.Deadline.IsZero() {
+		return gas.InclusionTarget{}, false
+	}
+	return gas.InclusionTarget{
+		DeadlineTime:  etx.Deadline.Time,
+		DeadlineBlock: etx.Deadline.BlockNumber,
+		Confidence:    gas.DefaultInclusionConfidence,
+	}, true
+}
+
 func (c *evmTxAttemptBuilder) NewPurgeTxAttempt(ctx context.Context, etx Tx, lggr logger.Logger) (attempt TxAttempt, err error) {
 	// Use the LimitDefault since this is an empty tx
 	gasLimit := c.feeConfig.LimitDefault()
@@ -109,6 +151,18 @@ func (c *evmTxAttemptBuilder) NewPurgeTxAttempt(ctx context.Context, etx Tx, lgg
 	return attempt, nil
 }
 
+// NewCancelTxAttempt builds a purge attempt sending 0 value to the from address, so that the transaction is replaced by a
+// plain self-transfer at the same nonce.
+func (c *evmTxAttemptBuilder) NewCancelTxAttempt(ctx context.Context, etx Tx, lggr logger.Logger) (attempt TxAttempt, err error) {
+	etx.ToAddress = etx.FromAddress
+	attempt, err = c.NewPurgeTxAttempt(ctx, etx, lggr)
+	if err != nil {
+		return attempt, fmt.Errorf("failed to create cancel attempt: %w", err)
+	}
+	attempt.IsCancelAttempt = true
+	return attempt, nil
+}
+
 // NewCustomTxAttempt is the lowest level func where the fee parameters + tx type must be passed in
 // used in the txm for force rebroadcast where fees and tx type are pre-determined without an estimator
 func (c *evmTxAttemptBuilder) NewCustomTxAttempt(ctx context.Context, etx Tx, fee gas.EvmFee, gasLimit uint64, txType int, lggr logger.Logger) (attempt TxAttempt, retryable bool, err error) {
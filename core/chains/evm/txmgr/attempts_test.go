@@ -17,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
@@ -301,6 +302,101 @@ func TestTxm_NewPurgeAttempt(t *testing.T) {
 	})
 }
 
+func TestTxm_NewCancelAttempt(t *testing.T) {
+	addr := NewEvmAddress()
+	kst := ksmocks.NewEth(t)
+	tx := types.NewTx(&types.LegacyTx{})
+	kst.On("SignTx", mock.Anything, addr, mock.Anything, big.NewInt(1)).Return(tx, nil)
+	gc := newFeeConfig()
+	gc.priceMin = assets.GWei(10)
+	gc.priceMax = assets.GWei(50)
+	gc.limitDefault = uint64(10)
+	est := gasmocks.NewEvmFeeEstimator(t)
+	bumpedLegacy := assets.GWei(30)
+	est.On("BumpFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(gas.EvmFee{GasPrice: bumpedLegacy}, uint64(10_000), nil)
+	cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, kst, est)
+	lggr := logger.Test(t)
+	ctx := tests.Context(t)
+
+	n := evmtypes.Nonce(0)
+	etx := txmgr.Tx{Sequence: &n, FromAddress: addr, ToAddress: NewEvmAddress(), EncodedPayload: []byte{1, 2, 3}, Value: *big.NewInt(1)}
+	prevAttempt, _, err := cks.NewCustomTxAttempt(ctx, etx, gas.EvmFee{GasPrice: bumpedLegacy.Sub(assets.GWei(1))}, 100, 0x0, lggr)
+	require.NoError(t, err)
+	etx.TxAttempts = append(etx.TxAttempts, prevAttempt)
+
+	t.Run("creates empty attempt sent to the from address", func(t *testing.T) {
+		a, err := cks.NewCancelTxAttempt(ctx, etx, lggr)
+		require.NoError(t, err)
+		require.Equal(t, gc.limitDefault, a.ChainSpecificFeeLimit)
+		require.Equal(t, bumpedLegacy.String(), a.TxFee.GasPrice.String())
+		require.True(t, a.IsPurgeAttempt)
+		require.True(t, a.IsCancelAttempt)
+		require.Equal(t, addr, a.Tx.ToAddress)
+		require.Equal(t, []byte{}, a.Tx.EncodedPayload)
+		require.Equal(t, *big.NewInt(0), a.Tx.Value)
+	})
+
+	t.Run("creates bump cancel attempt with fields", func(t *testing.T) {
+		cancelAttempt, err := cks.NewCancelTxAttempt(ctx, etx, lggr)
+		require.NoError(t, err)
+		etx := etx
+		etx.TxAttempts = append(etx.TxAttempts, cancelAttempt)
+		bumpAttempt, _, _, _, err := cks.NewBumpTxAttempt(ctx, etx, cancelAttempt, etx.TxAttempts, lggr)
+		require.NoError(t, err)
+		require.Equal(t, gc.limitDefault, bumpAttempt.ChainSpecificFeeLimit)
+		require.True(t, bumpAttempt.IsPurgeAttempt)
+		require.True(t, bumpAttempt.IsCancelAttempt)
+		require.Equal(t, addr, bumpAttempt.Tx.ToAddress)
+		require.Equal(t, []byte{}, bumpAttempt.Tx.EncodedPayload)
+		require.Equal(t, *big.NewInt(0), bumpAttempt.Tx.Value)
+	})
+}
+
+func TestTxm_NewAttempt_InclusionTarget(t *testing.T) {
+	t.Parallel()
+
+	addr := NewEvmAddress()
+	kst := ksmocks.NewEth(t)
+	tx := types.NewTx(&types.LegacyTx{})
+	kst.On("SignTx", mock.Anything, addr, mock.Anything, big.NewInt(1)).Return(tx, nil)
+	gc := newFeeConfig()
+	gc.priceMax = assets.GWei(50)
+	lggr := logger.Test(t)
+	ctx := tests.Context(t)
+
+	deadline := int64(42)
+	n := evmtypes.Nonce(0)
+	etx := txmgr.Tx{Sequence: &n, FromAddress: addr, FeeLimit: 100, Deadline: txmgrtypes.TxDeadline{BlockNumber: &deadline}}
+	target := gas.InclusionTarget{DeadlineBlock: &deadline, Confidence: gas.DefaultInclusionConfidence}
+
+	t.Run("prices txs with a deadline to be included by it", func(t *testing.T) {
+		est := gasmocks.NewEvmFeeEstimator(t)
+		est.On("GetFeeForInclusion", mock.Anything, mock.Anything, uint64(100), gc.priceMax, &etx.FromAddress, &etx.ToAddress, target).
+			Return(gas.EvmFee{GasPrice: assets.GWei(20)}, uint64(100), nil).Once()
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, kst, est)
+
+		a, fee, _, _, err := cks.NewTxAttempt(ctx, etx, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(20), fee.GasPrice)
+		assert.Equal(t, assets.GWei(20), a.TxFee.GasPrice)
+	})
+
+	t.Run("raises bumps to the fee forecast for the deadline", func(t *testing.T) {
+		est := gasmocks.NewEvmFeeEstimator(t)
+		est.On("BumpFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(gas.EvmFee{GasPrice: assets.GWei(22)}, uint64(100), nil).Once()
+		est.On("GetFeeForInclusion", mock.Anything, mock.Anything, uint64(100), gc.priceMax, &etx.FromAddress, &etx.ToAddress, target).
+			Return(gas.EvmFee{GasPrice: assets.GWei(30)}, uint64(100), nil).Once()
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, kst, est)
+
+		prevAttempt, _, err := cks.NewCustomTxAttempt(ctx, etx, gas.EvmFee{GasPrice: assets.GWei(20)}, 100, 0x0, lggr)
+		require.NoError(t, err)
+		a, fee, _, _, err := cks.NewBumpTxAttempt(ctx, etx, prevAttempt, []txmgr.TxAttempt{prevAttempt}, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(30), fee.GasPrice)
+		assert.Equal(t, assets.GWei(30), a.TxFee.GasPrice)
+	})
+}
+
 func TestTxm_NewCustomTxAttempt_NonRetryableErrors(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,118 @@
+package txmgr
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	"github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/authorized_forwarder"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/shared/generated/multicall3"
+)
+
+// Multicall3Address is the address Multicall3 is deployed at on most chains.
+var Multicall3Address = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+// NewMulticall3BatchStrategy creates a new TxStrategy that aggregates up to maxBatchSize calls to targets into
+// Multicall3 transactions. Multicall3 is the msg.sender of the calls, so targets must not authenticate their caller.
+// The results of the calls allowed to fail are read by tracing their batch transaction, which the RPC must support.
+func NewMulticall3BatchStrategy(subject uuid.UUID, maxBatchSize uint32, targets []common.Address) txmgr.BatchStrategy {
+	return txmgr.NewBatchStrategy(subject, maxBatchSize, Multicall3Address, targets)
+}
+
+// NewForwarderBatchStrategy creates a new TxStrategy that aggregates up to maxBatchSize transactions sent through
+// forwarder into a single AuthorizedForwarder multiForward transaction. The forwarder stays the msg.sender of the calls,
+// so they can be authenticated like forwarded transactions. The calls must not transfer value, and any failing call
+// reverts the whole batch.
+func NewForwarderBatchStrategy(subject uuid.UUID, maxBatchSize uint32, forwarder common.Address) txmgr.BatchStrategy {
+	return txmgr.NewBatchStrategy(subject, maxBatchSize, forwarder, []common.Address{forwarder})
+}
+
+// encodeBatch returns the payload, value and gas limit of the batch transaction to batchContract making the calls of
+// txs, in order. Batches to any other contract than Multicall3 are sent to an AuthorizedForwarder.
+func encodeBatch(batchContract common.Address, txs []Tx) (payload []byte, value *big.Int, gasLimit uint64, err error) {
+	if batchContract == Multicall3Address {
+		return encodeMulticall3Batch(txs)
+	}
+	return encodeForwarderBatch(txs)
+}
+
+// encodeMulticall3Batch returns the payload, value and gas limit of a Multicall3 aggregate3Value transaction making the
+// calls of txs, in order. The calls of the txs failing on revert revert the whole batch, the others are allowed to fail.
+// The gas limit is the sum of the ones of txs, whose intrinsic gas covers the overhead of the batch.
+func encodeMulticall3Batch(txs []Tx) (payload []byte, value *big.Int, gasLimit uint64, err error) {
+	multicallABI, err := multicall3.Multicall3MetaData.GetAbi()
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("failed to parse Multicall3 ABI: %w", err)
+	}
+	value = new(big.Int)
+	calls := make([]multicall3.Multicall3Call3Value, len(txs))
+	for i, tx := range txs {
+		meta, err := tx.GetMeta()
+		if err != nil {
+			return nil, nil, 0, fmt.Errorf("failed to read meta of tx %d: %w", tx.ID, err)
+		}
+		calls[i] = multicall3.Multicall3Call3Value{
+			Target:       tx.ToAddress,
+			AllowFailure: meta == nil || !meta.FailOnRevert.Bool,
+			Value:        new(big.Int).Set(&tx.Value),
+			CallData:     tx.EncodedPayload,
+		}
+		value.Add(value, &tx.Value)
+		gasLimit += tx.FeeLimit
+	}
+	payload, err = multicallABI.Pack("aggregate3Value", calls)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("failed to pack Multicall3 calls: %w", err)
+	}
+	return payload, value, gasLimit, nil
+}
+
+// encodeForwarderBatch returns the payload, value and gas limit of an AuthorizedForwarder multiForward transaction
+// making the forwarded calls of txs, in order.
+func encodeForwarderBatch(txs []Tx) (payload []byte, value *big.Int, gasLimit uint64, err error) {
+	forwarderABI, err := authorized_forwarder.AuthorizedForwarderMetaData.GetAbi()
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("failed to parse AuthorizedForwarder ABI: %w", err)
+	}
+	forward := forwarderABI.Methods["forward"]
+	tos := make([]common.Address, len(txs))
+	datas := make([][]byte, len(txs))
+	for i, tx := range txs {
+		if tx.Value.Sign() != 0 {
+			return nil, nil, 0, fmt.Errorf("tx %d transfers value, which cannot be forwarded", tx.ID)
+		}
+		if !bytes.HasPrefix(tx.EncodedPayload, forward.ID) {
+			return nil, nil, 0, fmt.Errorf("tx %d is not a forwarded call", tx.ID)
+		}
+		args, err := forward.Inputs.Unpack(tx.EncodedPayload[len(forward.ID):])
+		if err != nil {
+			return nil, nil, 0, fmt.Errorf("failed to unpack forwarded call of tx %d: %w", tx.ID, err)
+		}
+		tos[i] = args[0].(common.Address)
+		datas[i] = args[1].([]byte)
+		gasLimit += tx.FeeLimit
+	}
+	payload, err = forwarderABI.Pack("multiForward", tos, datas)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("failed to pack forwarded calls: %w", err)
+	}
+	return payload, new(big.Int), gasLimit, nil
+}
+
+// decodeMulticall3Results returns the results of the calls of a Multicall3 aggregate3Value transaction from its output.
+func decodeMulticall3Results(output []byte) ([]multicall3.Multicall3Result, error) {
+	multicallABI, err := multicall3.Multicall3MetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Multicall3 ABI: %w", err)
+	}
+	out, err := multicallABI.Unpack("aggregate3Value", output)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack Multicall3 results: %w", err)
+	}
+	return *abi.ConvertType(out[0], new([]multicall3.Multicall3Result)).(*[]multicall3.Multicall3Result), nil
+}
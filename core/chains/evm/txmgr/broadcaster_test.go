@@ -627,6 +627,89 @@ func TestEthBroadcaster_TransmitChecking(t *testing.T) {
 		assert.True(t, ethTx.Error.Valid)
 		assert.Equal(t, "fatal checker error", ethTx.Error.String)
 	})
+
+	t.Run("when simulation reverts, marks transaction as simulation failed", func(t *testing.T) {
+		// Checker will return a simulation revert
+		checkerFactory.err = fmt.Errorf("%w: Unauthorized()", txmgrcommon.ErrTxSimulationFailed)
+
+		ethTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, txRequestWithChecker(checker))
+		{
+			retryable, err := eb.ProcessUnstartedTxs(tests.Context(t), fromAddress)
+			assert.NoError(t, err)
+			assert.False(t, retryable)
+		}
+
+		// Check ethtx was not sent
+		ethTx, err := txStore.FindTxWithAttempts(ctx, ethTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxSimulationFailed, ethTx.State)
+		assert.Empty(t, ethTx.TxAttempts)
+		assert.Equal(t, "transaction reverted during simulation: Unauthorized()", ethTx.Error.String)
+	})
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_PastDeadline(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	ctx := tests.Context(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	ethClient.On("NonceAt", mock.Anything, fromAddress, mock.Anything).Return(uint64(0), nil).Once()
+	nonceTracker := txmgr.NewNonceTracker(logger.Test(t), txStore, txmgr.NewEvmTxmClient(ethClient, nil))
+	eb := NewTestEthBroadcaster(t, txStore, ethClient, ethKeyStore, cfg, evmcfg, &testCheckerFactory{}, false, nonceTracker)
+	eb.SetLatestBlockNum(100)
+
+	run := cltest.MustInsertPipelineRun(t, db)
+	tr := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run.ID)
+	var resumedErr error
+	eb.SetResumeCallback(func(ctx context.Context, id uuid.UUID, result interface{}, err error) error {
+		require.Equal(t, tr.ID, id)
+		resumedErr = err
+		return nil
+	})
+
+	pastBlock := int64(99)
+	pastTx := txmgr.Tx{
+		FromAddress:       fromAddress,
+		ToAddress:         testutils.NewAddress(),
+		EncodedPayload:    []byte{42, 42, 0},
+		FeeLimit:          21000,
+		State:             txmgrcommon.TxUnstarted,
+		PipelineTaskRunID: uuid.NullUUID{UUID: tr.ID, Valid: true},
+		SignalCallback:    true,
+		Deadline:          txmgrtypes.TxDeadline{BlockNumber: &pastBlock},
+	}
+	require.NoError(t, txStore.InsertTx(ctx, &pastTx))
+	futureTime := time.Now().Add(time.Hour)
+	futureTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID,
+		func(txRequest *txmgr.TxRequest) { txRequest.Deadline = txmgrtypes.TxDeadline{Time: &futureTime} })
+
+	// The tx past its deadline is cancelled without a nonce, which goes to the next tx instead
+	ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Nonce() == 0
+	}), fromAddress).Return(commonclient.Successful, nil).Once()
+
+	retryable, err := eb.ProcessUnstartedTxs(ctx, fromAddress)
+	require.NoError(t, err)
+	assert.False(t, retryable)
+
+	pastTx, err = txStore.FindTxWithAttempts(ctx, pastTx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgrcommon.TxCancelled, pastTx.State)
+	assert.Nil(t, pastTx.Sequence)
+	assert.Empty(t, pastTx.TxAttempts)
+	assert.True(t, pastTx.CallbackCompleted)
+	assert.ErrorIs(t, resumedErr, txmgrcommon.ErrTxCancelled)
+
+	futureTx, err = txStore.FindTxWithAttempts(ctx, futureTx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgrcommon.TxUnconfirmed, futureTx.State)
+	require.NotNil(t, futureTx.Sequence)
+	assert.Equal(t, evmtypes.Nonce(0), *futureTx.Sequence)
 }
 
 func TestEthBroadcaster_ProcessUnstartedEthTxs_OptimisticLockingOnEthTx(t *testing.T) {
@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
@@ -57,8 +58,17 @@ func NewTxm(
 	txmCfg := NewEvmTxmConfig(chainConfig)             // wrap Evm specific config
 	feeCfg := NewEvmTxmFeeConfig(fCfg)                 // wrap Evm specific config
 	txmClient := NewEvmTxmClient(client, clientErrors) // wrap Evm specific client
+	if relayURL := txConfig.PrivateSubmission().URL(); relayURL != nil {
+		var signingAddress *common.Address
+		if a := txConfig.PrivateSubmission().SigningAddress(); a != nil {
+			signingAddress = ptr(a.Address())
+		}
+		txmClient.SetPrivateRelay(relayURL, txConfig.PrivateSubmission().Method(), signingAddress, keyStore)
+		lggr.Infow("EvmTxm: Private submission enabled", "relay", relayURL.Redacted(), "method", txConfig.PrivateSubmission().Method(), "fallbackBlocks", txConfig.PrivateSubmission().FallbackBlocks(), "signingAddress", signingAddress)
+	}
 	chainID := txmClient.ConfiguredChainID()
-	evmBroadcaster := NewEvmBroadcaster(txStore, txmClient, txmCfg, feeCfg, txConfig, listenerConfig, keyStore, txAttemptBuilder, lggr, checker, chainConfig.NonceAutoSync(), chainConfig.ChainType())
+	nonceTracker := NewNonceTracker(lggr, txStore, txmClient)
+	evmBroadcaster := txmgr.NewBroadcaster(txStore, txmClient, txmCfg, feeCfg, txConfig, listenerConfig, keyStore, txAttemptBuilder, nonceTracker, lggr, checker, chainConfig.NonceAutoSync(), string(chainConfig.ChainType()))
 	evmTracker := NewEvmTracker(txStore, keyStore, chainID, lggr)
 	stuckTxDetector := NewStuckTxDetector(lggr, client.ConfiguredChainID(), chainConfig.ChainType(), fCfg.PriceMax(), txConfig.AutoPurge(), estimator, txStore, client)
 	evmConfirmer := NewEvmConfirmer(txStore, txmClient, feeCfg, txConfig, dbConfig, keyStore, txAttemptBuilder, lggr, stuckTxDetector, headTracker)
@@ -67,7 +77,13 @@ func NewTxm(
 	if txConfig.ResendAfterThreshold() > 0 {
 		evmResender = NewEvmResender(lggr, txStore, txmClient, evmTracker, keyStore, txmgr.DefaultResenderPollInterval, chainConfig, txConfig)
 	}
-	txm = NewEvmTxm(chainID, txmCfg, txConfig, keyStore, lggr, checker, fwdMgr, txAttemptBuilder, txStore, evmBroadcaster, evmConfirmer, evmResender, evmTracker, evmFinalizer)
+	var evmReconciler services.Service
+	if interval := txConfig.NonceReconciliation().Interval(); interval > 0 {
+		evmReconciler = NewNonceReconciler(lggr, chainID, interval, txStore, txmClient, nonceTracker, keyStore, txAttemptBuilder, estimator, fCfg)
+	} else {
+		lggr.Info("NonceReconciler: Disabled")
+	}
+	txm = NewEvmTxm(chainID, txmCfg, txConfig, keyStore, lggr, checker, fwdMgr, txAttemptBuilder, txStore, evmBroadcaster, evmConfirmer, evmResender, evmTracker, evmFinalizer, evmReconciler)
 	return txm, nil
 }
 
@@ -87,8 +103,9 @@ func NewEvmTxm(
 	resender *Resender,
 	tracker *Tracker,
 	finalizer Finalizer,
+	reconciler services.Service,
 ) *Txm {
-	return txmgr.NewTxm(chainId, cfg, txCfg, keyStore, lggr, checkerFactory, fwdMgr, txAttemptBuilder, txStore, broadcaster, confirmer, resender, tracker, finalizer, client.NewTxError)
+	return txmgr.NewTxm(chainId, cfg, txCfg, keyStore, lggr, checkerFactory, fwdMgr, txAttemptBuilder, txStore, broadcaster, confirmer, resender, tracker, finalizer, reconciler, client.NewTxError)
 }
 
 // NewEvmResender creates a new concrete EvmResender
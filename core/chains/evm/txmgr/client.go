@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"net/url"
 	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -19,6 +21,7 @@ import (
 	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 )
@@ -28,12 +31,21 @@ var _ TxmClient = (*evmTxmClient)(nil)
 type evmTxmClient struct {
 	client       client.Client
 	clientErrors config.ClientErrors
+	// privateRelay receives the transactions requesting private submission, if configured
+	privateRelay *privateRelay
 }
 
 func NewEvmTxmClient(c client.Client, clientErrors config.ClientErrors) *evmTxmClient {
 	return &evmTxmClient{client: c, clientErrors: clientErrors}
 }
 
+// SetPrivateRelay makes the client send the transactions requesting private submission to the relay at relayURL with
+// method, instead of broadcasting them to the public mempool. The requests are signed by signer with the key of
+// signingAddress, if set.
+func (c *evmTxmClient) SetPrivateRelay(relayURL *url.URL, method string, signingAddress *common.Address, signer MessageSigner) {
+	c.privateRelay = newPrivateRelay(relayURL, method, signingAddress, signer)
+}
+
 func (c *evmTxmClient) PendingSequenceAt(ctx context.Context, addr common.Address) (evmtypes.Nonce, error) {
 	return c.PendingNonceAt(ctx, addr)
 }
@@ -58,12 +70,29 @@ func (c *evmTxmClient) BatchSendTransactions(
 	codes = make([]commonclient.SendTxReturnCode, len(attempts))
 	txErrs = make([]error, len(attempts))
 
-	reqs, broadcastTime, successfulTxIDs, batchErr := batchSendTransactions(ctx, attempts, batchSize, lggr, c.client)
+	// Private attempts are resent through the private relay one by one, so that they never reach the public mempool
+	var publicAttempts []TxAttempt
+	var publicIndexes []int
+	var privateTxIDs []int64
+	for i, attempt := range attempts {
+		if c.privateRelay == nil || !attempt.Tx.PrivateSubmission {
+			publicAttempts = append(publicAttempts, attempt)
+			publicIndexes = append(publicIndexes, i)
+			continue
+		}
+		codes[i], txErrs[i] = c.SendTransactionReturnCode(ctx, attempt.Tx, attempt, lggr)
+		if codes[i] == commonclient.Successful || codes[i] == commonclient.TransactionAlreadyKnown {
+			privateTxIDs = append(privateTxIDs, attempt.TxID)
+		}
+	}
+
+	reqs, broadcastTime, successfulTxIDs, batchErr := batchSendTransactions(ctx, publicAttempts, batchSize, lggr, c.client)
+	successfulTxIDs = append(successfulTxIDs, privateTxIDs...)
 	err = errors.Join(err, batchErr) // this error does not block processing
 
 	// safety check - exits before processing
-	if len(reqs) != len(attempts) {
-		lenErr := fmt.Errorf("Returned request data length (%d) != number of tx attempts (%d)", len(reqs), len(attempts))
+	if len(reqs) != len(publicAttempts) {
+		lenErr := fmt.Errorf("Returned request data length (%d) != number of tx attempts (%d)", len(reqs), len(publicAttempts))
 		err = errors.Join(err, lenErr)
 		lggr.Criticalw("Mismatched length", "err", err)
 		return
@@ -74,8 +103,9 @@ func (c *evmTxmClient) BatchSendTransactions(
 	wg.Add(len(reqs))
 	processingErr := make([]error, len(attempts))
 	for index := range reqs {
-		go func(i int) {
+		go func(j int) {
 			defer wg.Done()
+			i := publicIndexes[j]
 
 			// convert to tx for logging purposes - exits early if error occurs
 			tx, signedErr := GetGethSignedTx(attempts[i].SignedRawTx)
@@ -85,7 +115,7 @@ func (c *evmTxmClient) BatchSendTransactions(
 				processingErr[i] = fmt.Errorf("%s: %w", signedErrMsg, signedErr)
 				return
 			}
-			sendErr := reqs[i].Error
+			sendErr := reqs[j].Error
 			codes[i] = client.ClassifySendError(sendErr, c.clientErrors, lggr, tx, attempts[i].Tx.FromAddress, c.client.IsL2())
 			txErrs[i] = sendErr
 		}(index)
@@ -101,9 +131,28 @@ func (c *evmTxmClient) SendTransactionReturnCode(ctx context.Context, etx Tx, at
 		lggr.Criticalw("Fatal error signing transaction", "err", err, "etx", etx)
 		return commonclient.Fatal, err
 	}
+	if etx.PrivateSubmission {
+		if c.privateRelay != nil {
+			return c.sendPrivateTransaction(ctx, signedTx, etx.FromAddress, lggr)
+		}
+		lggr.Warnw("Transaction requested private submission but no private relay is configured, broadcasting it publicly", "etx", etx)
+	}
 	return c.client.SendTransactionReturnCode(ctx, signedTx, etx.FromAddress)
 }
 
+func (c *evmTxmClient) sendPrivateTransaction(ctx context.Context, signedTx *types.Transaction, fromAddress common.Address, lggr logger.SugaredLogger) (commonclient.SendTxReturnCode, error) {
+	var latestBlock *big.Int
+	if c.privateRelay.method == toml.PrivateSubmissionMethodBundle {
+		var err error
+		if latestBlock, err = c.client.LatestBlockHeight(ctx); err != nil {
+			return commonclient.Retryable, fmt.Errorf("failed to get latest block height for bundle: %w", err)
+		}
+	}
+	err := c.privateRelay.sendTransaction(ctx, signedTx, latestBlock)
+	lggr.Debugw("Sent transaction to private relay", "relay", c.privateRelay.url.Redacted(), "method", c.privateRelay.method, "txHash", signedTx.Hash(), "err", err)
+	return client.ClassifySendError(err, c.clientErrors, lggr, signedTx, fromAddress, c.client.IsL2()), err
+}
+
 func (c *evmTxmClient) PendingNonceAt(ctx context.Context, fromAddress common.Address) (n evmtypes.Nonce, err error) {
 	nextNonce, err := c.client.PendingNonceAt(ctx, fromAddress)
 	if err != nil {
@@ -0,0 +1,235 @@
+package txmgr_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+)
+
+type relayRequest struct {
+	Method string
+	Params []map[string]any
+	// Signer is the address recovered from the X-Flashbots-Signature header, if any
+	Signer string
+}
+
+// recoverFlashbotsSigner returns the address that signed body in the X-Flashbots-Signature header, if set.
+func recoverFlashbotsSigner(t *testing.T, header string, body []byte) string {
+	if header == "" {
+		return ""
+	}
+	address, signature, ok := strings.Cut(header, ":")
+	require.True(t, ok)
+	pubKey, err := crypto.SigToPub(accounts.TextHash([]byte(crypto.Keccak256Hash(body).Hex())), hexutil.MustDecode(signature))
+	require.NoError(t, err)
+	require.Equal(t, address, crypto.PubkeyToAddress(*pubKey).Hex())
+	return address
+}
+
+type testMessageSigner struct {
+	key *ecdsa.PrivateKey
+}
+
+func (s testMessageSigner) SignMessage(_ context.Context, _ common.Address, message []byte) ([]byte, error) {
+	return crypto.Sign(accounts.TextHash(message), s.key)
+}
+
+// newTestRelay returns the URL of a JSON-RPC server recording the requests it receives, and answering them with
+// errMsg if set.
+func newTestRelay(t *testing.T, errMsg string) (*url.URL, func() []relayRequest) {
+	var mu sync.Mutex
+	var reqs []relayRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage  `json:"id"`
+			Method string           `json:"method"`
+			Params []map[string]any `json:"params"`
+		}
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.NoError(t, json.Unmarshal(body, &req))
+		signer := recoverFlashbotsSigner(t, r.Header.Get("X-Flashbots-Signature"), body)
+		mu.Lock()
+		reqs = append(reqs, relayRequest{Method: req.Method, Params: req.Params, Signer: signer})
+		mu.Unlock()
+		resp := map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": common.Hash{}.Hex()}
+		if errMsg != "" {
+			resp = map[string]any{"jsonrpc": "2.0", "id": req.ID, "error": map[string]any{"code": -32000, "message": errMsg}}
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	t.Cleanup(srv.Close)
+	relayURL, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	return relayURL, func() []relayRequest {
+		mu.Lock()
+		defer mu.Unlock()
+		return reqs
+	}
+}
+
+func newSignedTestAttempt(t *testing.T, private bool) (txmgr.Tx, txmgr.TxAttempt, string) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	tx := gethtypes.NewTx(&gethtypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(1), Gas: 21000, To: &common.Address{}, Value: big.NewInt(0)})
+	signedTx, err := gethtypes.SignTx(tx, gethtypes.NewEIP155Signer(testutils.FixtureChainID), key)
+	require.NoError(t, err)
+	signedRawTx, err := rlp.EncodeToBytes(signedTx)
+	require.NoError(t, err)
+	txBytes, err := signedTx.MarshalBinary()
+	require.NoError(t, err)
+
+	etx := txmgr.Tx{ID: 1, FromAddress: crypto.PubkeyToAddress(key.PublicKey), PrivateSubmission: private}
+	attempt := txmgr.TxAttempt{TxID: etx.ID, Tx: etx, Hash: signedTx.Hash(), SignedRawTx: signedRawTx}
+	return etx, attempt, hexutil.Encode(txBytes)
+}
+
+func TestEvmTxmClient_PrivateSubmission(t *testing.T) {
+	t.Parallel()
+	ctx := tests.Context(t)
+	lggr := logger.Sugared(logger.Test(t))
+
+	t.Run("sends private transactions with eth_sendPrivateTransaction", func(t *testing.T) {
+		relayURL, requests := newTestRelay(t, "")
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("IsL2").Return(false).Maybe()
+		txmClient := txmgr.NewEvmTxmClient(ethClient, nil)
+		txmClient.SetPrivateRelay(relayURL, toml.PrivateSubmissionMethodPrivateTransaction, nil, nil)
+
+		etx, attempt, rawTx := newSignedTestAttempt(t, true)
+		code, err := txmClient.SendTransactionReturnCode(ctx, etx, attempt, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, commonclient.Successful, code)
+		require.Equal(t, []relayRequest{{Method: "eth_sendPrivateTransaction", Params: []map[string]any{{"tx": rawTx}}}}, requests())
+	})
+
+	t.Run("sends private transactions with eth_sendBundle targeting the next block", func(t *testing.T) {
+		relayURL, requests := newTestRelay(t, "")
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("IsL2").Return(false).Maybe()
+		ethClient.On("LatestBlockHeight", mock.Anything).Return(big.NewInt(41), nil)
+		txmClient := txmgr.NewEvmTxmClient(ethClient, nil)
+		txmClient.SetPrivateRelay(relayURL, toml.PrivateSubmissionMethodBundle, nil, nil)
+
+		etx, attempt, rawTx := newSignedTestAttempt(t, true)
+		code, err := txmClient.SendTransactionReturnCode(ctx, etx, attempt, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, commonclient.Successful, code)
+		require.Equal(t, []relayRequest{{Method: "eth_sendBundle", Params: []map[string]any{{"txs": []any{rawTx}, "blockNumber": "0x2a"}}}}, requests())
+	})
+
+	t.Run("signs requests with the signing key", func(t *testing.T) {
+		relayURL, requests := newTestRelay(t, "")
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("IsL2").Return(false).Maybe()
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		signingAddress := crypto.PubkeyToAddress(key.PublicKey)
+		txmClient := txmgr.NewEvmTxmClient(ethClient, nil)
+		txmClient.SetPrivateRelay(relayURL, toml.PrivateSubmissionMethodPrivateTransaction, &signingAddress, testMessageSigner{key})
+
+		etx, attempt, rawTx := newSignedTestAttempt(t, true)
+		code, err := txmClient.SendTransactionReturnCode(ctx, etx, attempt, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, commonclient.Successful, code)
+		require.Equal(t, []relayRequest{{Method: "eth_sendPrivateTransaction", Params: []map[string]any{{"tx": rawTx}}, Signer: signingAddress.Hex()}}, requests())
+	})
+
+	t.Run("classifies relay errors", func(t *testing.T) {
+		relayURL, _ := newTestRelay(t, "nonce too low")
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("IsL2").Return(false).Maybe()
+		txmClient := txmgr.NewEvmTxmClient(ethClient, nil)
+		txmClient.SetPrivateRelay(relayURL, toml.PrivateSubmissionMethodPrivateTransaction, nil, nil)
+
+		etx, attempt, _ := newSignedTestAttempt(t, true)
+		code, err := txmClient.SendTransactionReturnCode(ctx, etx, attempt, lggr)
+		require.ErrorContains(t, err, "nonce too low")
+		assert.Equal(t, commonclient.TransactionAlreadyKnown, code)
+	})
+
+	t.Run("broadcasts public transactions and private ones without relay publicly", func(t *testing.T) {
+		relayURL, requests := newTestRelay(t, "")
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("IsL2").Return(false).Maybe()
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.Anything, mock.Anything).Return(commonclient.Successful, nil).Twice()
+
+		withRelay := txmgr.NewEvmTxmClient(ethClient, nil)
+		withRelay.SetPrivateRelay(relayURL, toml.PrivateSubmissionMethodPrivateTransaction, nil, nil)
+		etx, attempt, _ := newSignedTestAttempt(t, false)
+		code, err := withRelay.SendTransactionReturnCode(ctx, etx, attempt, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, commonclient.Successful, code)
+
+		etx, attempt, _ = newSignedTestAttempt(t, true)
+		code, err = txmgr.NewEvmTxmClient(ethClient, nil).SendTransactionReturnCode(ctx, etx, attempt, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, commonclient.Successful, code)
+		assert.Empty(t, requests())
+	})
+
+	t.Run("resends private attempts through the relay only", func(t *testing.T) {
+		relayURL, requests := newTestRelay(t, "")
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("IsL2").Return(false).Maybe()
+		txmClient := txmgr.NewEvmTxmClient(ethClient, nil)
+		txmClient.SetPrivateRelay(relayURL, toml.PrivateSubmissionMethodPrivateTransaction, nil, nil)
+
+		_, private, privateRawTx := newSignedTestAttempt(t, true)
+		_, public, _ := newSignedTestAttempt(t, false)
+		public.TxID, public.Tx.ID = 2, 2
+		ethClient.On("BatchCallContextAll", mock.Anything, mock.MatchedBy(func(reqs []rpc.BatchElem) bool {
+			return len(reqs) == 1 && reqs[0].Method == "eth_sendRawTransaction"
+		})).Return(nil).Once()
+
+		codes, txErrs, _, successfulTxIDs, err := txmClient.BatchSendTransactions(ctx, []txmgr.TxAttempt{private, public}, 10, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, []commonclient.SendTxReturnCode{commonclient.Successful, commonclient.Successful}, codes)
+		assert.Equal(t, []error{nil, nil}, txErrs)
+		assert.ElementsMatch(t, []int64{1, 2}, successfulTxIDs)
+		require.Equal(t, []relayRequest{{Method: "eth_sendPrivateTransaction", Params: []map[string]any{{"tx": privateRawTx}}}}, requests())
+	})
+
+	t.Run("fails to send private transactions without latest block", func(t *testing.T) {
+		relayURL, requests := newTestRelay(t, "")
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		ethClient.On("IsL2").Return(false).Maybe()
+		ethClient.On("LatestBlockHeight", mock.Anything).Return(nil, errors.New("boom"))
+		txmClient := txmgr.NewEvmTxmClient(ethClient, nil)
+		txmClient.SetPrivateRelay(relayURL, toml.PrivateSubmissionMethodBundle, nil, nil)
+
+		etx, attempt, _ := newSignedTestAttempt(t, true)
+		code, err := txmClient.SendTransactionReturnCode(ctx, etx, attempt, lggr)
+		require.ErrorContains(t, err, "boom")
+		assert.Equal(t, commonclient.Retryable, code)
+		assert.Empty(t, requests())
+	})
+}
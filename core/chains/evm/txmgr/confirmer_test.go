@@ -1793,6 +1793,131 @@ func TestEthConfirmer_ProcessStuckTransactions(t *testing.T) {
 	})
 }
 
+func TestEthConfirmer_ProcessPastDeadlineTxs(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	ethClient.On("SendTransactionReturnCode", mock.Anything, mock.Anything, fromAddress).Return(commonclient.Successful, nil).Once()
+	lggr := logger.Test(t)
+	feeEstimator := gasmocks.NewEvmFeeEstimator(t)
+
+	marketGasPrice := tenGwei
+	bumpedFee := gas.EvmFee{GasPrice: assets.GWei(30)}
+	feeEstimator.On("BumpFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(bumpedFee, uint64(10_000), nil)
+	limitDefault := uint64(100)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].GasEstimator.LimitDefault = ptr(limitDefault)
+	})
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	ge := evmcfg.EVM().GasEstimator()
+	txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), ge, ethKeyStore, feeEstimator)
+	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), evmcfg.EVM().Transactions().AutoPurge(), feeEstimator, txStore, ethClient)
+	ht := headtracker.NewSimulatedHeadTracker(ethClient, true, 0)
+	ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmFeeConfig(ge), evmcfg.EVM().Transactions(), cfg.Database(), ethKeyStore, txBuilder, lggr, stuckTxDetector, ht)
+	ec.SetResumeCallback(func(context.Context, uuid.UUID, interface{}, error) error {
+		t.Fatal("No resume expected before the receipt of the cancel attempt")
+		return nil
+	})
+	servicetest.Run(t, ec)
+
+	ctx := tests.Context(t)
+	blockNum := int64(100)
+
+	t.Run("cancels transactions past their deadline", func(t *testing.T) {
+		tx := mustInsertUnconfirmedTxWithBroadcastAttempts(t, txStore, 0, fromAddress, 1, blockNum-1, marketGasPrice)
+		pgtest.MustExec(t, db, `UPDATE evm.txes SET pipeline_task_run_id = $1, signal_callback = TRUE, deadline_block_num = $2 WHERE id = $3`, uuid.New(), blockNum-1, tx.ID)
+		head := evmtypes.Head{
+			Hash:   testutils.NewHash(),
+			Number: blockNum,
+		}
+		head.IsFinalized.Store(true)
+
+		// Mined tx count does not increment since the transaction was not included
+		ethClient.On("NonceAt", mock.Anything, mock.Anything, mock.Anything).Return(uint64(0), nil).Once()
+
+		// First call to ProcessHead should create, save and send a cancel attempt for the transaction past its deadline
+		require.NoError(t, ec.ProcessHead(ctx, &head))
+
+		dbTx, err := txStore.FindTxWithAttempts(ctx, tx.ID)
+		require.NoError(t, err)
+		latestAttempt := dbTx.TxAttempts[0]
+		require.True(t, latestAttempt.IsCancelAttempt)
+		require.True(t, latestAttempt.IsPurgeAttempt)
+		require.Equal(t, limitDefault, latestAttempt.ChainSpecificFeeLimit)
+		require.Equal(t, bumpedFee.GasPrice, latestAttempt.TxFee.GasPrice)
+		require.False(t, dbTx.CallbackCompleted)
+
+		head = evmtypes.Head{
+			Hash:   testutils.NewHash(),
+			Number: blockNum + 1,
+		}
+		// Mined tx count incremented because of the cancel attempt
+		ethClient.On("NonceAt", mock.Anything, mock.Anything, mock.Anything).Return(uint64(1), nil)
+
+		// Second call to ProcessHead should mark the transaction as confirmed, since either attempt may have been included
+		// The Finalizer marks it as cancelled once the receipt of the cancel attempt is in
+		require.NoError(t, ec.ProcessHead(ctx, &head))
+		dbTx, err = txStore.FindTxWithAttempts(ctx, tx.ID)
+		require.NoError(t, err)
+		require.Equal(t, txmgrcommon.TxConfirmed, dbTx.State)
+		require.False(t, dbTx.CallbackCompleted)
+	})
+}
+
+func TestEthConfirmer_ProcessPrivateTxsPastFallback(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	lggr := logger.Test(t)
+	feeEstimator := gasmocks.NewEvmFeeEstimator(t)
+
+	fallbackBlocks := uint32(5)
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].Transactions.PrivateSubmission.FallbackBlocks = ptr(fallbackBlocks)
+	})
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	ge := evmcfg.EVM().GasEstimator()
+	txBuilder := txmgr.NewEvmTxAttemptBuilder(*ethClient.ConfiguredChainID(), ge, ethKeyStore, feeEstimator)
+	stuckTxDetector := txmgr.NewStuckTxDetector(lggr, testutils.FixtureChainID, "", assets.NewWei(assets.NewEth(100).ToInt()), evmcfg.EVM().Transactions().AutoPurge(), feeEstimator, txStore, ethClient)
+	ht := headtracker.NewSimulatedHeadTracker(ethClient, true, 0)
+	ec := txmgr.NewEvmConfirmer(txStore, txmgr.NewEvmTxmClient(ethClient, nil), txmgr.NewEvmTxmFeeConfig(ge), evmcfg.EVM().Transactions(), cfg.Database(), ethKeyStore, txBuilder, lggr, stuckTxDetector, ht)
+	servicetest.Run(t, ec)
+
+	ctx := tests.Context(t)
+	blockNum := int64(100)
+
+	tx := mustInsertUnconfirmedTxWithBroadcastAttempts(t, txStore, 0, fromAddress, 1, blockNum-int64(fallbackBlocks), tenGwei)
+	pgtest.MustExec(t, db, `UPDATE evm.txes SET private_submission = TRUE WHERE id = $1`, tx.ID)
+
+	t.Run("keeps private transactions private until the fallback blocks", func(t *testing.T) {
+		require.NoError(t, ec.ProcessPrivateTxsPastFallback(ctx, blockNum))
+
+		dbTx, err := txStore.FindTxWithAttempts(ctx, tx.ID)
+		require.NoError(t, err)
+		require.True(t, dbTx.PrivateSubmission)
+	})
+
+	t.Run("broadcasts private transactions publicly after the fallback blocks", func(t *testing.T) {
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.Anything, fromAddress).Return(commonclient.Successful, nil).Once()
+		require.NoError(t, ec.ProcessPrivateTxsPastFallback(ctx, blockNum+1))
+
+		dbTx, err := txStore.FindTxWithAttempts(ctx, tx.ID)
+		require.NoError(t, err)
+		require.False(t, dbTx.PrivateSubmission)
+
+		// Transactions already broadcast publicly are not sent again
+		require.NoError(t, ec.ProcessPrivateTxsPastFallback(ctx, blockNum+2))
+	})
+}
+
 func ptr[T any](t T) *T { return &t }
 
 func newEthConfirmer(t testing.TB, txStore txmgr.EvmTxStore, ethClient client.Client, gconfig chainlink.GeneralConfig, config evmconfig.ChainScopedConfig, ks keystore.Eth, fn txmgrcommon.ResumeCallback) *txmgr.Confirmer {
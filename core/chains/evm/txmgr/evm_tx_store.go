@@ -47,9 +47,12 @@ type EvmTxStore interface {
 	FindAttemptsRequiringReceiptFetch(ctx context.Context, chainID *big.Int) (hashes []TxAttempt, err error)
 	FindConfirmedTxesReceipts(ctx context.Context, finalizedBlockNum int64, chainID *big.Int) (receipts []*evmtypes.Receipt, err error)
 	FindTxesPendingCallback(ctx context.Context, latest, finalized int64, chainID *big.Int) (receiptsPlus []ReceiptPlus, err error)
-	FindTxesByIDs(ctx context.Context, etxIDs []int64, chainID *big.Int) (etxs []*Tx, err error)
+	FindFailedBatchedTxesPendingCallback(ctx context.Context, chainID *big.Int) (etxs []*Tx, err error)
+	FindNoncesInRange(ctx context.Context, fromAddress common.Address, from, to evmtypes.Nonce, chainID *big.Int) (nonces []evmtypes.Nonce, err error)
+	InsertNonceReconciliation(ctx context.Context, r *NonceReconciliation) error
 	SaveFetchedReceipts(ctx context.Context, r []*evmtypes.Receipt) (err error)
 	UpdateTxStatesToFinalizedUsingTxHashes(ctx context.Context, txHashes []common.Hash, chainID *big.Int) error
+	UpdateTxsCancelledUsingReceipts(ctx context.Context, chainID *big.Int) (etxs []*Tx, err error)
 }
 
 // TxStoreWebApi encapsulates the methods that are not used by the txmgr and only used by the various web controllers, readers, or evm specific components
@@ -62,6 +65,7 @@ type TxStoreWebApi interface {
 	FindTxAttempt(ctx context.Context, hash common.Hash) (*TxAttempt, error)
 	FindTxWithAttempts(ctx context.Context, etxID int64) (etx Tx, err error)
 	FindTxsByStateAndFromAddresses(ctx context.Context, addresses []common.Address, state txmgrtypes.TxState, chainID *big.Int) (txs []*Tx, err error)
+	NonceReconciliations(ctx context.Context, offset, limit int) ([]NonceReconciliation, int, error)
 }
 
 type TestEvmTxStore interface {
@@ -132,9 +136,12 @@ func (o *evmTxStore) new(q sqlutil.DataSource) *evmTxStore { return NewTxStore(q
 // Does not map to a single database table.
 // It's comprised of fields from different tables.
 type dbReceiptPlus struct {
-	ID           uuid.UUID        `db:"pipeline_task_run_id"`
-	Receipt      evmtypes.Receipt `db:"receipt"`
-	FailOnRevert bool             `db:"FailOnRevert"`
+	ID                    uuid.UUID        `db:"pipeline_task_run_id"`
+	Receipt               evmtypes.Receipt `db:"receipt"`
+	FailOnRevert          bool             `db:"FailOnRevert"`
+	BatchTxID             *int64           `db:"batch_tx_id"`
+	BatchCallIndex        *int32           `db:"batch_call_index"`
+	BatchCallAllowFailure bool             `db:"batch_call_allow_failure"`
 }
 
 func fromDBReceipts(rs []DbReceipt) []*evmtypes.Receipt {
@@ -149,9 +156,12 @@ func fromDBReceiptsPlus(rs []dbReceiptPlus) []ReceiptPlus {
 	receipts := make([]ReceiptPlus, len(rs))
 	for i := 0; i < len(rs); i++ {
 		receipts[i] = ReceiptPlus{
-			ID:           rs[i].ID,
-			Receipt:      &rs[i].Receipt,
-			FailOnRevert: rs[i].FailOnRevert,
+			ID:                    rs[i].ID,
+			Receipt:               &rs[i].Receipt,
+			FailOnRevert:          rs[i].FailOnRevert,
+			BatchTxID:             rs[i].BatchTxID,
+			BatchCallIndex:        rs[i].BatchCallIndex,
+			BatchCallAllowFailure: rs[i].BatchCallAllowFailure,
 		}
 	}
 	return receipts
@@ -201,6 +211,14 @@ type DbEthTx struct {
 	SignalCallback bool
 	// Marks tx callback as signaled
 	CallbackCompleted bool
+	// Deadline after which the tx is cancelled if it is not included yet
+	DeadlineAt       *time.Time
+	DeadlineBlockNum *int64
+	// Batch tx the call of this tx was aggregated into
+	BatchTxID      *int64
+	BatchCallIndex *int32
+	// Sent through the private relay
+	PrivateSubmission bool
 }
 
 func (db *DbEthTx) FromTx(tx *Tx) {
@@ -223,6 +241,11 @@ func (db *DbEthTx) FromTx(tx *Tx) {
 	db.InitialBroadcastAt = tx.InitialBroadcastAt
 	db.SignalCallback = tx.SignalCallback
 	db.CallbackCompleted = tx.CallbackCompleted
+	db.DeadlineAt = tx.Deadline.Time
+	db.DeadlineBlockNum = tx.Deadline.BlockNumber
+	db.BatchTxID = tx.BatchTxID
+	db.BatchCallIndex = tx.BatchCallIndex
+	db.PrivateSubmission = tx.PrivateSubmission
 
 	if tx.ChainID != nil {
 		db.EVMChainID = *ubig.New(tx.ChainID)
@@ -258,6 +281,10 @@ func (db DbEthTx) ToTx(tx *Tx) {
 	tx.InitialBroadcastAt = db.InitialBroadcastAt
 	tx.SignalCallback = db.SignalCallback
 	tx.CallbackCompleted = db.CallbackCompleted
+	tx.Deadline = txmgrtypes.TxDeadline{Time: db.DeadlineAt, BlockNumber: db.DeadlineBlockNum}
+	tx.BatchTxID = db.BatchTxID
+	tx.BatchCallIndex = db.BatchCallIndex
+	tx.PrivateSubmission = db.PrivateSubmission
 }
 
 func dbEthTxsToEvmEthTxs(dbEthTxs []DbEthTx) []Tx {
@@ -291,6 +318,7 @@ type DbEthTxAttempt struct {
 	GasTipCap               *assets.Wei
 	GasFeeCap               *assets.Wei
 	IsPurgeAttempt          bool
+	IsCancelAttempt         bool
 }
 
 func (db *DbEthTxAttempt) FromTxAttempt(attempt *TxAttempt) {
@@ -306,6 +334,7 @@ func (db *DbEthTxAttempt) FromTxAttempt(attempt *TxAttempt) {
 	db.GasTipCap = attempt.TxFee.GasTipCap
 	db.GasFeeCap = attempt.TxFee.GasFeeCap
 	db.IsPurgeAttempt = attempt.IsPurgeAttempt
+	db.IsCancelAttempt = attempt.IsCancelAttempt
 
 	// handle state naming difference between generic + EVM
 	if attempt.State == txmgrtypes.TxAttemptInsufficientFunds {
@@ -337,6 +366,7 @@ func (db DbEthTxAttempt) ToTxAttempt(attempt *TxAttempt) {
 		DynamicFee: gas.DynamicFee{GasTipCap: db.GasTipCap, GasFeeCap: db.GasFeeCap},
 	}
 	attempt.IsPurgeAttempt = db.IsPurgeAttempt
+	attempt.IsCancelAttempt = db.IsCancelAttempt
 }
 
 func dbEthTxAttemptsToEthTxAttempts(dbEthTxAttempt []DbEthTxAttempt) []TxAttempt {
@@ -360,8 +390,8 @@ func NewTxStore(
 }
 
 const insertIntoEthTxAttemptsQuery = `
-INSERT INTO evm.tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap, is_purge_attempt)
-VALUES (:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap, :is_purge_attempt)
+INSERT INTO evm.tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap, is_purge_attempt, is_cancel_attempt)
+VALUES (:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap, :is_purge_attempt, :is_cancel_attempt)
 RETURNING *;
 `
 
@@ -470,6 +500,18 @@ func (o *evmTxStore) TransactionsWithAttempts(ctx context.Context, offset, limit
 	return
 }
 
+// NonceReconciliations returns the last actions of the NonceReconciler sorted by created_at descending.
+func (o *evmTxStore) NonceReconciliations(ctx context.Context, offset, limit int) (rs []NonceReconciliation, count int, err error) {
+	sql := `SELECT count(*) FROM evm.nonce_reconciliations`
+	if err = o.q.GetContext(ctx, &count, sql); err != nil {
+		return
+	}
+
+	sql = `SELECT * FROM evm.nonce_reconciliations ORDER BY created_at DESC, id DESC LIMIT $1 OFFSET $2`
+	err = o.q.SelectContext(ctx, &rs, sql, limit, offset)
+	return
+}
+
 // TxAttempts returns the last tx attempts sorted by created_at descending.
 func (o *evmTxStore) TxAttempts(ctx context.Context, offset, limit int) (txs []TxAttempt, count int, err error) {
 	sql := `SELECT count(*) FROM evm.tx_attempts`
@@ -532,8 +574,8 @@ func (o *evmTxStore) InsertTx(ctx context.Context, etx *Tx) error {
 	if etx.CreatedAt == (time.Time{}) {
 		etx.CreatedAt = time.Now()
 	}
-	const insertEthTxSQL = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed) VALUES (
-:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed
+	const insertEthTxSQL = `INSERT INTO evm.txes (nonce, from_address, to_address, encoded_payload, value, gas_limit, error, broadcast_at, initial_broadcast_at, created_at, state, meta, subject, pipeline_task_run_id, min_confirmations, evm_chain_id, transmit_checker, idempotency_key, signal_callback, callback_completed, deadline_at, deadline_block_num, private_submission) VALUES (
+:nonce, :from_address, :to_address, :encoded_payload, :value, :gas_limit, :error, :broadcast_at, :initial_broadcast_at, :created_at, :state, :meta, :subject, :pipeline_task_run_id, :min_confirmations, :evm_chain_id, :transmit_checker, :idempotency_key, :signal_callback, :callback_completed, :deadline_at, :deadline_block_num, :private_submission
 ) RETURNING *`
 	var dbTx DbEthTx
 	dbTx.FromTx(etx)
@@ -975,6 +1017,8 @@ WHERE evm.tx_attempts.state = 'in_progress' AND evm.txes.from_address = $1 AND e
 }
 
 // Find confirmed txes requiring callback but have not yet been signaled
+// Batched txes are resumed with the receipt of their batch tx, and the result of their own call if it may differ from it
+// Cancelled txes are resumed once their cancel attempt is included, see UpdateTxsCancelledUsingReceipts
 func (o *evmTxStore) FindTxesPendingCallback(ctx context.Context, latest, finalized int64, chainID *big.Int) (receiptsPlus []ReceiptPlus, err error) {
 	var rs []dbReceiptPlus
 
@@ -982,16 +1026,21 @@ func (o *evmTxStore) FindTxesPendingCallback(ctx context.Context, latest, finali
 	ctx, cancel = o.stopCh.Ctx(ctx)
 	defer cancel()
 	err = o.q.SelectContext(ctx, &rs, `
-	SELECT evm.txes.pipeline_task_run_id, evm.receipts.receipt, COALESCE((evm.txes.meta->>'FailOnRevert')::boolean, false) "FailOnRevert" FROM evm.txes
-	INNER JOIN evm.tx_attempts ON evm.txes.id = evm.tx_attempts.eth_tx_id
+	SELECT evm.txes.pipeline_task_run_id, evm.receipts.receipt, COALESCE((evm.txes.meta->>'FailOnRevert')::boolean, false) "FailOnRevert",
+		evm.txes.batch_tx_id, evm.txes.batch_call_index, (batch_txes.to_address = $4 AND NOT COALESCE((evm.txes.meta->>'FailOnRevert')::boolean, false)) IS TRUE "batch_call_allow_failure"
+	FROM evm.txes
+	INNER JOIN evm.tx_attempts ON COALESCE(evm.txes.batch_tx_id, evm.txes.id) = evm.tx_attempts.eth_tx_id
 	INNER JOIN evm.receipts ON evm.tx_attempts.hash = evm.receipts.tx_hash
+	LEFT JOIN evm.txes batch_txes ON batch_txes.id = evm.txes.batch_tx_id
 	WHERE evm.txes.pipeline_task_run_id IS NOT NULL AND evm.txes.signal_callback = TRUE AND evm.txes.callback_completed = FALSE
+	AND NOT evm.tx_attempts.is_cancel_attempt
+	AND (evm.txes.batch_tx_id IS NULL OR evm.txes.state = 'batched')
 	AND (
 	    (evm.txes.min_confirmations IS NOT NULL AND evm.receipts.block_number <= ($1 - evm.txes.min_confirmations)) 
 		OR (evm.txes.min_confirmations IS NULL AND evm.receipts.block_number <= $2)
 	) 
   	AND evm.txes.evm_chain_id = $3
-	`, latest, finalized, chainID.String())
+	`, latest, finalized, chainID.String(), Multicall3Address)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve transactions pending pipeline resume callback: %w", err)
 	}
@@ -1020,6 +1069,17 @@ func (o *evmTxStore) FindLatestSequence(ctx context.Context, fromAddress common.
 	return
 }
 
+// FindNoncesInRange returns the nonces between from (inclusive) and to (exclusive) that are held by a transaction of
+// fromAddress, in ascending order.
+func (o *evmTxStore) FindNoncesInRange(ctx context.Context, fromAddress common.Address, from, to evmtypes.Nonce, chainID *big.Int) (nonces []evmtypes.Nonce, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	sql := `SELECT DISTINCT nonce FROM evm.txes WHERE from_address = $1 AND evm_chain_id = $2 AND nonce >= $3 AND nonce < $4 ORDER BY nonce ASC`
+	err = o.q.SelectContext(ctx, &nonces, sql, fromAddress, chainID.String(), from, to)
+	return
+}
+
 // FindTxWithIdempotencyKey returns any broadcast ethtx with the given idempotencyKey and chainID
 func (o *evmTxStore) FindTxWithIdempotencyKey(ctx context.Context, idempotencyKey string, chainID *big.Int) (etx *Tx, err error) {
 	var cancel context.CancelFunc
@@ -1339,6 +1399,57 @@ ORDER BY nonce ASC
 	return
 }
 
+// FindTxsPastDeadline returns the unconfirmed transactions whose deadline is before now or blockNum, and that do not
+// have a purge or cancel attempt yet
+func (o *evmTxStore) FindTxsPastDeadline(ctx context.Context, address common.Address, now time.Time, blockNum int64, chainID *big.Int) (etxs []*Tx, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	err = o.Transact(ctx, true, func(orm *evmTxStore) error {
+		stmt := `
+SELECT * FROM evm.txes
+WHERE state = 'unconfirmed' AND from_address = $1 AND evm_chain_id = $2
+	AND (deadline_at < $3 OR deadline_block_num < $4)
+	AND NOT EXISTS (SELECT 1 FROM evm.tx_attempts WHERE evm.tx_attempts.eth_tx_id = evm.txes.id AND evm.tx_attempts.is_purge_attempt)
+ORDER BY nonce ASC
+`
+		var dbEtxs []DbEthTx
+		if err = orm.q.SelectContext(ctx, &dbEtxs, stmt, address, chainID.String(), now, blockNum); err != nil {
+			return pkgerrors.Wrap(err, "FindTxsPastDeadline failed to load evm.txes")
+		}
+		etxs = make([]*Tx, len(dbEtxs))
+		dbEthTxsToEvmEthTxPtrs(dbEtxs, etxs)
+		err = orm.LoadTxesAttempts(ctx, etxs)
+		return pkgerrors.Wrap(err, "FindTxsPastDeadline failed to load evm.tx_attempts")
+	})
+	return
+}
+
+// FindPrivateTxsPastFallback returns the unconfirmed transactions sent through the private relay whose first attempt was
+// broadcast before blockNum
+func (o *evmTxStore) FindPrivateTxsPastFallback(ctx context.Context, address common.Address, blockNum int64, chainID *big.Int) (etxs []*Tx, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	err = o.Transact(ctx, true, func(orm *evmTxStore) error {
+		stmt := `
+SELECT * FROM evm.txes
+WHERE state = 'unconfirmed' AND private_submission AND from_address = $1 AND evm_chain_id = $2
+	AND (SELECT min(broadcast_before_block_num) FROM evm.tx_attempts WHERE evm.tx_attempts.eth_tx_id = evm.txes.id) < $3
+ORDER BY nonce ASC
+`
+		var dbEtxs []DbEthTx
+		if err = orm.q.SelectContext(ctx, &dbEtxs, stmt, address, chainID.String(), blockNum); err != nil {
+			return pkgerrors.Wrap(err, "FindPrivateTxsPastFallback failed to load evm.txes")
+		}
+		etxs = make([]*Tx, len(dbEtxs))
+		dbEthTxsToEvmEthTxPtrs(dbEtxs, etxs)
+		err = orm.LoadTxesAttempts(ctx, etxs)
+		return pkgerrors.Wrap(err, "FindPrivateTxsPastFallback failed to load evm.tx_attempts")
+	})
+	return
+}
+
 // FindTxsRequiringResubmissionDueToInsufficientFunds returns transactions
 // that need to be re-sent because they hit an out-of-eth error on a previous
 // block
@@ -1426,10 +1537,61 @@ func (o *evmTxStore) UpdateTxFatalErrorAndDeleteAttempts(ctx context.Context, et
 		dbEtx.FromTx(etx)
 		err := pkgerrors.Wrap(orm.q.GetContext(ctx, &dbEtx, `UPDATE evm.txes SET state=$1, error=$2, broadcast_at=NULL, initial_broadcast_at=NULL, nonce=NULL WHERE id=$3 RETURNING *`, etx.State, etx.Error, etx.ID), "saveFatallyErroredTransaction failed to save eth_tx")
 		dbEtx.ToTx(etx)
-		return err
+		if err != nil {
+			return err
+		}
+		_, err = orm.q.ExecContext(ctx, updateBatchedTxsFatalErrorSQL, pq.Array([]int64{etx.ID}), etx.Error)
+		return pkgerrors.Wrap(err, "saveFatallyErroredTransaction failed to save batched eth_txes")
+	})
+}
+
+func (o *evmTxStore) UpdateTxPrivateSubmissionFallback(ctx context.Context, etxID int64) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	_, err := o.q.ExecContext(ctx, `UPDATE evm.txes SET private_submission = false WHERE id = $1`, etxID)
+	return pkgerrors.Wrap(err, "UpdateTxPrivateSubmissionFallback failed to update evm.txes")
+}
+
+func (o *evmTxStore) UpdateTxSimulationFailed(ctx context.Context, etx *Tx) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	if !etx.Error.Valid {
+		return errors.New("expected error field to be set")
+	}
+
+	return o.Transact(ctx, false, func(orm *evmTxStore) error {
+		var dbEtx DbEthTx
+		err := orm.q.GetContext(ctx, &dbEtx, `UPDATE evm.txes SET state='simulation_failed', error=$1 WHERE id=$2 AND state='unstarted' RETURNING *`, etx.Error, etx.ID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return txmgr.ErrTxRemoved
+		} else if err != nil {
+			return pkgerrors.Wrap(err, "UpdateTxSimulationFailed failed to save eth_tx")
+		}
+		dbEtx.ToTx(etx)
+		_, err = orm.q.ExecContext(ctx, updateBatchedTxsFatalErrorSQL, pq.Array([]int64{etx.ID}), etx.Error)
+		return pkgerrors.Wrap(err, "UpdateTxSimulationFailed failed to save batched eth_txes")
 	})
 }
 
+// UpdateTxUnstartedToCancelled marks an unstarted tx as cancelled. It is never assigned a nonce, so there is nothing
+// to cancel on chain.
+func (o *evmTxStore) UpdateTxUnstartedToCancelled(ctx context.Context, etx *Tx) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	var dbEtx DbEthTx
+	err := o.q.GetContext(ctx, &dbEtx, `UPDATE evm.txes SET state='cancelled' WHERE id=$1 AND state='unstarted' RETURNING *`, etx.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return txmgr.ErrTxRemoved
+	} else if err != nil {
+		return pkgerrors.Wrap(err, "UpdateTxUnstartedToCancelled failed to save eth_tx")
+	}
+	dbEtx.ToTx(etx)
+	return nil
+}
+
 // Updates eth attempt from in_progress to broadcast. Also updates the eth tx to unconfirmed.
 func (o *evmTxStore) UpdateTxAttemptInProgressToBroadcast(ctx context.Context, etx *Tx, attempt TxAttempt, NewAttemptState txmgrtypes.TxAttemptState) error {
 	var cancel context.CancelFunc
@@ -1529,7 +1691,18 @@ func (o *evmTxStore) UpdateTxUnstartedToInProgress(ctx context.Context, etx *Tx,
 		dbAttempt.ToTxAttempt(attempt)
 		var dbEtx DbEthTx
 		dbEtx.FromTx(etx)
-		err = orm.q.GetContext(ctx, &dbEtx, `UPDATE evm.txes SET nonce=$1, state=$2, broadcast_at=$3, initial_broadcast_at=$4 WHERE id=$5 RETURNING *`, etx.Sequence, etx.State, etx.BroadcastAt, etx.InitialBroadcastAt, etx.ID)
+		// Batch txes may get more calls while unstarted, so the tx must still have the payload the attempt was built with.
+		// Otherwise the calls batched in the meantime would be marked as batched but never be sent.
+		payload := etx.EncodedPayload
+		if payload == nil {
+			payload = []byte{}
+		}
+		err = orm.q.GetContext(ctx, &dbEtx, `UPDATE evm.txes SET nonce=$1, state=$2, broadcast_at=$3, initial_broadcast_at=$4
+WHERE id=$5 AND state='unstarted' AND encoded_payload=$6 RETURNING *`, etx.Sequence, etx.State, etx.BroadcastAt, etx.InitialBroadcastAt, etx.ID, payload)
+		if errors.Is(err, sql.ErrNoRows) {
+			// The tx was removed or its payload changed since it was loaded, it is picked up again on the next run if it is still unstarted
+			return txmgr.ErrTxRemoved
+		}
 		dbEtx.ToTx(etx)
 		return pkgerrors.Wrap(err, "UpdateTxUnstartedToInProgress failed to update eth_tx")
 	})
@@ -1648,12 +1821,12 @@ func (o *evmTxStore) CreateTransaction(ctx context.Context, txRequest TxRequest,
 			}
 		}
 		err = orm.q.GetContext(ctx, &dbEtx, `
-INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key, signal_callback)
+INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, idempotency_key, signal_callback, deadline_at, deadline_block_num, private_submission)
 VALUES (
-$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13
+$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16
 )
 RETURNING "txes".*
-`, txRequest.FromAddress, txRequest.ToAddress, txRequest.EncodedPayload, assets.Eth(txRequest.Value), txRequest.FeeLimit, txRequest.Meta, txRequest.Strategy.Subject(), chainID.String(), txRequest.MinConfirmations, txRequest.PipelineTaskRunID, txRequest.Checker, txRequest.IdempotencyKey, txRequest.SignalCallback)
+`, txRequest.FromAddress, txRequest.ToAddress, txRequest.EncodedPayload, assets.Eth(txRequest.Value), txRequest.FeeLimit, txRequest.Meta, txRequest.Strategy.Subject(), chainID.String(), txRequest.MinConfirmations, txRequest.PipelineTaskRunID, txRequest.Checker, txRequest.IdempotencyKey, txRequest.SignalCallback, txRequest.Deadline.Time, txRequest.Deadline.BlockNumber, txRequest.PrivateSubmission)
 		if err != nil {
 			return pkgerrors.Wrap(err, "CreateEthTransaction failed to insert evm tx")
 		}
@@ -1692,6 +1865,132 @@ id < (
 	return
 }
 
+// updateBatchedTxsFatalErrorSQL marks the txes batched into the given batch txes as fatal error, with the error of
+// their batch tx
+const updateBatchedTxsFatalErrorSQL = `UPDATE evm.txes SET state = 'fatal_error', error = $2 WHERE batch_tx_id = ANY($1) AND state = 'batched'`
+
+// BatchUnstartedTx aggregates the call of the unstarted tx etxID into an unstarted batch tx of subject with calls left,
+// or else into a new batch tx with the other unstarted txes of subject to targets. Txes with a transmit checker or a
+// deadline, or sent privately, are never batched, since their batch tx would not honour them. Neither are txes
+// transferring value into forwarder batches.
+// Calls are only added to batch txes still unstarted, which are locked while their payload is rewritten. A batch tx
+// loaded by the Broadcaster before is not moved to in_progress with its stale payload, see UpdateTxUnstartedToInProgress.
+func (o *evmTxStore) BatchUnstartedTx(ctx context.Context, etxID int64, subject uuid.UUID, batchContract string, targets []string, maxBatchSize uint32) (batchTxID int64, batched bool, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	contract := common.HexToAddress(batchContract)
+	// Calls transferring value can only be batched by Multicall3
+	multicall := contract == Multicall3Address
+	targetAddresses := make([][]byte, len(targets))
+	for i, target := range targets {
+		targetAddresses[i] = common.HexToAddress(target).Bytes()
+	}
+	err = o.Transact(ctx, false, func(orm *evmTxStore) error {
+		var dbEtx DbEthTx
+		err := orm.q.GetContext(ctx, &dbEtx, `SELECT * FROM evm.txes WHERE id = $1 AND state = 'unstarted' AND to_address = ANY($2)
+AND transmit_checker IS NULL AND deadline_at IS NULL AND deadline_block_num IS NULL AND NOT private_submission AND ($3 OR value = 0) FOR UPDATE`, etxID, pq.Array(targetAddresses), multicall)
+		if errors.Is(err, sql.ErrNoRows) {
+			// The tx can't be batched, or was already picked up by the Broadcaster
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to load evm.tx: %w", err)
+		}
+		var etx Tx
+		dbEtx.ToTx(&etx)
+
+		var dbBatchEtx DbEthTx
+		err = orm.q.GetContext(ctx, &dbBatchEtx, `
+SELECT * FROM evm.txes
+WHERE state = 'unstarted' AND subject = $1 AND from_address = $2 AND to_address = $3 AND evm_chain_id = $4
+	AND (SELECT count(*) FROM evm.txes calls WHERE calls.batch_tx_id = evm.txes.id) BETWEEN 1 AND $5 - 1
+ORDER BY id ASC LIMIT 1 FOR UPDATE
+`, subject, etx.FromAddress, contract, dbEtx.EVMChainID.String(), maxBatchSize)
+		if err == nil {
+			// Add the call to the existing batch tx
+			var dbCalls []DbEthTx
+			if err = orm.q.SelectContext(ctx, &dbCalls, `SELECT * FROM evm.txes WHERE batch_tx_id = $1 ORDER BY batch_call_index ASC`, dbBatchEtx.ID); err != nil {
+				return fmt.Errorf("failed to load batched evm.txes: %w", err)
+			}
+			batchTxID = dbBatchEtx.ID
+			batched = true
+			return orm.saveBatch(ctx, batchTxID, contract, append(dbEthTxsToEvmEthTxs(dbCalls), etx))
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to load batch evm.tx: %w", err)
+		}
+
+		// Start a new batch tx with the other unstarted txes to batch
+		var dbCalls []DbEthTx
+		err = orm.q.SelectContext(ctx, &dbCalls, `
+SELECT * FROM evm.txes
+WHERE state = 'unstarted' AND subject = $1 AND from_address = $2 AND evm_chain_id = $3 AND id <> $4 AND to_address = ANY($5)
+	AND transmit_checker IS NULL AND deadline_at IS NULL AND deadline_block_num IS NULL AND NOT private_submission AND ($7 OR value = 0)
+	AND NOT EXISTS (SELECT 1 FROM evm.txes calls WHERE calls.batch_tx_id = evm.txes.id)
+ORDER BY id ASC LIMIT $6 FOR UPDATE
+`, subject, etx.FromAddress, dbEtx.EVMChainID.String(), etx.ID, pq.Array(targetAddresses), maxBatchSize-1, multicall)
+		if err != nil {
+			return fmt.Errorf("failed to load unstarted evm.txes to batch: %w", err)
+		}
+		if len(dbCalls) == 0 {
+			return nil
+		}
+		calls := append(dbEthTxsToEvmEthTxs(dbCalls), etx)
+		// The batch tx takes the place of its oldest call in the queue
+		err = orm.q.GetContext(ctx, &batchTxID, `
+INSERT INTO evm.txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, subject, evm_chain_id)
+VALUES ($1, $2, '\x', 0, 0, 'unstarted', $3, $4, $5)
+RETURNING id
+`, etx.FromAddress, contract, calls[0].CreatedAt, subject, dbEtx.EVMChainID.String())
+		if err != nil {
+			return fmt.Errorf("failed to insert batch evm.tx: %w", err)
+		}
+		batched = true
+		return orm.saveBatch(ctx, batchTxID, contract, calls)
+	})
+	return batchTxID, batched, err
+}
+
+// saveBatch encodes calls into the batch tx batchTxID to contract, and marks them as batched into it
+func (o *evmTxStore) saveBatch(ctx context.Context, batchTxID int64, contract common.Address, calls []Tx) error {
+	payload, value, gasLimit, err := encodeBatch(contract, calls)
+	if err != nil {
+		return err
+	}
+	if _, err = o.q.ExecContext(ctx, `UPDATE evm.txes SET encoded_payload = $2, value = $3, gas_limit = $4 WHERE id = $1`, batchTxID, payload, assets.Eth(*value), gasLimit); err != nil {
+		return fmt.Errorf("failed to update batch evm.tx: %w", err)
+	}
+	ids := make([]int64, len(calls))
+	for i, call := range calls {
+		ids[i] = call.ID
+	}
+	_, err = o.q.ExecContext(ctx, `
+UPDATE evm.txes SET state = 'batched', batch_tx_id = $1, batch_call_index = calls.index - 1
+FROM unnest($2::bigint[]) WITH ORDINALITY AS calls(id, index)
+WHERE evm.txes.id = calls.id
+`, batchTxID, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("failed to mark evm.txes as batched: %w", err)
+	}
+	return nil
+}
+
+// FindFailedBatchedTxesPendingCallback returns the txes requiring callback whose batch tx failed, and that have not
+// been signaled yet
+func (o *evmTxStore) FindFailedBatchedTxesPendingCallback(ctx context.Context, chainID *big.Int) (etxs []*Tx, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	var dbEtxs []DbEthTx
+	err = o.q.SelectContext(ctx, &dbEtxs, `SELECT * FROM evm.txes WHERE state = 'fatal_error' AND batch_tx_id IS NOT NULL
+AND pipeline_task_run_id IS NOT NULL AND signal_callback = TRUE AND callback_completed = FALSE AND evm_chain_id = $1`, chainID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve failed batched transactions pending pipeline resume callback: %w", err)
+	}
+	etxs = make([]*Tx, len(dbEtxs))
+	dbEthTxsToEvmEthTxPtrs(dbEtxs, etxs)
+	return etxs, nil
+}
+
 func (o *evmTxStore) ReapTxHistory(ctx context.Context, timeThreshold time.Time, chainID *big.Int) error {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
@@ -1727,15 +2026,38 @@ AND evm_chain_id = $3`, limit, timeThreshold, chainID.String())
 	if err != nil {
 		return pkgerrors.Wrap(err, "TxmReaper#reapEthTxes batch delete of finalized evm.txes failed")
 	}
-	// Delete old 'fatal_error' evm.txes
+	// Delete old 'fatal_error', 'cancelled' and 'simulation_failed' evm.txes
 	err = sqlutil.Batch(func(_, limit uint) (count uint, err error) {
 		res, err := o.q.ExecContext(ctx, `
 DELETE FROM evm.txes
 WHERE created_at < $1
-AND state = 'fatal_error'
+AND state IN ('fatal_error', 'cancelled', 'simulation_failed')
 AND evm_chain_id = $2`, timeThreshold, chainID.String())
 		if err != nil {
-			return count, pkgerrors.Wrap(err, "ReapTxes failed to delete old fatally errored evm.txes")
+			return count, pkgerrors.Wrap(err, "ReapTxes failed to delete old fatally errored, cancelled or simulation failed evm.txes")
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return count, pkgerrors.Wrap(err, "ReapTxes failed to get rows affected")
+		}
+		return uint(rowsAffected), err
+	}, batchSize)
+	if err != nil {
+		return pkgerrors.Wrap(err, "TxmReaper#reapEthTxes batch delete of fatally errored, cancelled or simulation failed evm.txes failed")
+	}
+	// Delete old 'batched' evm.txes whose batch tx was finalized
+	// They are also deleted along with their batch tx, but the batch tx may be reaped later since it needs a receipt
+	err = sqlutil.Batch(func(_, limit uint) (count uint, err error) {
+		res, err := o.q.ExecContext(ctx, `
+DELETE FROM evm.txes
+USING evm.txes batch_txes
+WHERE evm.txes.batch_tx_id = batch_txes.id
+AND evm.txes.created_at < $1
+AND evm.txes.state = 'batched'
+AND batch_txes.state = 'finalized'
+AND evm.txes.evm_chain_id = $2`, timeThreshold, chainID.String())
+		if err != nil {
+			return count, pkgerrors.Wrap(err, "ReapTxes failed to delete old batched evm.txes")
 		}
 		rowsAffected, err := res.RowsAffected()
 		if err != nil {
@@ -1744,7 +2066,7 @@ AND evm_chain_id = $2`, timeThreshold, chainID.String())
 		return uint(rowsAffected), err
 	}, batchSize)
 	if err != nil {
-		return pkgerrors.Wrap(err, "TxmReaper#reapEthTxes batch delete of fatally errored evm.txes failed")
+		return pkgerrors.Wrap(err, "TxmReaper#reapEthTxes batch delete of batched evm.txes failed")
 	}
 	// Delete old 'confirmed' evm.txes that were never finalized
 	// This query should never result in changes but added just in case transactions slip through the cracks
@@ -1907,7 +2229,7 @@ func (o *evmTxStore) UpdateTxAttemptBroadcastBeforeBlockNum(ctx context.Context,
 	return err
 }
 
-// FindAttemptsRequiringReceiptFetch returns all broadcasted attempts for confirmed or terminally stuck transactions that do not have receipts stored in the DB
+// FindAttemptsRequiringReceiptFetch returns all broadcasted attempts for confirmed, cancelled or terminally stuck transactions that do not have receipts stored in the DB
 func (o *evmTxStore) FindAttemptsRequiringReceiptFetch(ctx context.Context, chainID *big.Int) (attempts []TxAttempt, err error) {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
@@ -1916,11 +2238,11 @@ func (o *evmTxStore) FindAttemptsRequiringReceiptFetch(ctx context.Context, chai
 	query := `
 		SELECT evm.tx_attempts.* FROM evm.tx_attempts
 		JOIN evm.txes ON evm.txes.ID = evm.tx_attempts.eth_tx_id
-		WHERE evm.tx_attempts.state = 'broadcast' AND evm.txes.state IN ('confirmed', 'confirmed_missing_receipt', 'fatal_error') AND evm.txes.evm_chain_id = $1 AND evm.txes.ID NOT IN (
+		WHERE evm.tx_attempts.state = 'broadcast' AND evm.txes.state IN ('confirmed', 'confirmed_missing_receipt', 'fatal_error', 'cancelled') AND evm.txes.evm_chain_id = $1 AND evm.txes.ID NOT IN (
 			SELECT DISTINCT evm.txes.ID FROM evm.txes
 			JOIN evm.tx_attempts ON evm.tx_attempts.eth_tx_id = evm.txes.ID
 			JOIN evm.receipts ON evm.receipts.tx_hash = evm.tx_attempts.hash
-			WHERE evm.txes.evm_chain_id = $1 AND evm.txes.state IN ('confirmed', 'confirmed_missing_receipt', 'fatal_error') AND evm.receipts.ID IS NOT NULL
+			WHERE evm.txes.evm_chain_id = $1 AND evm.txes.state IN ('confirmed', 'confirmed_missing_receipt', 'fatal_error', 'cancelled') AND evm.receipts.ID IS NOT NULL
 		)
 		ORDER BY evm.txes.nonce ASC, evm.tx_attempts.gas_price DESC, evm.tx_attempts.gas_tip_cap DESC
 	`
@@ -1983,7 +2305,7 @@ func (o *evmTxStore) FindReorgOrIncludedTxs(ctx context.Context, fromAddress com
 	defer cancel()
 	err = o.Transact(ctx, true, func(orm *evmTxStore) error {
 		var dbReOrgEtxs []DbEthTx
-		query := `SELECT * FROM evm.txes WHERE from_address = $1 AND state IN ('confirmed', 'confirmed_missing_receipt', 'fatal_error', 'finalized') AND nonce >= $2 AND evm_chain_id = $3`
+		query := `SELECT * FROM evm.txes WHERE from_address = $1 AND state IN ('confirmed', 'confirmed_missing_receipt', 'fatal_error', 'finalized', 'cancelled') AND nonce >= $2 AND evm_chain_id = $3`
 		err = o.q.SelectContext(ctx, &dbReOrgEtxs, query, fromAddress, minedTxCount.Int64(), chainID.String())
 		// If re-org'd transactions found, populate them with attempts and partial receipts, then return since new transactions could not have been included
 		if len(dbReOrgEtxs) > 0 {
@@ -2029,13 +2351,38 @@ func (o *evmTxStore) UpdateTxConfirmed(ctx context.Context, etxIDs []int64) erro
 	return err
 }
 
+// UpdateTxsCancelledUsingReceipts marks the confirmed transactions whose receipt is for their cancel attempt as
+// cancelled, and returns them
+func (o *evmTxStore) UpdateTxsCancelledUsingReceipts(ctx context.Context, chainID *big.Int) (etxs []*Tx, err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	var dbEtxs []DbEthTx
+	err = o.q.SelectContext(ctx, &dbEtxs, `
+UPDATE evm.txes SET state = 'cancelled' FROM evm.tx_attempts, evm.receipts
+WHERE evm.tx_attempts.eth_tx_id = evm.txes.id AND evm.receipts.tx_hash = evm.tx_attempts.hash
+	AND evm.tx_attempts.is_cancel_attempt AND evm.txes.state = 'confirmed' AND evm.txes.evm_chain_id = $1
+RETURNING evm.txes.*`, chainID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to update cancelled transactions: %w", err)
+	}
+	etxs = make([]*Tx, len(dbEtxs))
+	dbEthTxsToEvmEthTxPtrs(dbEtxs, etxs)
+	return etxs, nil
+}
+
 func (o *evmTxStore) UpdateTxFatalError(ctx context.Context, etxIDs []int64, errMsg string) error {
 	var cancel context.CancelFunc
 	ctx, cancel = o.stopCh.Ctx(ctx)
 	defer cancel()
-	sql := `UPDATE evm.txes SET state = 'fatal_error', error = $1 WHERE id = ANY($2)`
-	_, err := o.q.ExecContext(ctx, sql, errMsg, pq.Array(etxIDs))
-	return err
+	return o.Transact(ctx, false, func(orm *evmTxStore) error {
+		sql := `UPDATE evm.txes SET state = 'fatal_error', error = $1 WHERE id = ANY($2)`
+		if _, err := orm.q.ExecContext(ctx, sql, errMsg, pq.Array(etxIDs)); err != nil {
+			return err
+		}
+		_, err := orm.q.ExecContext(ctx, updateBatchedTxsFatalErrorSQL, pq.Array(etxIDs), errMsg)
+		return err
+	})
 }
 
 func (o *evmTxStore) FindTxesByIDs(ctx context.Context, etxIDs []int64, chainID *big.Int) (etxs []*Tx, err error) {
@@ -2051,3 +2398,14 @@ func (o *evmTxStore) FindTxesByIDs(ctx context.Context, etxIDs []int64, chainID
 	dbEthTxsToEvmEthTxPtrs(dbEtxs, etxs)
 	return
 }
+
+// InsertNonceReconciliation records an action of the NonceReconciler, and sets the ID and CreatedAt of r.
+func (o *evmTxStore) InsertNonceReconciliation(ctx context.Context, r *NonceReconciliation) error {
+	var cancel context.CancelFunc
+	ctx, cancel = o.stopCh.Ctx(ctx)
+	defer cancel()
+	sql := `INSERT INTO evm.nonce_reconciliations (evm_chain_id, address, action, nonce, local_nonce, mined_nonce, pending_nonce, tx_hash, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW()) RETURNING id, created_at`
+	err := o.q.QueryRowxContext(ctx, sql, r.EVMChainID, r.Address, r.Action, r.Nonce, r.LocalNonce, r.MinedNonce, r.PendingNonce, r.TxHash).Scan(&r.ID, &r.CreatedAt)
+	return pkgerrors.Wrap(err, "InsertNonceReconciliation failed to insert evm.nonce_reconciliations")
+}
@@ -27,6 +27,8 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/authorized_forwarder"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/evmtest"
@@ -1010,6 +1012,125 @@ func TestORM_FindTxsRequiringGasBump(t *testing.T) {
 	})
 }
 
+func TestORM_FindPrivateTxsPastFallback(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	privateEtx := mustInsertUnconfirmedEthTxWithAttemptState(t, txStore, 1, fromAddress, txmgrtypes.TxAttemptBroadcast)
+	pgtest.MustExec(t, db, `UPDATE evm.txes SET private_submission = TRUE WHERE id = $1`, privateEtx.ID)
+	mustInsertUnconfirmedEthTxWithAttemptState(t, txStore, 2, fromAddress, txmgrtypes.TxAttemptBroadcast)
+	require.NoError(t, txStore.SetBroadcastBeforeBlockNum(ctx, 10, ethClient.ConfiguredChainID()))
+
+	t.Run("ignores private txs first broadcast after block", func(t *testing.T) {
+		etxs, err := txStore.FindPrivateTxsPastFallback(ctx, fromAddress, 10, ethClient.ConfiguredChainID())
+		require.NoError(t, err)
+		assert.Empty(t, etxs)
+	})
+
+	t.Run("finds private txs first broadcast before block", func(t *testing.T) {
+		etxs, err := txStore.FindPrivateTxsPastFallback(ctx, fromAddress, 11, ethClient.ConfiguredChainID())
+		require.NoError(t, err)
+		require.Len(t, etxs, 1)
+		assert.Equal(t, privateEtx.ID, etxs[0].ID)
+		assert.True(t, etxs[0].PrivateSubmission)
+		assert.Len(t, etxs[0].TxAttempts, 1)
+	})
+
+	t.Run("ignores txs that fell back to public broadcast", func(t *testing.T) {
+		require.NoError(t, txStore.UpdateTxPrivateSubmissionFallback(ctx, privateEtx.ID))
+		etxs, err := txStore.FindPrivateTxsPastFallback(ctx, fromAddress, 11, ethClient.ConfiguredChainID())
+		require.NoError(t, err)
+		assert.Empty(t, etxs)
+	})
+}
+
+func TestORM_FindNoncesInRange(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	_, otherAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	mustInsertConfirmedEthTxWithReceipt(t, txStore, fromAddress, 1, 1)
+	mustInsertUnconfirmedEthTxWithAttemptState(t, txStore, 3, fromAddress, txmgrtypes.TxAttemptBroadcast)
+	mustInsertInProgressEthTxWithAttempt(t, txStore, 4, fromAddress)
+	mustInsertUnconfirmedEthTxWithAttemptState(t, txStore, 6, fromAddress, txmgrtypes.TxAttemptBroadcast)
+	mustInsertUnconfirmedEthTxWithAttemptState(t, txStore, 2, otherAddress, txmgrtypes.TxAttemptBroadcast)
+
+	nonces, err := txStore.FindNoncesInRange(ctx, fromAddress, 1, 6, ethClient.ConfiguredChainID())
+	require.NoError(t, err)
+	assert.Equal(t, []evmtypes.Nonce{1, 3, 4}, nonces)
+
+	nonces, err = txStore.FindNoncesInRange(ctx, fromAddress, 7, 10, ethClient.ConfiguredChainID())
+	require.NoError(t, err)
+	assert.Empty(t, nonces)
+}
+
+func TestORM_NonceReconciliations(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	address := testutils.NewAddress()
+	txHash := utils.NewHash()
+
+	resync := txmgr.NonceReconciliation{
+		EVMChainID:   *ubig.New(ethClient.ConfiguredChainID()),
+		Address:      address,
+		Action:       txmgr.NonceReconciliationResync,
+		Nonce:        8,
+		LocalNonce:   5,
+		MinedNonce:   7,
+		PendingNonce: 8,
+	}
+	require.NoError(t, txStore.InsertNonceReconciliation(ctx, &resync))
+	assert.NotZero(t, resync.ID)
+	assert.False(t, resync.CreatedAt.IsZero())
+
+	fillGap := txmgr.NonceReconciliation{
+		EVMChainID:   *ubig.New(ethClient.ConfiguredChainID()),
+		Address:      address,
+		Action:       txmgr.NonceReconciliationFillGap,
+		Nonce:        9,
+		LocalNonce:   11,
+		MinedNonce:   9,
+		PendingNonce: 9,
+		TxHash:       &txHash,
+	}
+	require.NoError(t, txStore.InsertNonceReconciliation(ctx, &fillGap))
+
+	rs, count, err := txStore.NonceReconciliations(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, rs, 2)
+	assert.Equal(t, fillGap.ID, rs[0].ID)
+	assert.Equal(t, txmgr.NonceReconciliationFillGap, rs[0].Action)
+	require.NotNil(t, rs[0].TxHash)
+	assert.Equal(t, txHash, *rs[0].TxHash)
+	assert.Equal(t, resync.ID, rs[1].ID)
+	assert.Equal(t, address, rs[1].Address)
+	assert.Equal(t, evmtypes.Nonce(8), rs[1].Nonce)
+	assert.Nil(t, rs[1].TxHash)
+
+	rs, count, err = txStore.NonceReconciliations(ctx, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, rs, 1)
+	assert.Equal(t, resync.ID, rs[0].ID)
+}
+
 func TestEthConfirmer_FindTxsRequiringResubmissionDueToInsufficientEth(t *testing.T) {
 	t.Parallel()
 
@@ -1266,6 +1387,24 @@ func TestORM_UpdateTxUnstartedToInProgress(t *testing.T) {
 		require.ErrorContains(t, err, "tx removed")
 	})
 
+	t.Run("update fails because tx payload changed", func(t *testing.T) {
+		etx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
+		etx.Sequence = &nonce
+
+		attempt := cltest.NewLegacyEthTxAttempt(t, etx.ID)
+
+		// A call was added to the batch tx after the attempt was built
+		pgtest.MustExec(t, db, `UPDATE evm.txes SET encoded_payload = '\x01' WHERE id = $1`, etx.ID)
+
+		err := txStore.UpdateTxUnstartedToInProgress(tests.Context(t), &etx, &attempt)
+		require.ErrorIs(t, err, txmgrcommon.ErrTxRemoved)
+
+		etx, err = txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnstarted, etx.State)
+		assert.Empty(t, etx.TxAttempts)
+	})
+
 	db = pgtest.NewSqlxDB(t)
 	txStore = cltest.NewTestTxStore(t, db)
 	ethKeyStore = cltest.NewKeyStore(t, db).Eth()
@@ -1286,7 +1425,7 @@ func TestORM_UpdateTxUnstartedToInProgress(t *testing.T) {
 		evmTxmCfg := txmgr.NewEvmTxmConfig(ccfg.EVM())
 		ec := evmtest.NewEthClientMockWithDefaultChain(t)
 		txMgr := txmgr.NewEvmTxm(ec.ConfiguredChainID(), evmTxmCfg, ccfg.EVM().Transactions(), nil, logger.Test(t), nil, nil,
-			nil, txStore, nil, nil, nil, nil, nil)
+			nil, txStore, nil, nil, nil, nil, nil, nil)
 		err := txMgr.XXXTestAbandon(fromAddress) // mark transaction as abandoned
 		require.NoError(t, err)
 
@@ -1747,6 +1886,127 @@ func TestORM_PruneUnstartedTxQueue(t *testing.T) {
 	})
 }
 
+func TestORM_BatchUnstartedTx(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	ctx := tests.Context(t)
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	target := testutils.NewAddress()
+	subject := uuid.New()
+	strategy := txmgr.NewMulticall3BatchStrategy(subject, 3, []common.Address{target})
+	toTarget := func(tx *txmgr.TxRequest) { tx.ToAddress = target }
+	batch := func(etx txmgr.Tx) (int64, bool) {
+		batchTxID, batched, err := strategy.BatchTx(ctx, txStore, etx.ID, etx.ToAddress.String())
+		require.NoError(t, err)
+		return batchTxID, batched
+	}
+
+	etx1 := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, txRequestWithStrategy(strategy), toTarget)
+	_, batched := batch(etx1)
+	assert.False(t, batched, "nothing to batch with")
+
+	etx2 := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, txRequestWithStrategy(strategy), toTarget)
+	batchTxID, batched := batch(etx2)
+	require.True(t, batched)
+
+	etx3 := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, txRequestWithStrategy(strategy), toTarget)
+	batchTxID3, batched := batch(etx3)
+	require.True(t, batched)
+	assert.Equal(t, batchTxID, batchTxID3, "added to the batch tx with calls left")
+
+	etx4 := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, txRequestWithStrategy(strategy), toTarget)
+	_, batched = batch(etx4)
+	assert.False(t, batched, "the batch tx is full")
+
+	batchTx, err := txStore.FindTxWithAttempts(ctx, batchTxID)
+	require.NoError(t, err)
+	assert.Equal(t, txmgrcommon.TxUnstarted, batchTx.State)
+	assert.Equal(t, txmgr.Multicall3Address, batchTx.ToAddress)
+	assert.Equal(t, etx1.FeeLimit*3, batchTx.FeeLimit)
+	assert.Equal(t, etx1.CreatedAt.Unix(), batchTx.CreatedAt.Unix())
+
+	etxs, err := txStore.FindTxesByIDs(ctx, []int64{etx1.ID, etx2.ID, etx3.ID, etx4.ID}, testutils.FixtureChainID)
+	require.NoError(t, err)
+	require.Len(t, etxs, 4)
+	for i, etx := range etxs[:3] {
+		assert.Equal(t, txmgrcommon.TxBatched, etx.State)
+		require.NotNil(t, etx.BatchTxID)
+		assert.Equal(t, batchTxID, *etx.BatchTxID)
+		require.NotNil(t, etx.BatchCallIndex)
+		assert.Equal(t, int32(i), *etx.BatchCallIndex)
+	}
+	assert.Equal(t, txmgrcommon.TxUnstarted, etxs[3].State)
+
+	t.Run("fatal errors of the batch tx are propagated to its calls", func(t *testing.T) {
+		require.NoError(t, txStore.UpdateTxFatalError(ctx, []int64{batchTxID}, "boom"))
+		etxs, err := txStore.FindTxesByIDs(ctx, []int64{etx1.ID, etx2.ID, etx3.ID}, testutils.FixtureChainID)
+		require.NoError(t, err)
+		for _, etx := range etxs {
+			assert.Equal(t, txmgrcommon.TxFatalError, etx.State)
+			assert.Equal(t, "boom", etx.Error.String)
+		}
+	})
+}
+
+func TestORM_BatchUnstartedTx_Forwarder(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	ctx := tests.Context(t)
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	forwarder := testutils.NewAddress()
+	subject := uuid.New()
+	strategy := txmgr.NewForwarderBatchStrategy(subject, 3, forwarder)
+	forwarderABI, err := authorized_forwarder.AuthorizedForwarderMetaData.GetAbi()
+	require.NoError(t, err)
+	dests := []common.Address{testutils.NewAddress(), testutils.NewAddress()}
+	datas := [][]byte{{0x01}, {0x02}}
+	forwardTo := func(i int) func(*txmgr.TxRequest) {
+		return func(tx *txmgr.TxRequest) {
+			tx.ToAddress = forwarder
+			tx.EncodedPayload, err = forwarderABI.Pack("forward", dests[i], datas[i])
+			require.NoError(t, err)
+		}
+	}
+	batch := func(etx txmgr.Tx) (int64, bool) {
+		batchTxID, batched, err := strategy.BatchTx(ctx, txStore, etx.ID, etx.ToAddress.String())
+		require.NoError(t, err)
+		return batchTxID, batched
+	}
+
+	etx1 := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, txRequestWithStrategy(strategy), forwardTo(0))
+	_, batched := batch(etx1)
+	assert.False(t, batched, "nothing to batch with")
+
+	withValue := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, txRequestWithStrategy(strategy), forwardTo(1), txRequestWithValue(*big.NewInt(1)))
+	_, batched = batch(withValue)
+	assert.False(t, batched, "value cannot be forwarded")
+
+	etx2 := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, txRequestWithStrategy(strategy), forwardTo(1))
+	batchTxID, batched := batch(etx2)
+	require.True(t, batched)
+
+	batchTx, err := txStore.FindTxWithAttempts(ctx, batchTxID)
+	require.NoError(t, err)
+	assert.Equal(t, forwarder, batchTx.ToAddress)
+	assert.Zero(t, batchTx.Value.Sign())
+	expectedPayload, err := forwarderABI.Pack("multiForward", dests, datas)
+	require.NoError(t, err)
+	assert.Equal(t, expectedPayload, batchTx.EncodedPayload)
+
+	etxs, err := txStore.FindTxesByIDs(ctx, []int64{etx1.ID, withValue.ID, etx2.ID}, testutils.FixtureChainID)
+	require.NoError(t, err)
+	require.Len(t, etxs, 3)
+	assert.Equal(t, txmgrcommon.TxBatched, etxs[0].State)
+	assert.Equal(t, txmgrcommon.TxUnstarted, etxs[1].State)
+	assert.Equal(t, txmgrcommon.TxBatched, etxs[2].State)
+}
+
 func TestORM_FindTxesWithAttemptsAndReceiptsByIdsAndState(t *testing.T) {
 	t.Parallel()
 
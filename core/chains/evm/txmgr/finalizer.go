@@ -22,8 +22,10 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mailbox"
 
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/shared/generated/multicall3"
 )
 
 var _ Finalizer = (*evmFinalizer)(nil)
@@ -49,6 +51,14 @@ var (
 		Name: "tx_manager_num_finalized_transactions",
 		Help: "Total number of finalized transactions",
 	}, []string{"chainID"})
+	promNumCancelledTxs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_num_cancelled_transactions",
+		Help: "Total number of transactions cancelled after their deadline.",
+	}, []string{"chainID"})
+	promNumSafeTxs = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tx_manager_num_safe_transactions",
+		Help: "Number of confirmed, unfinalized transactions whose receipt is in the chain up to the latest safe block",
+	}, []string{"chainID"})
 )
 
 var (
@@ -60,17 +70,23 @@ var (
 // processHeadTimeout represents a sanity limit on how long ProcessHead should take to complete
 const processHeadTimeout = 10 * time.Minute
 
+// maxBatchCallResultMisses is the number of heads on which the call results of a successful batch tx can fail to be
+// fetched before the pending task runs of its calls are resumed with an error
+const maxBatchCallResultMisses = 20
+
 type finalizerTxStore interface {
 	DeleteReceiptByTxHash(ctx context.Context, txHash common.Hash) error
 	FindAttemptsRequiringReceiptFetch(ctx context.Context, chainID *big.Int) (hashes []TxAttempt, err error)
 	FindConfirmedTxesReceipts(ctx context.Context, finalizedBlockNum int64, chainID *big.Int) (receipts []*evmtypes.Receipt, err error)
 	FindTxesPendingCallback(ctx context.Context, latest, finalized int64, chainID *big.Int) (receiptsPlus []ReceiptPlus, err error)
+	FindFailedBatchedTxesPendingCallback(ctx context.Context, chainID *big.Int) (etxs []*Tx, err error)
 	FindTxesByIDs(ctx context.Context, etxIDs []int64, chainID *big.Int) (etxs []*Tx, err error)
 	PreloadTxes(ctx context.Context, attempts []TxAttempt) error
 	SaveFetchedReceipts(ctx context.Context, r []*evmtypes.Receipt) (err error)
 	UpdateTxCallbackCompleted(ctx context.Context, pipelineTaskRunID uuid.UUID, chainID *big.Int) error
 	UpdateTxFatalErrorAndDeleteAttempts(ctx context.Context, etx *Tx) error
 	UpdateTxStatesToFinalizedUsingTxHashes(ctx context.Context, txHashes []common.Hash, chainID *big.Int) error
+	UpdateTxsCancelledUsingReceipts(ctx context.Context, chainID *big.Int) (etxs []*Tx, err error)
 }
 
 type finalizerChainClient interface {
@@ -102,7 +118,11 @@ type evmFinalizer struct {
 	wg     sync.WaitGroup
 
 	lastProcessedFinalizedBlockNum int64
+	lastProcessedSafeBlockNum      int64
+	lastProcessedSafeBlockHash     common.Hash
 	resumeCallback                 resumeCallback
+	// batchCallResultMisses counts the heads on which the call results of the pending batch txs failed to be fetched
+	batchCallResultMisses map[common.Hash]int
 }
 
 func NewEvmFinalizer(
@@ -205,15 +225,71 @@ func (f *evmFinalizer) ProcessHead(ctx context.Context, head *evmtypes.Head) err
 	if err != nil {
 		f.lggr.Errorf("failed to fetch and store receipts for confirmed transactions: %s", err.Error())
 	}
+	// Mark transactions whose cancel attempt was included as cancelled
+	err = f.ProcessCancelledTxs(ctx)
+	// Do not return on error since other functions are not dependent on results
+	if err != nil {
+		f.lggr.Errorf("failed to process cancelled transactions: %s", err.Error())
+	}
 	// Resume pending task runs if any receipts match the min confirmation criteria
 	err = f.ResumePendingTaskRuns(ctx, head.BlockNumber(), latestFinalizedHead.BlockNumber())
 	// Do not return on error since other functions are not dependent on results
 	if err != nil {
 		f.lggr.Errorf("failed to resume pending task runs: %s", err.Error())
 	}
+	// Count the safe transactions before finalizing, so transactions finalized by this head are counted as safe first
+	err = f.updateSafeTxsMetric(ctx, head)
+	// Do not return on error since other functions are not dependent on results
+	if err != nil {
+		f.lggr.Errorf("failed to count safe transactions: %s", err.Error())
+	}
 	return f.processFinalizedHead(ctx, latestFinalizedHead)
 }
 
+// updateSafeTxsMetric sets the tx_manager_num_safe_transactions gauge to the number of confirmed transactions whose
+// receipts are in the chain of head up to its latest safe block, as marked by the HeadTracker. It does not change the
+// state of any transaction. Receipts at or below the safe block that are not in the chain were re-org'd out, so
+// they are not counted and are left to the Confirmer, which rebroadcasts their transactions.
+func (f *evmFinalizer) updateSafeTxsMetric(ctx context.Context, head *evmtypes.Head) error {
+	latestSafeHead := head.LatestSafeHead()
+	if latestSafeHead == nil {
+		return nil
+	}
+	// Only continue processing if the latestSafeHead has not already been processed, including at the same height
+	// since the safe block can be re-org'd unlike the finalized one
+	if latestSafeHead.BlockNumber() == f.lastProcessedSafeBlockNum && latestSafeHead.BlockHash() == f.lastProcessedSafeBlockHash {
+		return nil
+	}
+	if f.lastProcessedSafeBlockNum > 0 {
+		if hash := latestSafeHead.HashAtHeight(f.lastProcessedSafeBlockNum); latestSafeHead.BlockNumber() < f.lastProcessedSafeBlockNum || (hash != (common.Hash{}) && hash != f.lastProcessedSafeBlockHash) {
+			f.lggr.Warnw("latest safe head was re-org'd", "prevBlockNum", f.lastProcessedSafeBlockNum, "prevBlockHash", f.lastProcessedSafeBlockHash,
+				"blockNum", latestSafeHead.BlockNumber(), "blockHash", latestSafeHead.BlockHash())
+		}
+	}
+
+	receipts, err := f.txStore.FindConfirmedTxesReceipts(ctx, latestSafeHead.BlockNumber(), f.chainID)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve receipts for confirmed, unfinalized transactions: %w", err)
+	}
+	var safeTxs int
+	for _, receipt := range receipts {
+		switch hash := latestSafeHead.HashAtHeight(receipt.BlockNumber.Int64()); hash {
+		case receipt.BlockHash:
+			safeTxs++
+		case common.Hash{}:
+			// The block of the receipt is older than the cached chain, processFinalizedHead checks it once finalized
+		default:
+			f.lggr.Warnw("found confirmed transaction with receipt re-org'd out of the safe chain", "txHash", receipt.TxHash,
+				"blockNum", receipt.BlockNumber, "blockHash", receipt.BlockHash, "safeChainBlockHash", hash)
+		}
+	}
+	f.lggr.Debugw("counted safe transactions", "blockNum", latestSafeHead.BlockNumber(), "blockHash", latestSafeHead.BlockHash(), "safeTxs", safeTxs)
+	f.lastProcessedSafeBlockNum = latestSafeHead.BlockNumber()
+	f.lastProcessedSafeBlockHash = latestSafeHead.BlockHash()
+	promNumSafeTxs.WithLabelValues(f.chainID.String()).Set(float64(safeTxs))
+	return nil
+}
+
 // processFinalizedHead determines if any confirmed transactions can be marked as finalized by comparing their receipts against the latest finalized block
 // Fetches receipts directly from on-chain so re-org detection is not needed during finalization
 func (f *evmFinalizer) processFinalizedHead(ctx context.Context, latestFinalizedHead *evmtypes.Head) error {
@@ -541,13 +617,34 @@ func (f *evmFinalizer) ResumePendingTaskRuns(ctx context.Context, latest, finali
 	} else {
 		f.lggr.Debug("No task runs to resume")
 	}
+	batchResults := f.fetchBatchCallResults(ctx, receiptsPlus)
 	for _, data := range receiptsPlus {
 		var taskErr error
 		var output interface{}
-		if data.FailOnRevert && data.Receipt.GetStatus() == 0 {
-			taskErr = fmt.Errorf("transaction %s reverted on-chain", data.Receipt.GetTxHash())
-		} else {
-			output = data.Receipt
+		if data.BatchCallAllowFailure && data.Receipt.GetStatus() != 0 {
+			// The call of the tx may have failed even though its batch tx succeeded
+			results, ok := batchResults[data.Receipt.TxHash]
+			switch {
+			case !ok && f.batchCallResultMisses[data.Receipt.TxHash] < maxBatchCallResultMisses:
+				// Retry on the next head
+				continue
+			case !ok:
+				taskErr = fmt.Errorf("failed to fetch the result of call %d of batch transaction %s on the last %d heads", *data.BatchCallIndex, data.Receipt.TxHash, maxBatchCallResultMisses)
+			case int(*data.BatchCallIndex) >= len(results):
+				f.lggr.AssumptionViolationw("batch transaction has less call results than calls", "txHash", data.Receipt.TxHash, "batchCallIndex", *data.BatchCallIndex, "results", len(results))
+				continue
+			case !results[*data.BatchCallIndex].Success:
+				receipt := *data.Receipt
+				receipt.Status = 0
+				data.Receipt = &receipt
+			}
+		}
+		if taskErr == nil {
+			if data.FailOnRevert && data.Receipt.GetStatus() == 0 {
+				taskErr = fmt.Errorf("transaction %s reverted on-chain", data.Receipt.GetTxHash())
+			} else {
+				output = data.Receipt
+			}
 		}
 
 		f.lggr.Debugw("Callback: resuming tx with receipt", "output", output, "taskErr", taskErr, "pipelineTaskRunID", data.ID)
@@ -560,9 +657,197 @@ func (f *evmFinalizer) ResumePendingTaskRuns(ctx context.Context, latest, finali
 		}
 	}
 
+	// Txes batched into a failed batch tx never get a receipt, resume them with the error of their batch tx
+	failedEtxs, err := f.txStore.FindFailedBatchedTxesPendingCallback(ctx, f.chainID)
+	if err != nil {
+		return err
+	}
+	for _, etx := range failedEtxs {
+		taskErr := fmt.Errorf("batch transaction %d failed: %s", *etx.BatchTxID, etx.Error.String)
+		f.lggr.Debugw("Callback: resuming batched tx with error", "taskErr", taskErr, "pipelineTaskRunID", etx.PipelineTaskRunID.UUID)
+		if err := f.resumeCallback(ctx, etx.PipelineTaskRunID.UUID, nil, taskErr); err != nil {
+			return fmt.Errorf("failed to resume suspended pipeline run: %w", err)
+		}
+		if err := f.txStore.UpdateTxCallbackCompleted(ctx, etx.PipelineTaskRunID.UUID, f.chainID); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// fetchBatchCallResults returns the results of the calls of the successful batch txs of receiptsPlus whose calls are
+// allowed to fail, by tx hash. They are decoded from the output of the batch txs traced from the RPC or, if the RPC
+// cannot trace them, re-executed with eth_call on top of the parent of their receipt block. Batch txs whose results
+// cannot be fetched are counted in batchCallResultMisses.
+func (f *evmFinalizer) fetchBatchCallResults(ctx context.Context, receiptsPlus []ReceiptPlus) map[common.Hash][]multicall3.Multicall3Result {
+	var reqs []rpc.BatchElem
+	traces := make(map[common.Hash]*callTrace)
+	batchReceipts := make(map[common.Hash]ReceiptPlus)
+	for _, data := range receiptsPlus {
+		if !data.BatchCallAllowFailure || data.Receipt.GetStatus() == 0 || traces[data.Receipt.TxHash] != nil {
+			continue
+		}
+		trace := new(callTrace)
+		traces[data.Receipt.TxHash] = trace
+		batchReceipts[data.Receipt.TxHash] = data
+		reqs = append(reqs, rpc.BatchElem{
+			Method: "debug_traceTransaction",
+			Args:   []any{data.Receipt.TxHash, map[string]any{"tracer": "callTracer", "tracerConfig": map[string]any{"onlyTopCall": true}}},
+			Result: trace,
+		})
+	}
+	if len(reqs) == 0 {
+		f.batchCallResultMisses = nil
+		return nil
+	}
+	results := make(map[common.Hash][]multicall3.Multicall3Result, len(reqs))
+	if err := f.client.BatchCallContext(ctx, reqs); err != nil {
+		f.lggr.Errorw("failed to trace batch transactions", "err", err)
+	} else {
+		for _, req := range reqs {
+			txHash := req.Args[0].(common.Hash)
+			if req.Error != nil {
+				f.lggr.Debugw("failed to trace batch transaction", "txHash", txHash, "err", req.Error)
+				continue
+			}
+			callResults, err := decodeMulticall3Results(traces[txHash].Output)
+			if err != nil {
+				f.lggr.Errorw("failed to decode the traced call results of batch transaction", "txHash", txHash, "err", err)
+				continue
+			}
+			results[txHash] = callResults
+		}
+	}
+
+	var untraced []ReceiptPlus
+	for txHash, data := range batchReceipts {
+		if _, ok := results[txHash]; !ok {
+			untraced = append(untraced, data)
+		}
+	}
+	for txHash, callResults := range f.callBatchTxs(ctx, untraced) {
+		results[txHash] = callResults
+	}
+
+	// Only keep counting the misses of the batch txs that are still pending, so resumed ones are forgotten
+	misses := make(map[common.Hash]int)
+	for txHash := range batchReceipts {
+		if _, ok := results[txHash]; !ok {
+			misses[txHash] = f.batchCallResultMisses[txHash] + 1
+		}
+	}
+	f.batchCallResultMisses = misses
+	return results
+}
+
+// callBatchTxs re-executes the batch txs of receiptsPlus with eth_call on top of the parent of their receipt block, and
+// returns the results of their calls by tx hash. The results can differ from the ones of the batch txs if txs earlier in
+// the same block changed the state of their targets, so they are only used when the batch txs cannot be traced.
+func (f *evmFinalizer) callBatchTxs(ctx context.Context, receiptsPlus []ReceiptPlus) map[common.Hash][]multicall3.Multicall3Result {
+	if len(receiptsPlus) == 0 {
+		return nil
+	}
+	batchTxIDs := make([]int64, 0, len(receiptsPlus))
+	for _, data := range receiptsPlus {
+		if data.BatchTxID != nil {
+			batchTxIDs = append(batchTxIDs, *data.BatchTxID)
+		}
+	}
+	batchTxs, err := f.txStore.FindTxesByIDs(ctx, batchTxIDs, f.chainID)
+	if err != nil {
+		f.lggr.Errorw("failed to find batch transactions", "err", err)
+		return nil
+	}
+	batchTxsByID := make(map[int64]*Tx, len(batchTxs))
+	for _, etx := range batchTxs {
+		batchTxsByID[etx.ID] = etx
+	}
+
+	var reqs []rpc.BatchElem
+	txHashes := make([]common.Hash, 0, len(receiptsPlus))
+	for _, data := range receiptsPlus {
+		if data.BatchTxID == nil || batchTxsByID[*data.BatchTxID] == nil {
+			continue
+		}
+		etx := batchTxsByID[*data.BatchTxID]
+		parentBlockNum := new(big.Int).Sub(data.Receipt.GetBlockNumber(), big.NewInt(1))
+		reqs = append(reqs, rpc.BatchElem{
+			Method: "eth_call",
+			Args: []any{
+				map[string]any{
+					"from":  etx.FromAddress,
+					"to":    etx.ToAddress,
+					"gas":   hexutil.Uint64(etx.FeeLimit),
+					"value": (*hexutil.Big)(&etx.Value),
+					"data":  hexutil.Bytes(etx.EncodedPayload),
+				},
+				hexutil.EncodeBig(parentBlockNum),
+			},
+			Result: new(hexutil.Bytes),
+		})
+		txHashes = append(txHashes, data.Receipt.TxHash)
+	}
+	if len(reqs) == 0 {
+		return nil
+	}
+	if err = f.client.BatchCallContext(ctx, reqs); err != nil {
+		f.lggr.Errorw("failed to call batch transactions", "err", err)
+		return nil
+	}
+	results := make(map[common.Hash][]multicall3.Multicall3Result, len(reqs))
+	for i, req := range reqs {
+		if req.Error != nil {
+			f.lggr.Errorw("failed to call batch transaction", "txHash", txHashes[i], "err", req.Error)
+			continue
+		}
+		callResults, err := decodeMulticall3Results(*req.Result.(*hexutil.Bytes))
+		if err != nil {
+			f.lggr.Errorw("failed to decode the call results of batch transaction", "txHash", txHashes[i], "err", err)
+			continue
+		}
+		results[txHashes[i]] = callResults
+	}
+	return results
+}
+
+// callTrace is the top call of a transaction traced with the callTracer
+type callTrace struct {
+	Output hexutil.Bytes `json:"output"`
+}
+
+// ProcessCancelledTxs marks the confirmed transactions whose receipt is for their cancel attempt as cancelled, and
+// resumes their pending task runs with ErrTxCancelled. Transactions whose original attempt was included instead stay
+// confirmed, and their task runs are resumed with the receipt as usual.
+func (f *evmFinalizer) ProcessCancelledTxs(ctx context.Context) error {
+	cancelledTxs, err := f.txStore.UpdateTxsCancelledUsingReceipts(ctx, f.chainID)
+	if err != nil {
+		return err
+	}
+	promNumCancelledTxs.WithLabelValues(f.chainID.String()).Add(float64(len(cancelledTxs)))
+
+	errorList := make([]error, 0, len(cancelledTxs))
+	for _, etx := range cancelledTxs {
+		f.lggr.Infow("transaction cancelled after its deadline", "etxID", etx.ID, "deadline", etx.Deadline)
+		if f.resumeCallback == nil || !etx.PipelineTaskRunID.Valid || !etx.SignalCallback || etx.CallbackCompleted {
+			continue
+		}
+		err = f.resumeCallback(ctx, etx.PipelineTaskRunID.UUID, nil, txmgrcommon.ErrTxCancelled)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			f.lggr.Debugw("callback missing or already resumed", "etxID", etx.ID)
+		case err != nil:
+			errorList = append(errorList, fmt.Errorf("failed to resume pipeline for ID %s: %w", etx.PipelineTaskRunID.UUID.String(), err))
+		default:
+			// Mark tx as having completed callback
+			if err = f.txStore.UpdateTxCallbackCompleted(ctx, etx.PipelineTaskRunID.UUID, f.chainID); err != nil {
+				errorList = append(errorList, fmt.Errorf("failed to update callback as complete for tx ID %d: %w", etx.ID, err))
+			}
+		}
+	}
+	return errors.Join(errorList...)
+}
+
 func (f *evmFinalizer) ProcessOldTxsWithoutReceipts(ctx context.Context, oldTxIDs []int64, head, latestFinalizedHead *evmtypes.Head) error {
 	if len(oldTxIDs) == 0 {
 		return nil
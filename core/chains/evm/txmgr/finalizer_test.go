@@ -30,6 +30,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/shared/generated/multicall3"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/evmtest"
@@ -455,6 +456,234 @@ func TestFinalizer_ResumePendingRuns(t *testing.T) {
 	})
 }
 
+func TestFinalizer_ResumePendingRuns_BatchedTxes(t *testing.T) {
+	t.Parallel()
+	ctx := tests.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	txmClient := txmgr.NewEvmTxmClient(ethClient, nil)
+	ht := headtracker.NewSimulatedHeadTracker(ethClient, true, 0)
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	pgtest.MustExec(t, db, `SET CONSTRAINTS fk_pipeline_runs_pruning_key DEFERRED`)
+	pgtest.MustExec(t, db, `SET CONSTRAINTS pipeline_runs_pipeline_spec_id_fkey DEFERRED`)
+
+	// A Multicall3 batch tx whose first call succeeded and second call failed
+	batchTx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 0, 1, fromAddress)
+	pgtest.MustExec(t, db, `UPDATE evm.txes SET to_address = $1 WHERE id = $2`, txmgr.Multicall3Address, batchTx.ID)
+	receipt := mustInsertEthReceipt(t, txStore, 10, testutils.NewHash(), batchTx.TxAttempts[0].Hash)
+	taskRunIDs := make([]uuid.UUID, 2)
+	for i := range taskRunIDs {
+		run := cltest.MustInsertPipelineRun(t, db)
+		tr := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run.ID)
+		taskRunIDs[i] = tr.ID
+		etx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
+		pgtest.MustExec(t, db, `UPDATE evm.txes SET state = 'batched', batch_tx_id = $1, batch_call_index = $2, pipeline_task_run_id = $3, signal_callback = TRUE WHERE id = $4`, batchTx.ID, i, tr.ID, etx.ID)
+	}
+
+	multicallABI, err := multicall3.Multicall3MetaData.GetAbi()
+	require.NoError(t, err)
+	output, err := multicallABI.Methods["aggregate3Value"].Outputs.Pack([]multicall3.Multicall3Result{{Success: true, ReturnData: []byte{}}, {Success: false, ReturnData: []byte{}}})
+	require.NoError(t, err)
+	ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+		return len(b) == 1 && b[0].Method == "debug_traceTransaction" && b[0].Args[0] == receipt.TxHash
+	})).Return(nil).Run(func(args mock.Arguments) {
+		elems := args.Get(1).([]rpc.BatchElem)
+		require.NoError(t, json.Unmarshal([]byte(fmt.Sprintf(`{"output": "%s"}`, hexutil.Encode(output))), elems[0].Result))
+	}).Once()
+
+	finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, uint32(1), false, txStore, txmClient, ht)
+	statuses := make(map[uuid.UUID]uint64)
+	finalizer.SetResumeCallback(func(ctx context.Context, id uuid.UUID, value interface{}, err error) error {
+		require.NoError(t, err)
+		statuses[id] = value.(*evmtypes.Receipt).Status
+		return nil
+	})
+
+	require.NoError(t, finalizer.ResumePendingTaskRuns(ctx, 100, 100))
+	require.Len(t, statuses, 2)
+	assert.Equal(t, uint64(1), statuses[taskRunIDs[0]])
+	assert.Equal(t, uint64(0), statuses[taskRunIDs[1]])
+}
+
+func TestFinalizer_ResumePendingRuns_BatchedTxes_WithoutTracing(t *testing.T) {
+	t.Parallel()
+	ctx := tests.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	pgtest.MustExec(t, db, `SET CONSTRAINTS fk_pipeline_runs_pruning_key DEFERRED`)
+	pgtest.MustExec(t, db, `SET CONSTRAINTS pipeline_runs_pipeline_spec_id_fkey DEFERRED`)
+
+	// mustInsertBatchTx inserts a Multicall3 batch tx with a receipt at block 10 whose two calls have pending task runs
+	mustInsertBatchTx := func(t *testing.T, nonce int64) (txmgr.Receipt, []uuid.UUID) {
+		batchTx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, nonce, 1, fromAddress)
+		pgtest.MustExec(t, db, `UPDATE evm.txes SET to_address = $1 WHERE id = $2`, txmgr.Multicall3Address, batchTx.ID)
+		receipt := mustInsertEthReceipt(t, txStore, 10, testutils.NewHash(), batchTx.TxAttempts[0].Hash)
+		taskRunIDs := make([]uuid.UUID, 2)
+		for i := range taskRunIDs {
+			run := cltest.MustInsertPipelineRun(t, db)
+			tr := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run.ID)
+			taskRunIDs[i] = tr.ID
+			etx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
+			pgtest.MustExec(t, db, `UPDATE evm.txes SET state = 'batched', batch_tx_id = $1, batch_call_index = $2, pipeline_task_run_id = $3, signal_callback = TRUE WHERE id = $4`, batchTx.ID, i, tr.ID, etx.ID)
+		}
+		return receipt, taskRunIDs
+	}
+	isTrace := func(b []rpc.BatchElem) bool {
+		return len(b) == 1 && b[0].Method == "debug_traceTransaction"
+	}
+
+	t.Run("resumes task runs with the results of the batch tx re-executed on the parent block", func(t *testing.T) {
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		txmClient := txmgr.NewEvmTxmClient(ethClient, nil)
+		ht := headtracker.NewSimulatedHeadTracker(ethClient, true, 0)
+		receipt, taskRunIDs := mustInsertBatchTx(t, 0)
+
+		multicallABI, err := multicall3.Multicall3MetaData.GetAbi()
+		require.NoError(t, err)
+		output, err := multicallABI.Methods["aggregate3Value"].Outputs.Pack([]multicall3.Multicall3Result{{Success: true, ReturnData: []byte{}}, {Success: false, ReturnData: []byte{}}})
+		require.NoError(t, err)
+		ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(isTrace)).Return(nil).Run(func(args mock.Arguments) {
+			elems := args.Get(1).([]rpc.BatchElem)
+			elems[0].Error = errors.New("the method debug_traceTransaction does not exist/is not available")
+		}).Once()
+		ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+			return len(b) == 1 && b[0].Method == "eth_call" && b[0].Args[1] == hexutil.EncodeBig(big.NewInt(receipt.BlockNumber-1))
+		})).Return(nil).Run(func(args mock.Arguments) {
+			elems := args.Get(1).([]rpc.BatchElem)
+			*elems[0].Result.(*hexutil.Bytes) = output
+		}).Once()
+
+		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, uint32(1), false, txStore, txmClient, ht)
+		statuses := make(map[uuid.UUID]uint64)
+		finalizer.SetResumeCallback(func(ctx context.Context, id uuid.UUID, value interface{}, err error) error {
+			require.NoError(t, err)
+			statuses[id] = value.(*evmtypes.Receipt).Status
+			return nil
+		})
+
+		require.NoError(t, finalizer.ResumePendingTaskRuns(ctx, 100, 100))
+		require.Len(t, statuses, 2)
+		assert.Equal(t, uint64(1), statuses[taskRunIDs[0]])
+		assert.Equal(t, uint64(0), statuses[taskRunIDs[1]])
+	})
+
+	t.Run("resumes task runs with an error once the call results could not be fetched on too many heads", func(t *testing.T) {
+		ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+		txmClient := txmgr.NewEvmTxmClient(ethClient, nil)
+		ht := headtracker.NewSimulatedHeadTracker(ethClient, true, 0)
+		_, taskRunIDs := mustInsertBatchTx(t, 1)
+
+		ethClient.On("BatchCallContext", mock.Anything, mock.Anything).Return(errors.New("unavailable"))
+
+		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, uint32(1), false, txStore, txmClient, ht)
+		errs := make(map[uuid.UUID]error)
+		finalizer.SetResumeCallback(func(ctx context.Context, id uuid.UUID, value interface{}, err error) error {
+			assert.Nil(t, value)
+			errs[id] = err
+			return nil
+		})
+
+		// The call results of the batch tx are fetched on 20 heads before its task runs are resumed with an error
+		for i := 0; i < 19; i++ {
+			require.NoError(t, finalizer.ResumePendingTaskRuns(ctx, 100, 100))
+			require.Empty(t, errs)
+		}
+		require.NoError(t, finalizer.ResumePendingTaskRuns(ctx, 100, 100))
+		require.Len(t, errs, 2)
+		for _, id := range taskRunIDs {
+			require.ErrorContains(t, errs[id], "failed to fetch the result of call")
+		}
+	})
+}
+
+func TestFinalizer_ProcessCancelledTxs(t *testing.T) {
+	t.Parallel()
+	ctx := tests.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	txmClient := txmgr.NewEvmTxmClient(ethClient, nil)
+	rpcBatchSize := uint32(1)
+	ht := headtracker.NewSimulatedHeadTracker(ethClient, true, 0)
+
+	pgtest.MustExec(t, db, `SET CONSTRAINTS fk_pipeline_runs_pruning_key DEFERRED`)
+	pgtest.MustExec(t, db, `SET CONSTRAINTS pipeline_runs_pipeline_spec_id_fkey DEFERRED`)
+
+	mustInsertConfirmedTxWithCancelAttempt := func(t *testing.T, fromAddress common.Address) (txmgr.Tx, txmgr.TxAttempt, uuid.UUID) {
+		run := cltest.MustInsertPipelineRun(t, db)
+		tr := cltest.MustInsertUnfinishedPipelineTaskRun(t, db, run.ID)
+		etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 0, 1, fromAddress)
+		cancelAttempt := cltest.NewLegacyEthTxAttempt(t, etx.ID)
+		cancelAttempt.State = txmgrtypes.TxAttemptBroadcast
+		cancelAttempt.IsPurgeAttempt = true
+		cancelAttempt.IsCancelAttempt = true
+		require.NoError(t, txStore.InsertTxAttempt(ctx, &cancelAttempt))
+		pgtest.MustExec(t, db, `UPDATE evm.txes SET pipeline_task_run_id = $1, signal_callback = TRUE WHERE id = $2`, &tr.ID, etx.ID)
+		return etx, cancelAttempt, tr.ID
+	}
+
+	t.Run("marks transactions whose cancel attempt was included as cancelled and resumes their task runs", func(t *testing.T) {
+		_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+		etx, cancelAttempt, trID := mustInsertConfirmedTxWithCancelAttempt(t, fromAddress)
+		mustInsertEthReceipt(t, txStore, 10, testutils.NewHash(), cancelAttempt.Hash)
+
+		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, rpcBatchSize, false, txStore, txmClient, ht)
+		var resumed bool
+		finalizer.SetResumeCallback(func(ctx context.Context, id uuid.UUID, value interface{}, err error) error {
+			resumed = true
+			require.Equal(t, trID, id)
+			require.Nil(t, value)
+			require.ErrorIs(t, err, txmgrcommon.ErrTxCancelled)
+			return nil
+		})
+
+		require.NoError(t, finalizer.ProcessCancelledTxs(ctx))
+		require.True(t, resumed)
+
+		dbTx, err := txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		require.Equal(t, txmgrcommon.TxCancelled, dbTx.State)
+		require.True(t, dbTx.CallbackCompleted)
+
+		// The receipt of the cancel attempt is never used to resume the task run
+		receiptsPlus, err := txStore.FindTxesPendingCallback(ctx, 100, 100, testutils.FixtureChainID)
+		require.NoError(t, err)
+		require.Empty(t, receiptsPlus)
+	})
+
+	t.Run("keeps transactions whose original attempt was included confirmed", func(t *testing.T) {
+		_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+		etx, _, trID := mustInsertConfirmedTxWithCancelAttempt(t, fromAddress)
+		mustInsertEthReceipt(t, txStore, 10, testutils.NewHash(), etx.TxAttempts[0].Hash)
+
+		finalizer := txmgr.NewEvmFinalizer(logger.Test(t), testutils.FixtureChainID, rpcBatchSize, false, txStore, txmClient, ht)
+		finalizer.SetResumeCallback(func(context.Context, uuid.UUID, interface{}, error) error {
+			t.Fatal("No resume expected")
+			return nil
+		})
+
+		require.NoError(t, finalizer.ProcessCancelledTxs(ctx))
+
+		dbTx, err := txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		require.Equal(t, txmgrcommon.TxConfirmed, dbTx.State)
+		require.False(t, dbTx.CallbackCompleted)
+
+		// The task run is resumed with the receipt of the original attempt instead
+		receiptsPlus, err := txStore.FindTxesPendingCallback(ctx, 100, 100, testutils.FixtureChainID)
+		require.NoError(t, err)
+		require.Len(t, receiptsPlus, 1)
+		require.Equal(t, trID, receiptsPlus[0].ID)
+	})
+}
+
 func TestFinalizer_FetchAndStoreReceipts(t *testing.T) {
 	t.Parallel()
 	ctx := tests.Context(t)
@@ -0,0 +1,130 @@
+package txmgr
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+)
+
+type keyPoolTxStore interface {
+	CountUnconfirmedTransactions(ctx context.Context, fromAddress common.Address, chainID *big.Int) (count uint32, err error)
+	CountUnstartedTransactions(ctx context.Context, fromAddress common.Address, chainID *big.Int) (count uint32, err error)
+}
+
+type keyPoolKeyStore interface {
+	EnabledAddressesForChain(ctx context.Context, chainID *big.Int) (addresses []common.Address, err error)
+}
+
+type keyPoolBalanceMonitor interface {
+	IsLowBalance(address common.Address) bool
+}
+
+// KeyPool assigns new transactions to the key of a pool with the fewest in-flight transactions, so that a key slowed
+// down by stuck or underpriced transactions does not block the whole pool. It is a drop-in replacement for the
+// round-robin selection of the keystore.
+type KeyPool struct {
+	lggr              logger.SugaredLogger
+	txStore           keyPoolTxStore
+	keystore          keyPoolKeyStore
+	balanceMonitor    keyPoolBalanceMonitor
+	maxInFlightPerKey uint32
+	excluded          []common.Address
+
+	assignmentsMu sync.Mutex
+	assignments   uint64
+	lastAssigned  map[common.Address]uint64
+}
+
+// NewKeyPool returns a KeyPool skipping the keys flagged with a low balance by balanceMonitor, if set, the keys with
+// maxInFlightPerKey in-flight transactions, unless 0, and the excluded keys, like the treasury key of the chain.
+func NewKeyPool(lggr logger.Logger, txStore keyPoolTxStore, keystore keyPoolKeyStore, balanceMonitor keyPoolBalanceMonitor, maxInFlightPerKey uint32, excluded ...common.Address) *KeyPool {
+	return &KeyPool{
+		lggr:              logger.Sugared(logger.Named(lggr, "KeyPool")),
+		txStore:           txStore,
+		keystore:          keystore,
+		balanceMonitor:    balanceMonitor,
+		maxInFlightPerKey: maxInFlightPerKey,
+		excluded:          excluded,
+		lastAssigned:      make(map[common.Address]uint64),
+	}
+}
+
+// GetRoundRobinAddress returns the key of addresses, or of the enabled keys of the chain if none are given, with the
+// fewest unstarted and unconfirmed transactions. Ties are broken in favor of the least recently assigned key.
+func (p *KeyPool) GetRoundRobinAddress(ctx context.Context, chainID *big.Int, addresses ...common.Address) (common.Address, error) {
+	enabled, err := p.keystore.EnabledAddressesForChain(ctx, chainID)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to get enabled addresses: %w", err)
+	}
+	if len(addresses) == 0 {
+		addresses = enabled
+	}
+
+	var candidates []common.Address
+	minInFlight := uint32(math.MaxUint32)
+	for _, address := range addresses {
+		if !slices.Contains(enabled, address) {
+			p.lggr.Debugw("Skipping key not enabled for chain", "address", address, "chainID", chainID)
+			continue
+		}
+		if slices.Contains(p.excluded, address) {
+			p.lggr.Debugw("Skipping key excluded from pool", "address", address)
+			continue
+		}
+		if p.balanceMonitor != nil && p.balanceMonitor.IsLowBalance(address) {
+			p.lggr.Debugw("Skipping key with low balance", "address", address)
+			continue
+		}
+		inFlight, err := p.countInFlight(ctx, address, chainID)
+		if err != nil {
+			return common.Address{}, err
+		}
+		if p.maxInFlightPerKey > 0 && inFlight >= p.maxInFlightPerKey {
+			p.lggr.Debugw("Skipping key at max in-flight transactions", "address", address, "inFlight", inFlight)
+			continue
+		}
+		switch {
+		case inFlight < minInFlight:
+			minInFlight = inFlight
+			candidates = []common.Address{address}
+		case inFlight == minInFlight:
+			candidates = append(candidates, address)
+		}
+	}
+	if len(candidates) == 0 {
+		return common.Address{}, fmt.Errorf("no key available in pool of %d keys: all keys are disabled, have a low balance or %d in-flight transactions", len(addresses), p.maxInFlightPerKey)
+	}
+	return p.assign(candidates), nil
+}
+
+// assign returns the least recently assigned of candidates, and records its assignment.
+func (p *KeyPool) assign(candidates []common.Address) common.Address {
+	p.assignmentsMu.Lock()
+	defer p.assignmentsMu.Unlock()
+	address := slices.MinFunc(candidates, func(a, b common.Address) int {
+		return cmp.Compare(p.lastAssigned[a], p.lastAssigned[b])
+	})
+	p.assignments++
+	p.lastAssigned[address] = p.assignments
+	return address
+}
+
+func (p *KeyPool) countInFlight(ctx context.Context, address common.Address, chainID *big.Int) (uint32, error) {
+	unstarted, err := p.txStore.CountUnstartedTransactions(ctx, address, chainID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unstarted transactions of %s: %w", address, err)
+	}
+	unconfirmed, err := p.txStore.CountUnconfirmedTransactions(ctx, address, chainID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unconfirmed transactions of %s: %w", address, err)
+	}
+	return unstarted + unconfirmed, nil
+}
@@ -0,0 +1,108 @@
+package txmgr_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	ksmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/keystore/mocks"
+	evmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	txstoremock "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
+)
+
+func TestKeyPool_GetRoundRobinAddress(t *testing.T) {
+	t.Parallel()
+
+	chainID := big.NewInt(0)
+	k0 := testutils.NewAddress()
+	k1 := testutils.NewAddress()
+	k2 := testutils.NewAddress()
+
+	// newTestKeyPool returns a pool of the enabled keys k0, k1 and k2, except excluded, which have the given in-flight
+	// transactions.
+	newTestKeyPool := func(t *testing.T, inFlight map[common.Address]uint32, maxInFlightPerKey uint32, excluded ...common.Address) (*txmgr.KeyPool, *evmmocks.BalanceMonitor) {
+		txStore := txstoremock.NewEvmTxStore(t)
+		for address, n := range inFlight {
+			txStore.On("CountUnstartedTransactions", mock.Anything, address, chainID).Return(n/2, nil).Maybe()
+			txStore.On("CountUnconfirmedTransactions", mock.Anything, address, chainID).Return(n-n/2, nil).Maybe()
+		}
+		ks := ksmocks.NewEth(t)
+		ks.On("EnabledAddressesForChain", mock.Anything, chainID).Return([]common.Address{k0, k1, k2}, nil)
+		bm := evmmocks.NewBalanceMonitor(t)
+		return txmgr.NewKeyPool(logger.Test(t), txStore, ks, bm, maxInFlightPerKey, excluded...), bm
+	}
+
+	t.Run("assigns the key with the fewest in-flight transactions", func(t *testing.T) {
+		ctx := tests.Context(t)
+		pool, bm := newTestKeyPool(t, map[common.Address]uint32{k0: 3, k1: 1, k2: 2}, 0)
+		bm.On("IsLowBalance", mock.Anything).Return(false)
+
+		address, err := pool.GetRoundRobinAddress(ctx, chainID, k0, k1, k2)
+		require.NoError(t, err)
+		assert.Equal(t, k1, address)
+	})
+
+	t.Run("rotates through the keys with the fewest in-flight transactions", func(t *testing.T) {
+		ctx := tests.Context(t)
+		pool, bm := newTestKeyPool(t, map[common.Address]uint32{k0: 1, k1: 4, k2: 1}, 0)
+		bm.On("IsLowBalance", mock.Anything).Return(false)
+
+		var assigned []common.Address
+		for i := 0; i < 3; i++ {
+			address, err := pool.GetRoundRobinAddress(ctx, chainID, k0, k1, k2)
+			require.NoError(t, err)
+			assigned = append(assigned, address)
+		}
+		assert.Equal(t, []common.Address{k0, k2, k0}, assigned)
+	})
+
+	t.Run("skips keys with a low balance or at max in-flight transactions", func(t *testing.T) {
+		ctx := tests.Context(t)
+		pool, bm := newTestKeyPool(t, map[common.Address]uint32{k1: 5, k2: 4}, 5)
+		bm.On("IsLowBalance", k0).Return(true)
+		bm.On("IsLowBalance", mock.Anything).Return(false)
+
+		address, err := pool.GetRoundRobinAddress(ctx, chainID, k0, k1, k2)
+		require.NoError(t, err)
+		assert.Equal(t, k2, address)
+	})
+
+	t.Run("picks among the enabled keys if none are given", func(t *testing.T) {
+		ctx := tests.Context(t)
+		pool, bm := newTestKeyPool(t, map[common.Address]uint32{k0: 2, k1: 0, k2: 1}, 0)
+		bm.On("IsLowBalance", mock.Anything).Return(false)
+
+		address, err := pool.GetRoundRobinAddress(ctx, chainID)
+		require.NoError(t, err)
+		assert.Equal(t, k1, address)
+	})
+
+	t.Run("skips excluded keys", func(t *testing.T) {
+		ctx := tests.Context(t)
+		pool, bm := newTestKeyPool(t, map[common.Address]uint32{k0: 2, k1: 0, k2: 1}, 0, k1)
+		bm.On("IsLowBalance", mock.Anything).Return(false)
+
+		address, err := pool.GetRoundRobinAddress(ctx, chainID)
+		require.NoError(t, err)
+		assert.Equal(t, k2, address)
+	})
+
+	t.Run("fails if no key is available", func(t *testing.T) {
+		ctx := tests.Context(t)
+		pool, bm := newTestKeyPool(t, map[common.Address]uint32{k1: 2}, 2)
+		bm.On("IsLowBalance", k0).Return(true)
+		bm.On("IsLowBalance", k1).Return(false)
+
+		_, err := pool.GetRoundRobinAddress(ctx, chainID, k0, k1, testutils.NewAddress())
+		require.ErrorContains(t, err, "no key available in pool of 3 keys")
+	})
+}
@@ -86,6 +86,74 @@ func (_c *EvmTxStore_Abandon_Call) RunAndReturn(run func(context.Context, *big.I
 	return _c
 }
 
+// BatchUnstartedTx provides a mock function with given fields: ctx, etxID, subject, batchContract, targets, maxBatchSize
+func (_m *EvmTxStore) BatchUnstartedTx(ctx context.Context, etxID int64, subject uuid.UUID, batchContract string, targets []string, maxBatchSize uint32) (int64, bool, error) {
+	ret := _m.Called(ctx, etxID, subject, batchContract, targets, maxBatchSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchUnstartedTx")
+	}
+
+	var r0 int64
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uuid.UUID, string, []string, uint32) (int64, bool, error)); ok {
+		return rf(ctx, etxID, subject, batchContract, targets, maxBatchSize)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uuid.UUID, string, []string, uint32) int64); ok {
+		r0 = rf(ctx, etxID, subject, batchContract, targets, maxBatchSize)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, uuid.UUID, string, []string, uint32) bool); ok {
+		r1 = rf(ctx, etxID, subject, batchContract, targets, maxBatchSize)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, uuid.UUID, string, []string, uint32) error); ok {
+		r2 = rf(ctx, etxID, subject, batchContract, targets, maxBatchSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// EvmTxStore_BatchUnstartedTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchUnstartedTx'
+type EvmTxStore_BatchUnstartedTx_Call struct {
+	*mock.Call
+}
+
+// BatchUnstartedTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - etxID int64
+//   - subject uuid.UUID
+//   - batchContract string
+//   - targets []string
+//   - maxBatchSize uint32
+func (_e *EvmTxStore_Expecter) BatchUnstartedTx(ctx interface{}, etxID interface{}, subject interface{}, batchContract interface{}, targets interface{}, maxBatchSize interface{}) *EvmTxStore_BatchUnstartedTx_Call {
+	return &EvmTxStore_BatchUnstartedTx_Call{Call: _e.mock.On("BatchUnstartedTx", ctx, etxID, subject, batchContract, targets, maxBatchSize)}
+}
+
+func (_c *EvmTxStore_BatchUnstartedTx_Call) Run(run func(ctx context.Context, etxID int64, subject uuid.UUID, batchContract string, targets []string, maxBatchSize uint32)) *EvmTxStore_BatchUnstartedTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(uuid.UUID), args[3].(string), args[4].([]string), args[5].(uint32))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_BatchUnstartedTx_Call) Return(batchTxID int64, batched bool, err error) *EvmTxStore_BatchUnstartedTx_Call {
+	_c.Call.Return(batchTxID, batched, err)
+	return _c
+}
+
+func (_c *EvmTxStore_BatchUnstartedTx_Call) RunAndReturn(run func(context.Context, int64, uuid.UUID, string, []string, uint32) (int64, bool, error)) *EvmTxStore_BatchUnstartedTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckTxQueueCapacity provides a mock function with given fields: ctx, fromAddress, maxQueuedTransactions, chainID
 func (_m *EvmTxStore) CheckTxQueueCapacity(ctx context.Context, fromAddress common.Address, maxQueuedTransactions uint64, chainID *big.Int) error {
 	ret := _m.Called(ctx, fromAddress, maxQueuedTransactions, chainID)
@@ -726,6 +794,65 @@ func (_c *EvmTxStore_FindEarliestUnconfirmedTxAttemptBlock_Call) RunAndReturn(ru
 	return _c
 }
 
+// FindFailedBatchedTxesPendingCallback provides a mock function with given fields: ctx, chainID
+func (_m *EvmTxStore) FindFailedBatchedTxesPendingCallback(ctx context.Context, chainID *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
+	ret := _m.Called(ctx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFailedBatchedTxesPendingCallback")
+	}
+
+	var r0 []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)); ok {
+		return rf(ctx, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int) error); ok {
+		r1 = rf(ctx, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_FindFailedBatchedTxesPendingCallback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindFailedBatchedTxesPendingCallback'
+type EvmTxStore_FindFailedBatchedTxesPendingCallback_Call struct {
+	*mock.Call
+}
+
+// FindFailedBatchedTxesPendingCallback is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) FindFailedBatchedTxesPendingCallback(ctx interface{}, chainID interface{}) *EvmTxStore_FindFailedBatchedTxesPendingCallback_Call {
+	return &EvmTxStore_FindFailedBatchedTxesPendingCallback_Call{Call: _e.mock.On("FindFailedBatchedTxesPendingCallback", ctx, chainID)}
+}
+
+func (_c *EvmTxStore_FindFailedBatchedTxesPendingCallback_Call) Run(run func(ctx context.Context, chainID *big.Int)) *EvmTxStore_FindFailedBatchedTxesPendingCallback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindFailedBatchedTxesPendingCallback_Call) Return(etxs []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], err error) *EvmTxStore_FindFailedBatchedTxesPendingCallback_Call {
+	_c.Call.Return(etxs, err)
+	return _c
+}
+
+func (_c *EvmTxStore_FindFailedBatchedTxesPendingCallback_Call) RunAndReturn(run func(context.Context, *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)) *EvmTxStore_FindFailedBatchedTxesPendingCallback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindLatestSequence provides a mock function with given fields: ctx, fromAddress, chainID
 func (_m *EvmTxStore) FindLatestSequence(ctx context.Context, fromAddress common.Address, chainID *big.Int) (evmtypes.Nonce, error) {
 	ret := _m.Called(ctx, fromAddress, chainID)
@@ -844,6 +971,129 @@ func (_c *EvmTxStore_FindNextUnstartedTransactionFromAddress_Call) RunAndReturn(
 	return _c
 }
 
+// FindNoncesInRange provides a mock function with given fields: ctx, fromAddress, from, to, chainID
+func (_m *EvmTxStore) FindNoncesInRange(ctx context.Context, fromAddress common.Address, from evmtypes.Nonce, to evmtypes.Nonce, chainID *big.Int) ([]evmtypes.Nonce, error) {
+	ret := _m.Called(ctx, fromAddress, from, to, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindNoncesInRange")
+	}
+
+	var r0 []evmtypes.Nonce
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, evmtypes.Nonce, evmtypes.Nonce, *big.Int) ([]evmtypes.Nonce, error)); ok {
+		return rf(ctx, fromAddress, from, to, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, evmtypes.Nonce, evmtypes.Nonce, *big.Int) []evmtypes.Nonce); ok {
+		r0 = rf(ctx, fromAddress, from, to, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]evmtypes.Nonce)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, evmtypes.Nonce, evmtypes.Nonce, *big.Int) error); ok {
+		r1 = rf(ctx, fromAddress, from, to, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_FindNoncesInRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindNoncesInRange'
+type EvmTxStore_FindNoncesInRange_Call struct {
+	*mock.Call
+}
+
+// FindNoncesInRange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromAddress common.Address
+//   - from evmtypes.Nonce
+//   - to evmtypes.Nonce
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) FindNoncesInRange(ctx interface{}, fromAddress interface{}, from interface{}, to interface{}, chainID interface{}) *EvmTxStore_FindNoncesInRange_Call {
+	return &EvmTxStore_FindNoncesInRange_Call{Call: _e.mock.On("FindNoncesInRange", ctx, fromAddress, from, to, chainID)}
+}
+
+func (_c *EvmTxStore_FindNoncesInRange_Call) Run(run func(ctx context.Context, fromAddress common.Address, from evmtypes.Nonce, to evmtypes.Nonce, chainID *big.Int)) *EvmTxStore_FindNoncesInRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(evmtypes.Nonce), args[3].(evmtypes.Nonce), args[4].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindNoncesInRange_Call) Return(nonces []evmtypes.Nonce, err error) *EvmTxStore_FindNoncesInRange_Call {
+	_c.Call.Return(nonces, err)
+	return _c
+}
+
+func (_c *EvmTxStore_FindNoncesInRange_Call) RunAndReturn(run func(context.Context, common.Address, evmtypes.Nonce, evmtypes.Nonce, *big.Int) ([]evmtypes.Nonce, error)) *EvmTxStore_FindNoncesInRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPrivateTxsPastFallback provides a mock function with given fields: ctx, address, blockNum, chainID
+func (_m *EvmTxStore) FindPrivateTxsPastFallback(ctx context.Context, address common.Address, blockNum int64, chainID *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
+	ret := _m.Called(ctx, address, blockNum, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPrivateTxsPastFallback")
+	}
+
+	var r0 []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, int64, *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)); ok {
+		return rf(ctx, address, blockNum, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, int64, *big.Int) []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]); ok {
+		r0 = rf(ctx, address, blockNum, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, int64, *big.Int) error); ok {
+		r1 = rf(ctx, address, blockNum, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_FindPrivateTxsPastFallback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPrivateTxsPastFallback'
+type EvmTxStore_FindPrivateTxsPastFallback_Call struct {
+	*mock.Call
+}
+
+// FindPrivateTxsPastFallback is a helper method to define mock.On call
+//   - ctx context.Context
+//   - address common.Address
+//   - blockNum int64
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) FindPrivateTxsPastFallback(ctx interface{}, address interface{}, blockNum interface{}, chainID interface{}) *EvmTxStore_FindPrivateTxsPastFallback_Call {
+	return &EvmTxStore_FindPrivateTxsPastFallback_Call{Call: _e.mock.On("FindPrivateTxsPastFallback", ctx, address, blockNum, chainID)}
+}
+
+func (_c *EvmTxStore_FindPrivateTxsPastFallback_Call) Run(run func(ctx context.Context, address common.Address, blockNum int64, chainID *big.Int)) *EvmTxStore_FindPrivateTxsPastFallback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(int64), args[3].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindPrivateTxsPastFallback_Call) Return(etxs []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], err error) *EvmTxStore_FindPrivateTxsPastFallback_Call {
+	_c.Call.Return(etxs, err)
+	return _c
+}
+
+func (_c *EvmTxStore_FindPrivateTxsPastFallback_Call) RunAndReturn(run func(context.Context, common.Address, int64, *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)) *EvmTxStore_FindPrivateTxsPastFallback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindReorgOrIncludedTxs provides a mock function with given fields: ctx, fromAddress, nonce, chainID
 func (_m *EvmTxStore) FindReorgOrIncludedTxs(ctx context.Context, fromAddress common.Address, nonce evmtypes.Nonce, chainID *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
 	ret := _m.Called(ctx, fromAddress, nonce, chainID)
@@ -1816,6 +2066,68 @@ func (_c *EvmTxStore_FindTxsByStateAndFromAddresses_Call) RunAndReturn(run func(
 	return _c
 }
 
+// FindTxsPastDeadline provides a mock function with given fields: ctx, address, now, blockNum, chainID
+func (_m *EvmTxStore) FindTxsPastDeadline(ctx context.Context, address common.Address, now time.Time, blockNum int64, chainID *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
+	ret := _m.Called(ctx, address, now, blockNum, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTxsPastDeadline")
+	}
+
+	var r0 []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, time.Time, int64, *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)); ok {
+		return rf(ctx, address, now, blockNum, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, time.Time, int64, *big.Int) []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]); ok {
+		r0 = rf(ctx, address, now, blockNum, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, time.Time, int64, *big.Int) error); ok {
+		r1 = rf(ctx, address, now, blockNum, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_FindTxsPastDeadline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTxsPastDeadline'
+type EvmTxStore_FindTxsPastDeadline_Call struct {
+	*mock.Call
+}
+
+// FindTxsPastDeadline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - address common.Address
+//   - now time.Time
+//   - blockNum int64
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) FindTxsPastDeadline(ctx interface{}, address interface{}, now interface{}, blockNum interface{}, chainID interface{}) *EvmTxStore_FindTxsPastDeadline_Call {
+	return &EvmTxStore_FindTxsPastDeadline_Call{Call: _e.mock.On("FindTxsPastDeadline", ctx, address, now, blockNum, chainID)}
+}
+
+func (_c *EvmTxStore_FindTxsPastDeadline_Call) Run(run func(ctx context.Context, address common.Address, now time.Time, blockNum int64, chainID *big.Int)) *EvmTxStore_FindTxsPastDeadline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Address), args[2].(time.Time), args[3].(int64), args[4].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_FindTxsPastDeadline_Call) Return(etxs []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], err error) *EvmTxStore_FindTxsPastDeadline_Call {
+	_c.Call.Return(etxs, err)
+	return _c
+}
+
+func (_c *EvmTxStore_FindTxsPastDeadline_Call) RunAndReturn(run func(context.Context, common.Address, time.Time, int64, *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)) *EvmTxStore_FindTxsPastDeadline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindTxsRequiringGasBump provides a mock function with given fields: ctx, address, blockNum, gasBumpThreshold, depth, chainID
 func (_m *EvmTxStore) FindTxsRequiringGasBump(ctx context.Context, address common.Address, blockNum int64, gasBumpThreshold int64, depth int64, chainID *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
 	ret := _m.Called(ctx, address, blockNum, gasBumpThreshold, depth, chainID)
@@ -2237,6 +2549,53 @@ func (_c *EvmTxStore_HasInProgressTransaction_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// InsertNonceReconciliation provides a mock function with given fields: ctx, r
+func (_m *EvmTxStore) InsertNonceReconciliation(ctx context.Context, r *txmgr.NonceReconciliation) error {
+	ret := _m.Called(ctx, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertNonceReconciliation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *txmgr.NonceReconciliation) error); ok {
+		r0 = rf(ctx, r)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EvmTxStore_InsertNonceReconciliation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertNonceReconciliation'
+type EvmTxStore_InsertNonceReconciliation_Call struct {
+	*mock.Call
+}
+
+// InsertNonceReconciliation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - r *txmgr.NonceReconciliation
+func (_e *EvmTxStore_Expecter) InsertNonceReconciliation(ctx interface{}, r interface{}) *EvmTxStore_InsertNonceReconciliation_Call {
+	return &EvmTxStore_InsertNonceReconciliation_Call{Call: _e.mock.On("InsertNonceReconciliation", ctx, r)}
+}
+
+func (_c *EvmTxStore_InsertNonceReconciliation_Call) Run(run func(ctx context.Context, r *txmgr.NonceReconciliation)) *EvmTxStore_InsertNonceReconciliation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*txmgr.NonceReconciliation))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_InsertNonceReconciliation_Call) Return(_a0 error) *EvmTxStore_InsertNonceReconciliation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EvmTxStore_InsertNonceReconciliation_Call) RunAndReturn(run func(context.Context, *txmgr.NonceReconciliation) error) *EvmTxStore_InsertNonceReconciliation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LoadTxAttempts provides a mock function with given fields: ctx, etx
 func (_m *EvmTxStore) LoadTxAttempts(ctx context.Context, etx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error {
 	ret := _m.Called(ctx, etx)
@@ -2284,6 +2643,73 @@ func (_c *EvmTxStore_LoadTxAttempts_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// NonceReconciliations provides a mock function with given fields: ctx, offset, limit
+func (_m *EvmTxStore) NonceReconciliations(ctx context.Context, offset int, limit int) ([]txmgr.NonceReconciliation, int, error) {
+	ret := _m.Called(ctx, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NonceReconciliations")
+	}
+
+	var r0 []txmgr.NonceReconciliation
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]txmgr.NonceReconciliation, int, error)); ok {
+		return rf(ctx, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []txmgr.NonceReconciliation); ok {
+		r0 = rf(ctx, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]txmgr.NonceReconciliation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) int); ok {
+		r1 = rf(ctx, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
+		r2 = rf(ctx, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// EvmTxStore_NonceReconciliations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NonceReconciliations'
+type EvmTxStore_NonceReconciliations_Call struct {
+	*mock.Call
+}
+
+// NonceReconciliations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - offset int
+//   - limit int
+func (_e *EvmTxStore_Expecter) NonceReconciliations(ctx interface{}, offset interface{}, limit interface{}) *EvmTxStore_NonceReconciliations_Call {
+	return &EvmTxStore_NonceReconciliations_Call{Call: _e.mock.On("NonceReconciliations", ctx, offset, limit)}
+}
+
+func (_c *EvmTxStore_NonceReconciliations_Call) Run(run func(ctx context.Context, offset int, limit int)) *EvmTxStore_NonceReconciliations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_NonceReconciliations_Call) Return(_a0 []txmgr.NonceReconciliation, _a1 int, _a2 error) *EvmTxStore_NonceReconciliations_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *EvmTxStore_NonceReconciliations_Call) RunAndReturn(run func(context.Context, int, int) ([]txmgr.NonceReconciliation, int, error)) *EvmTxStore_NonceReconciliations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PreloadTxes provides a mock function with given fields: ctx, attempts
 func (_m *EvmTxStore) PreloadTxes(ctx context.Context, attempts []types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error {
 	ret := _m.Called(ctx, attempts)
@@ -3264,6 +3690,100 @@ func (_c *EvmTxStore_UpdateTxFatalErrorAndDeleteAttempts_Call) RunAndReturn(run
 	return _c
 }
 
+// UpdateTxPrivateSubmissionFallback provides a mock function with given fields: ctx, etxID
+func (_m *EvmTxStore) UpdateTxPrivateSubmissionFallback(ctx context.Context, etxID int64) error {
+	ret := _m.Called(ctx, etxID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTxPrivateSubmissionFallback")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, etxID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EvmTxStore_UpdateTxPrivateSubmissionFallback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTxPrivateSubmissionFallback'
+type EvmTxStore_UpdateTxPrivateSubmissionFallback_Call struct {
+	*mock.Call
+}
+
+// UpdateTxPrivateSubmissionFallback is a helper method to define mock.On call
+//   - ctx context.Context
+//   - etxID int64
+func (_e *EvmTxStore_Expecter) UpdateTxPrivateSubmissionFallback(ctx interface{}, etxID interface{}) *EvmTxStore_UpdateTxPrivateSubmissionFallback_Call {
+	return &EvmTxStore_UpdateTxPrivateSubmissionFallback_Call{Call: _e.mock.On("UpdateTxPrivateSubmissionFallback", ctx, etxID)}
+}
+
+func (_c *EvmTxStore_UpdateTxPrivateSubmissionFallback_Call) Run(run func(ctx context.Context, etxID int64)) *EvmTxStore_UpdateTxPrivateSubmissionFallback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_UpdateTxPrivateSubmissionFallback_Call) Return(_a0 error) *EvmTxStore_UpdateTxPrivateSubmissionFallback_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EvmTxStore_UpdateTxPrivateSubmissionFallback_Call) RunAndReturn(run func(context.Context, int64) error) *EvmTxStore_UpdateTxPrivateSubmissionFallback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTxSimulationFailed provides a mock function with given fields: ctx, etx
+func (_m *EvmTxStore) UpdateTxSimulationFailed(ctx context.Context, etx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error {
+	ret := _m.Called(ctx, etx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTxSimulationFailed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error); ok {
+		r0 = rf(ctx, etx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EvmTxStore_UpdateTxSimulationFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTxSimulationFailed'
+type EvmTxStore_UpdateTxSimulationFailed_Call struct {
+	*mock.Call
+}
+
+// UpdateTxSimulationFailed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - etx *types.Tx[*big.Int,common.Address,common.Hash,common.Hash,evmtypes.Nonce,gas.EvmFee]
+func (_e *EvmTxStore_Expecter) UpdateTxSimulationFailed(ctx interface{}, etx interface{}) *EvmTxStore_UpdateTxSimulationFailed_Call {
+	return &EvmTxStore_UpdateTxSimulationFailed_Call{Call: _e.mock.On("UpdateTxSimulationFailed", ctx, etx)}
+}
+
+func (_c *EvmTxStore_UpdateTxSimulationFailed_Call) Run(run func(ctx context.Context, etx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])) *EvmTxStore_UpdateTxSimulationFailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_UpdateTxSimulationFailed_Call) Return(_a0 error) *EvmTxStore_UpdateTxSimulationFailed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EvmTxStore_UpdateTxSimulationFailed_Call) RunAndReturn(run func(context.Context, *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error) *EvmTxStore_UpdateTxSimulationFailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateTxStatesToFinalizedUsingTxHashes provides a mock function with given fields: ctx, txHashes, chainID
 func (_m *EvmTxStore) UpdateTxStatesToFinalizedUsingTxHashes(ctx context.Context, txHashes []common.Hash, chainID *big.Int) error {
 	ret := _m.Called(ctx, txHashes, chainID)
@@ -3312,6 +3832,53 @@ func (_c *EvmTxStore_UpdateTxStatesToFinalizedUsingTxHashes_Call) RunAndReturn(r
 	return _c
 }
 
+// UpdateTxUnstartedToCancelled provides a mock function with given fields: ctx, etx
+func (_m *EvmTxStore) UpdateTxUnstartedToCancelled(ctx context.Context, etx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error {
+	ret := _m.Called(ctx, etx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTxUnstartedToCancelled")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error); ok {
+		r0 = rf(ctx, etx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EvmTxStore_UpdateTxUnstartedToCancelled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTxUnstartedToCancelled'
+type EvmTxStore_UpdateTxUnstartedToCancelled_Call struct {
+	*mock.Call
+}
+
+// UpdateTxUnstartedToCancelled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - etx *types.Tx[*big.Int,common.Address,common.Hash,common.Hash,evmtypes.Nonce,gas.EvmFee]
+func (_e *EvmTxStore_Expecter) UpdateTxUnstartedToCancelled(ctx interface{}, etx interface{}) *EvmTxStore_UpdateTxUnstartedToCancelled_Call {
+	return &EvmTxStore_UpdateTxUnstartedToCancelled_Call{Call: _e.mock.On("UpdateTxUnstartedToCancelled", ctx, etx)}
+}
+
+func (_c *EvmTxStore_UpdateTxUnstartedToCancelled_Call) Run(run func(ctx context.Context, etx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])) *EvmTxStore_UpdateTxUnstartedToCancelled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_UpdateTxUnstartedToCancelled_Call) Return(_a0 error) *EvmTxStore_UpdateTxUnstartedToCancelled_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EvmTxStore_UpdateTxUnstartedToCancelled_Call) RunAndReturn(run func(context.Context, *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error) *EvmTxStore_UpdateTxUnstartedToCancelled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateTxUnstartedToInProgress provides a mock function with given fields: ctx, etx, attempt
 func (_m *EvmTxStore) UpdateTxUnstartedToInProgress(ctx context.Context, etx *types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], attempt *types.TxAttempt[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]) error {
 	ret := _m.Called(ctx, etx, attempt)
@@ -3360,6 +3927,65 @@ func (_c *EvmTxStore_UpdateTxUnstartedToInProgress_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// UpdateTxsCancelledUsingReceipts provides a mock function with given fields: ctx, chainID
+func (_m *EvmTxStore) UpdateTxsCancelledUsingReceipts(ctx context.Context, chainID *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error) {
+	ret := _m.Called(ctx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTxsCancelledUsingReceipts")
+	}
+
+	var r0 []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)); ok {
+		return rf(ctx, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee]); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int) error); ok {
+		r1 = rf(ctx, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EvmTxStore_UpdateTxsCancelledUsingReceipts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTxsCancelledUsingReceipts'
+type EvmTxStore_UpdateTxsCancelledUsingReceipts_Call struct {
+	*mock.Call
+}
+
+// UpdateTxsCancelledUsingReceipts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chainID *big.Int
+func (_e *EvmTxStore_Expecter) UpdateTxsCancelledUsingReceipts(ctx interface{}, chainID interface{}) *EvmTxStore_UpdateTxsCancelledUsingReceipts_Call {
+	return &EvmTxStore_UpdateTxsCancelledUsingReceipts_Call{Call: _e.mock.On("UpdateTxsCancelledUsingReceipts", ctx, chainID)}
+}
+
+func (_c *EvmTxStore_UpdateTxsCancelledUsingReceipts_Call) Run(run func(ctx context.Context, chainID *big.Int)) *EvmTxStore_UpdateTxsCancelledUsingReceipts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*big.Int))
+	})
+	return _c
+}
+
+func (_c *EvmTxStore_UpdateTxsCancelledUsingReceipts_Call) Return(etxs []*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], err error) *EvmTxStore_UpdateTxsCancelledUsingReceipts_Call {
+	_c.Call.Return(etxs, err)
+	return _c
+}
+
+func (_c *EvmTxStore_UpdateTxsCancelledUsingReceipts_Call) RunAndReturn(run func(context.Context, *big.Int) ([]*types.Tx[*big.Int, common.Address, common.Hash, common.Hash, evmtypes.Nonce, gas.EvmFee], error)) *EvmTxStore_UpdateTxsCancelledUsingReceipts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateTxsForRebroadcast provides a mock function with given fields: ctx, etxIDs, attemptIDs
 func (_m *EvmTxStore) UpdateTxsForRebroadcast(ctx context.Context, etxIDs []int64, attemptIDs []int64) error {
 	ret := _m.Called(ctx, etxIDs, attemptIDs)
@@ -0,0 +1,291 @@
+package txmgr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+var promNonceReconciliations = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "tx_manager_num_nonce_reconciliations",
+	Help: "Number of actions taken to reconcile the local nonces of the keys with the chain, labeled by action",
+}, []string{"chainID", "action"})
+
+// NonceReconciliationAction is an action taken by the NonceReconciler to bring a key back in sync with the chain.
+type NonceReconciliationAction string
+
+const (
+	// NonceReconciliationResync moves the local next nonce of a key to the on-chain pending nonce.
+	NonceReconciliationResync = NonceReconciliationAction("resync")
+	// NonceReconciliationFillGap fills a nonce blocking the stored transactions of a key with a zero-value self-transfer.
+	NonceReconciliationFillGap = NonceReconciliationAction("fill_gap")
+)
+
+// NonceReconciliation is a row of the evm.nonce_reconciliations audit table.
+type NonceReconciliation struct {
+	ID         int64
+	EVMChainID ubig.Big
+	Address    common.Address
+	Action     NonceReconciliationAction
+	// Nonce is the new local next nonce of a resync, or the nonce filled by a gap filling transaction.
+	Nonce        evmtypes.Nonce
+	LocalNonce   evmtypes.Nonce
+	MinedNonce   evmtypes.Nonce
+	PendingNonce evmtypes.Nonce
+	// TxHash is the hash of the gap filling transaction.
+	TxHash    *common.Hash
+	CreatedAt time.Time
+}
+
+type nonceReconcilerTxStore interface {
+	FindNoncesInRange(ctx context.Context, fromAddress common.Address, from, to evmtypes.Nonce, chainID *big.Int) (nonces []evmtypes.Nonce, err error)
+	InsertNonceReconciliation(ctx context.Context, r *NonceReconciliation) error
+}
+
+type nonceReconcilerClient interface {
+	PendingSequenceAt(ctx context.Context, addr common.Address) (evmtypes.Nonce, error)
+	SequenceAt(ctx context.Context, addr common.Address, blockNum *big.Int) (evmtypes.Nonce, error)
+	SendEmptyTransaction(
+		ctx context.Context,
+		newTxAttempt func(ctx context.Context, seq evmtypes.Nonce, feeLimit uint64, fee gas.EvmFee, fromAddress common.Address) (attempt TxAttempt, err error),
+		seq evmtypes.Nonce,
+		gasLimit uint64,
+		fee gas.EvmFee,
+		fromAddress common.Address,
+	) (txhash string, err error)
+}
+
+type nonceReconcilerSequenceTracker interface {
+	GetNextSequence(ctx context.Context, address common.Address) (evmtypes.Nonce, error)
+	ResyncSequence(address common.Address, expected, seq evmtypes.Nonce) bool
+}
+
+type nonceReconcilerFeeEstimator interface {
+	GetFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, fromAddress, toAddress *common.Address, opts ...feetypes.Opt) (fee gas.EvmFee, estimatedFeeLimit uint64, err error)
+}
+
+type nonceReconcilerFeeConfig interface {
+	LimitDefault() uint64
+	PriceMaxKey(common.Address) *assets.Wei
+}
+
+// NonceReconciler periodically compares the on-chain nonces of the enabled keys with the stored transactions, to recover
+// from the keys being used outside of the node. It resyncs the local next nonce of a key when it is behind the chain,
+// or ahead of it with no stored transaction to broadcast, and otherwise fills the nonce gaps blocking the stored
+// transactions with zero-value self-transfers. Every action is recorded in the evm.nonce_reconciliations table.
+type NonceReconciler struct {
+	services.StateMachine
+	lggr      logger.SugaredLogger
+	chainID   *big.Int
+	interval  time.Duration
+	txStore   nonceReconcilerTxStore
+	client    nonceReconcilerClient
+	tracker   nonceReconcilerSequenceTracker
+	keystore  KeyStore
+	builder   TxAttemptBuilder
+	estimator nonceReconcilerFeeEstimator
+	feeConfig nonceReconcilerFeeConfig
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+func NewNonceReconciler(
+	lggr logger.Logger,
+	chainID *big.Int,
+	interval time.Duration,
+	txStore nonceReconcilerTxStore,
+	client nonceReconcilerClient,
+	tracker nonceReconcilerSequenceTracker,
+	keystore KeyStore,
+	builder TxAttemptBuilder,
+	estimator nonceReconcilerFeeEstimator,
+	feeConfig nonceReconcilerFeeConfig,
+) *NonceReconciler {
+	lggr = logger.Named(lggr, "NonceReconciler")
+	return &NonceReconciler{
+		lggr:      logger.Sugared(lggr),
+		chainID:   chainID,
+		interval:  interval,
+		txStore:   txStore,
+		client:    client,
+		tracker:   tracker,
+		keystore:  keystore,
+		builder:   builder,
+		estimator: estimator,
+		feeConfig: feeConfig,
+	}
+}
+
+// Start the NonceReconciler
+func (r *NonceReconciler) Start(context.Context) error {
+	return r.StartOnce("NonceReconciler", func() error {
+		r.lggr.Debugw("started NonceReconciler", "interval", r.interval)
+		r.stopCh = make(chan struct{})
+		r.wg.Add(1)
+		go r.runLoop()
+		return nil
+	})
+}
+
+// Close the NonceReconciler
+func (r *NonceReconciler) Close() error {
+	return r.StopOnce("NonceReconciler", func() error {
+		r.lggr.Debug("closing NonceReconciler")
+		close(r.stopCh)
+		r.wg.Wait()
+		return nil
+	})
+}
+
+func (r *NonceReconciler) Name() string {
+	return r.lggr.Name()
+}
+
+func (r *NonceReconciler) HealthReport() map[string]error {
+	return map[string]error{r.Name(): r.Healthy()}
+}
+
+func (r *NonceReconciler) runLoop() {
+	defer r.wg.Done()
+	ctx, cancel := r.stopCh.NewCtx()
+	defer cancel()
+	ticker := services.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := r.Reconcile(ctx); err != nil {
+				r.lggr.Errorw("Error reconciling nonces", "err", err)
+				r.SvcErrBuffer.Append(err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Reconcile reconciles the nonces of all the enabled keys.
+func (r *NonceReconciler) Reconcile(ctx context.Context) (merr error) {
+	addresses, err := r.keystore.EnabledAddressesForChain(ctx, r.chainID)
+	if err != nil {
+		return fmt.Errorf("failed to get enabled addresses: %w", err)
+	}
+	for _, address := range addresses {
+		if err := r.reconcileAddress(ctx, address); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to reconcile nonces of address %s: %w", address, err))
+		}
+	}
+	return merr
+}
+
+func (r *NonceReconciler) reconcileAddress(ctx context.Context, address common.Address) error {
+	// The local nonce must be read before the stored ones: the nonce tracker only generates the next nonce after a
+	// transaction is saved with the current one.
+	local, err := r.tracker.GetNextSequence(ctx, address)
+	if err != nil {
+		return fmt.Errorf("failed to get local next nonce: %w", err)
+	}
+	mined, err := r.client.SequenceAt(ctx, address, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get mined nonce: %w", err)
+	}
+	pending, err := r.client.PendingSequenceAt(ctx, address)
+	if err != nil {
+		return fmt.Errorf("failed to get pending nonce: %w", err)
+	}
+	audit := NonceReconciliation{
+		EVMChainID:   *ubig.New(r.chainID),
+		Address:      address,
+		LocalNonce:   local,
+		MinedNonce:   mined,
+		PendingNonce: pending,
+	}
+
+	if pending >= local {
+		if pending == local {
+			return nil
+		}
+		// Nonces were used by transactions sent from outside the node, which the stored ones would conflict with
+		r.lggr.Warnw("Address was used outside of the node, fast-forwarding local next nonce", "address", address, "localNonce", local, "minedNonce", mined, "pendingNonce", pending)
+		return r.resync(ctx, audit)
+	}
+
+	// The nonces between the pending and local ones are missing from the mempool, so the stored transactions and the
+	// next ones are queued behind any of them that no stored transaction holds.
+	stored, err := r.txStore.FindNoncesInRange(ctx, address, pending, local, r.chainID)
+	if err != nil {
+		return fmt.Errorf("failed to find stored nonces: %w", err)
+	}
+	if len(stored) == 0 {
+		r.lggr.Warnw("Local next nonce is ahead of the chain with no stored transaction to broadcast, rewinding it", "address", address, "localNonce", local, "minedNonce", mined, "pendingNonce", pending)
+		return r.resync(ctx, audit)
+	}
+	for nonce := pending; nonce < local; nonce++ {
+		if slices.Contains(stored, nonce) {
+			continue
+		}
+		r.lggr.Warnw("Nonce gap is blocking stored transactions, filling it", "address", address, "nonce", nonce, "localNonce", local, "minedNonce", mined, "pendingNonce", pending)
+		audit.Nonce = nonce
+		if err := r.fillGap(ctx, audit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *NonceReconciler) resync(ctx context.Context, audit NonceReconciliation) error {
+	if !r.tracker.ResyncSequence(audit.Address, audit.LocalNonce, audit.PendingNonce) {
+		r.lggr.Debugw("Local next nonce changed while reconciling, skipping resync", "address", audit.Address)
+		return nil
+	}
+	audit.Action = NonceReconciliationResync
+	audit.Nonce = audit.PendingNonce
+	return r.record(ctx, audit)
+}
+
+func (r *NonceReconciler) fillGap(ctx context.Context, audit NonceReconciliation) error {
+	gasLimit := r.feeConfig.LimitDefault()
+	fee, _, err := r.estimator.GetFee(ctx, []byte{}, gasLimit, r.feeConfig.PriceMaxKey(audit.Address), &audit.Address, &audit.Address)
+	if err != nil {
+		return fmt.Errorf("failed to estimate fee of gap filling transaction: %w", err)
+	}
+	if fee.GasPrice == nil {
+		// Empty transactions are legacy ones, which pay their gas price in full
+		fee = gas.EvmFee{GasPrice: fee.GasFeeCap}
+	}
+	txHash, err := r.client.SendEmptyTransaction(ctx, r.builder.NewEmptyTxAttempt, audit.Nonce, gasLimit, fee, audit.Address)
+	if err != nil {
+		return fmt.Errorf("failed to fill nonce gap %d: %w", audit.Nonce, err)
+	}
+	hash := common.HexToHash(txHash)
+	audit.Action = NonceReconciliationFillGap
+	audit.TxHash = &hash
+	return r.record(ctx, audit)
+}
+
+func (r *NonceReconciler) record(ctx context.Context, audit NonceReconciliation) error {
+	promNonceReconciliations.WithLabelValues(r.chainID.String(), string(audit.Action)).Inc()
+	r.lggr.Infow("Reconciled nonce", "address", audit.Address, "action", audit.Action, "nonce", audit.Nonce, "txHash", audit.TxHash)
+	if err := r.txStore.InsertNonceReconciliation(ctx, &audit); err != nil {
+		return fmt.Errorf("failed to record nonce reconciliation: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package txmgr_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+	commontxmmocks "github.com/smartcontractkit/chainlink/v2/common/txmgr/types/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	clientmock "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	gasmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	txstoremock "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+type nonceReconcilerMocks struct {
+	txStore   *txstoremock.EvmTxStore
+	client    *clientmock.Client
+	builder   *commontxmmocks.TxAttemptBuilder[*big.Int, *types.Head, common.Address, common.Hash, common.Hash, types.Nonce, gas.EvmFee]
+	estimator *gasmocks.EvmFeeEstimator
+}
+
+// newTestNonceReconciler returns a NonceReconciler of addr, whose local next nonce is local, and whose mined and pending
+// nonces are mined and pending.
+func newTestNonceReconciler(t *testing.T, addr common.Address, local, mined, pending types.Nonce) (*txmgr.NonceReconciler, txmgr.NonceTracker, nonceReconcilerMocks) {
+	ctx := tests.Context(t)
+	chainID := big.NewInt(0)
+	m := nonceReconcilerMocks{
+		txStore:   txstoremock.NewEvmTxStore(t),
+		client:    clientmock.NewClient(t),
+		builder:   commontxmmocks.NewTxAttemptBuilder[*big.Int, *types.Head, common.Address, common.Hash, common.Hash, types.Nonce, gas.EvmFee](t),
+		estimator: gasmocks.NewEvmFeeEstimator(t),
+	}
+	m.client.On("ConfiguredChainID").Return(chainID)
+	m.client.On("IsL2").Return(false).Maybe()
+	m.client.On("NonceAt", mock.Anything, addr, (*big.Int)(nil)).Return(uint64(mined), nil)
+	m.client.On("PendingNonceAt", mock.Anything, addr).Return(uint64(pending), nil)
+	txmClient := txmgr.NewEvmTxmClient(m.client, nil)
+
+	m.txStore.On("FindLatestSequence", mock.Anything, addr, chainID).Return(local-1, nil).Once()
+	nonceTracker := txmgr.NewNonceTracker(logger.Test(t), m.txStore, txmClient)
+	nonceTracker.LoadNextSequences(ctx, []common.Address{addr})
+
+	ks := commontxmmocks.NewKeyStore[common.Address, *big.Int, types.Nonce](t)
+	ks.On("EnabledAddressesForChain", mock.Anything, chainID).Return([]common.Address{addr}, nil)
+
+	r := txmgr.NewNonceReconciler(logger.Test(t), chainID, time.Minute, m.txStore, txmClient, nonceTracker, ks, m.builder, m.estimator, &txmgr.TestGasEstimatorConfig{})
+	return r, nonceTracker, m
+}
+
+func TestNonceReconciler_Reconcile(t *testing.T) {
+	t.Parallel()
+
+	addr := common.HexToAddress("0xd5e099c71b797516c10ed0f0d895f429c2781142")
+
+	t.Run("does nothing if the local nonce is in sync with the chain", func(t *testing.T) {
+		ctx := tests.Context(t)
+		r, nonceTracker, _ := newTestNonceReconciler(t, addr, 5, 3, 5)
+
+		require.NoError(t, r.Reconcile(ctx))
+		seq, err := nonceTracker.GetNextSequence(ctx, addr)
+		require.NoError(t, err)
+		assert.Equal(t, types.Nonce(5), seq)
+	})
+
+	t.Run("fast-forwards the local nonce if the address was used outside of the node", func(t *testing.T) {
+		ctx := tests.Context(t)
+		r, nonceTracker, m := newTestNonceReconciler(t, addr, 5, 7, 8)
+		m.txStore.On("InsertNonceReconciliation", mock.Anything, mock.MatchedBy(func(r *txmgr.NonceReconciliation) bool {
+			return r.Action == txmgr.NonceReconciliationResync && r.Nonce == 8 && r.LocalNonce == 5 && r.MinedNonce == 7 && r.PendingNonce == 8 && r.TxHash == nil
+		})).Return(nil).Once()
+
+		require.NoError(t, r.Reconcile(ctx))
+		seq, err := nonceTracker.GetNextSequence(ctx, addr)
+		require.NoError(t, err)
+		assert.Equal(t, types.Nonce(8), seq)
+	})
+
+	t.Run("rewinds the local nonce if no stored transaction holds the missing nonces", func(t *testing.T) {
+		ctx := tests.Context(t)
+		r, nonceTracker, m := newTestNonceReconciler(t, addr, 9, 5, 6)
+		m.txStore.On("FindNoncesInRange", mock.Anything, addr, types.Nonce(6), types.Nonce(9), big.NewInt(0)).Return(nil, nil).Once()
+		m.txStore.On("InsertNonceReconciliation", mock.Anything, mock.MatchedBy(func(r *txmgr.NonceReconciliation) bool {
+			return r.Action == txmgr.NonceReconciliationResync && r.Nonce == 6 && r.LocalNonce == 9
+		})).Return(nil).Once()
+
+		require.NoError(t, r.Reconcile(ctx))
+		seq, err := nonceTracker.GetNextSequence(ctx, addr)
+		require.NoError(t, err)
+		assert.Equal(t, types.Nonce(6), seq)
+	})
+
+	t.Run("fills the nonce gaps blocking stored transactions with self-transfers", func(t *testing.T) {
+		ctx := tests.Context(t)
+		r, nonceTracker, m := newTestNonceReconciler(t, addr, 10, 5, 6)
+		m.txStore.On("FindNoncesInRange", mock.Anything, addr, types.Nonce(6), types.Nonce(10), big.NewInt(0)).Return([]types.Nonce{7, 9}, nil).Once()
+		m.estimator.On("GetFee", mock.Anything, []byte{}, uint64(42), assets.NewWeiI(42), &addr, &addr).Return(gas.EvmFee{DynamicFee: gas.DynamicFee{GasFeeCap: assets.NewWeiI(30), GasTipCap: assets.NewWeiI(2)}}, uint64(42), nil).Twice()
+
+		var filled []types.Nonce
+		for _, nonce := range []types.Nonce{6, 8} {
+			_, attempt, _ := newSignedTestAttempt(t, false)
+			m.builder.On("NewEmptyTxAttempt", mock.Anything, nonce, uint64(42), gas.EvmFee{GasPrice: assets.NewWeiI(30)}, addr).Return(attempt, nil).Once()
+			m.txStore.On("InsertNonceReconciliation", mock.Anything, mock.MatchedBy(func(r *txmgr.NonceReconciliation) bool {
+				return r.Action == txmgr.NonceReconciliationFillGap && r.Nonce == nonce && r.TxHash != nil && *r.TxHash == attempt.Hash
+			})).Run(func(args mock.Arguments) {
+				filled = append(filled, args.Get(1).(*txmgr.NonceReconciliation).Nonce)
+			}).Return(nil).Once()
+		}
+		m.client.On("SendTransactionReturnCode", mock.Anything, mock.Anything, addr).Return(commonclient.Successful, nil).Twice()
+
+		require.NoError(t, r.Reconcile(ctx))
+		assert.Equal(t, []types.Nonce{6, 8}, filled)
+		seq, err := nonceTracker.GetNextSequence(ctx, addr)
+		require.NoError(t, err)
+		assert.Equal(t, types.Nonce(10), seq)
+	})
+}
@@ -190,3 +190,15 @@ func (s *nonceTracker) GenerateNextSequence(address common.Address, nonceUsed ev
 	// This scenario should never occur but logging this discrepancy for visibility
 	s.lggr.Warnf("Local nonce map value %d for address %s is ahead of the nonce transmitted %d. Maintaining the existing value in the map without incrementing.", currentNonce, address.String(), nonceUsed)
 }
+
+// ResyncSequence sets the next sequence of address to seq if it is still expected, and returns whether it did.
+// Checking the expected sequence prevents reverting the sequences generated after it was read.
+func (s *nonceTracker) ResyncSequence(address common.Address, expected, seq evmtypes.Nonce) bool {
+	s.sequenceLock.Lock()
+	defer s.sequenceLock.Unlock()
+	if current, exists := s.nextSequenceMap[address]; !exists || current != expected {
+		return false
+	}
+	s.nextSequenceMap[address] = seq
+	return true
+}
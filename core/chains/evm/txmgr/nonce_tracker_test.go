@@ -256,6 +256,35 @@ func TestNonceTracker_GenerateNextSequence(t *testing.T) {
 	require.Equal(t, types.Nonce(randNonce+2), seq) // GenerateNextSequence increases local nonce by 1
 }
 
+func TestNonceTracker_ResyncSequence(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	chainID := big.NewInt(0)
+	txStore := txstoremock.NewEvmTxStore(t)
+
+	client := clientmock.NewClient(t)
+	client.On("ConfiguredChainID").Return(chainID)
+
+	nonceTracker := txmgr.NewNonceTracker(logger.Test(t), txStore, txmgr.NewEvmTxmClient(client, nil))
+
+	addr := common.HexToAddress("0xd5e099c71b797516c10ed0f0d895f429c2781142")
+	require.False(t, nonceTracker.ResyncSequence(addr, 0, 5)) // Address not loaded
+
+	txStore.On("FindLatestSequence", mock.Anything, addr, chainID).Return(types.Nonce(9), nil).Once()
+	nonceTracker.LoadNextSequences(ctx, []common.Address{addr})
+
+	require.False(t, nonceTracker.ResyncSequence(addr, 9, 5)) // Local nonce is not the expected one
+	seq, err := nonceTracker.GetNextSequence(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, types.Nonce(10), seq)
+
+	require.True(t, nonceTracker.ResyncSequence(addr, 10, 5))
+	seq, err = nonceTracker.GetNextSequence(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, types.Nonce(5), seq)
+}
+
 func Test_SetNonceAfterInit(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,97 @@
+package txmgr
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+)
+
+// MessageSigner signs messages following EIP-191 with the key of address.
+type MessageSigner interface {
+	SignMessage(ctx context.Context, address common.Address, message []byte) ([]byte, error)
+}
+
+// privateRelay submits signed transactions to a private relay, like Flashbots Protect, which keeps them out of the
+// public mempool until they are included.
+type privateRelay struct {
+	url        *url.URL
+	method     string
+	httpClient *http.Client
+}
+
+// newPrivateRelay returns a relay sending requests with method to relayURL. The requests are signed with the key of
+// signingAddress if set, in the X-Flashbots-Signature header.
+func newPrivateRelay(relayURL *url.URL, method string, signingAddress *common.Address, signer MessageSigner) *privateRelay {
+	httpClient := &http.Client{}
+	if signingAddress != nil {
+		httpClient.Transport = &flashbotsSigningTransport{base: http.DefaultTransport, address: *signingAddress, signer: signer}
+	}
+	return &privateRelay{url: relayURL, method: method, httpClient: httpClient}
+}
+
+// sendTransaction submits tx to the relay. Bundles target the block after latestBlock.
+func (r *privateRelay) sendTransaction(ctx context.Context, tx *types.Transaction, latestBlock *big.Int) error {
+	txBytes, err := tx.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("failed to marshal tx into canonical encoding: %w", err)
+	}
+	// The relay is only used by the private transactions, so its client is not kept open between them
+	c, err := rpc.DialOptions(ctx, r.url.String(), rpc.WithHTTPClient(r.httpClient))
+	if err != nil {
+		return fmt.Errorf("failed to dial private relay %s: %w", r.url.Redacted(), err)
+	}
+	defer c.Close()
+	var result any
+	switch r.method {
+	case toml.PrivateSubmissionMethodBundle:
+		return c.CallContext(ctx, &result, r.method, map[string]any{
+			"txs":         []string{hexutil.Encode(txBytes)},
+			"blockNumber": hexutil.EncodeBig(new(big.Int).Add(latestBlock, big.NewInt(1))),
+		})
+	default:
+		return c.CallContext(ctx, &result, r.method, map[string]any{
+			"tx": hexutil.Encode(txBytes),
+		})
+	}
+}
+
+// flashbotsSigningTransport signs the body of the requests with the key of address, in the X-Flashbots-Signature
+// header identifying their sender.
+// See: https://docs.flashbots.net/flashbots-auction/advanced/rpc-endpoint#authentication
+type flashbotsSigningTransport struct {
+	base    http.RoundTripper
+	address common.Address
+	signer  MessageSigner
+}
+
+func (t *flashbotsSigningTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read private relay request: %w", err)
+		}
+	}
+	signature, err := t.signer.SignMessage(req.Context(), t.address, []byte(crypto.Keccak256Hash(body).Hex()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign private relay request with %s: %w", t.address, err)
+	}
+	signed := req.Clone(req.Context())
+	signed.Body = io.NopCloser(bytes.NewReader(body))
+	signed.Header.Set("X-Flashbots-Signature", t.address.Hex()+":"+hexutil.Encode(signature))
+	return t.base.RoundTrip(signed)
+}
@@ -140,4 +140,28 @@ func TestReaper_ReapTxes(t *testing.T) {
 		// Now it deleted because the eth_tx was past the age threshold
 		cltest.AssertCount(t, db, "evm.txes", 0)
 	})
+
+	mustInsertConfirmedEthTxWithReceipt(t, txStore, from, 0, 42)
+	mustInsertFatalErrorEthTx(t, txStore, from)
+
+	t.Run("deletes cancelled and simulation failed evm.txes that exceed the age threshold", func(t *testing.T) {
+		tc := &reaperConfig{reaperThreshold: 1 * time.Hour}
+
+		r := newReaper(t, txStore, tc)
+
+		pgtest.MustExec(t, db, `UPDATE evm.txes SET state='cancelled' WHERE state='confirmed'`)
+		pgtest.MustExec(t, db, `UPDATE evm.txes SET state='simulation_failed' WHERE state='fatal_error'`)
+
+		err := r.ReapTxes(42)
+		assert.NoError(t, err)
+		// Didn't delete because eth_tx was not old enough
+		cltest.AssertCount(t, db, "evm.txes", 2)
+
+		pgtest.MustExec(t, db, `UPDATE evm.txes SET created_at=$1`, oneDayAgo)
+
+		err = r.ReapTxes(42)
+		assert.NoError(t, err)
+		// Now it deleted because the eth_tx was past the age threshold
+		cltest.AssertCount(t, db, "evm.txes", 0)
+	})
 }
@@ -3,6 +3,7 @@ package txmgr_test
 import (
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -11,6 +12,8 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
 )
 
@@ -50,3 +53,45 @@ func Test_DropOldestStrategy_PruneQueue(t *testing.T) {
 		assert.Equal(t, []int64{1, 2}, ids)
 	})
 }
+
+func Test_BatchStrategy_BatchTx(t *testing.T) {
+	t.Parallel()
+	subject := uuid.New()
+	target := testutils.NewAddress()
+	mockTxStore := mocks.NewEvmTxStore(t)
+
+	t.Run("does not batch txes to other addresses", func(t *testing.T) {
+		strategy := txmgr.NewMulticall3BatchStrategy(subject, 5, []common.Address{target})
+		batchTxID, batched, err := strategy.BatchTx(tests.Context(t), mockTxStore, 1, testutils.NewAddress().String())
+		require.NoError(t, err)
+		assert.False(t, batched)
+		assert.Zero(t, batchTxID)
+	})
+
+	t.Run("does not batch with a maxBatchSize below 2", func(t *testing.T) {
+		strategy := txmgr.NewMulticall3BatchStrategy(subject, 1, []common.Address{target})
+		_, batched, err := strategy.BatchTx(tests.Context(t), mockTxStore, 1, target.String())
+		require.NoError(t, err)
+		assert.False(t, batched)
+	})
+
+	t.Run("calls BatchUnstartedTx for txes to targets", func(t *testing.T) {
+		strategy := txmgr.NewMulticall3BatchStrategy(subject, 5, []common.Address{target})
+		assert.Equal(t, subject, strategy.Subject().UUID)
+		mockTxStore.On("BatchUnstartedTx", mock.Anything, int64(1), subject, txmgr.Multicall3Address.String(), []string{target.String()}, uint32(5)).Once().Return(int64(3), true, nil)
+		batchTxID, batched, err := strategy.BatchTx(tests.Context(t), mockTxStore, 1, target.String())
+		require.NoError(t, err)
+		assert.True(t, batched)
+		assert.Equal(t, int64(3), batchTxID)
+	})
+
+	t.Run("calls BatchUnstartedTx for txes to the forwarder", func(t *testing.T) {
+		forwarder := testutils.NewAddress()
+		strategy := txmgr.NewForwarderBatchStrategy(subject, 5, forwarder)
+		mockTxStore.On("BatchUnstartedTx", mock.Anything, int64(1), subject, forwarder.String(), []string{forwarder.String()}, uint32(5)).Once().Return(int64(3), true, nil)
+		batchTxID, batched, err := strategy.BatchTx(tests.Context(t), mockTxStore, 1, forwarder.String())
+		require.NoError(t, err)
+		assert.True(t, batched)
+		assert.Equal(t, int64(3), batchTxID)
+	})
+}
@@ -2,8 +2,13 @@ package txmgr
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"math/big"
+	"sort"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -50,7 +55,14 @@ type CheckerFactory struct {
 func (c *CheckerFactory) BuildChecker(spec TransmitCheckerSpec) (TransmitChecker, error) {
 	switch spec.CheckerType {
 	case TransmitCheckerTypeSimulate:
-		return &SimulateChecker{c.Client}, nil
+		var revertABI abi.ABI
+		if spec.RevertABI != "" {
+			var err error
+			if revertABI, err = abi.JSON(strings.NewReader(spec.RevertABI)); err != nil {
+				return nil, pkgerrors.Wrapf(err, "malformed checker, invalid RevertABI")
+			}
+		}
+		return &SimulateChecker{Client: c.Client, RevertABI: revertABI}, nil
 	case TransmitCheckerTypeVRFV1:
 		if spec.VRFCoordinatorAddress == nil {
 			return nil, pkgerrors.Errorf("malformed checker, expected non-nil VRFCoordinatorAddress, got: %v", spec)
@@ -117,9 +129,91 @@ func (noChecker) Check(
 	return nil
 }
 
-// SimulateChecker simulates transactions, producing an error if they revert on chain.
+// NewSimulateCheckerSpec returns the spec of a TransmitCheckerTypeSimulate checker that decodes the custom errors of
+// contractABI in the reverts of simulations.
+func NewSimulateCheckerSpec(contractABI abi.ABI) (TransmitCheckerSpec, error) {
+	revertABI, err := marshalErrorsABI(contractABI)
+	if err != nil {
+		return TransmitCheckerSpec{}, err
+	}
+	return TransmitCheckerSpec{CheckerType: TransmitCheckerTypeSimulate, RevertABI: revertABI}, nil
+}
+
+// abiArgument is the JSON ABI representation of an argument.
+type abiArgument struct {
+	Name       string        `json:"name"`
+	Type       string        `json:"type"`
+	Components []abiArgument `json:"components,omitempty"`
+}
+
+// marshalErrorsABI returns the JSON ABI of the custom errors of contractABI, leaving out its methods and events to
+// keep the specs stored with each transaction small.
+func marshalErrorsABI(contractABI abi.ABI) (string, error) {
+	if len(contractABI.Errors) == 0 {
+		return "", nil
+	}
+	type abiError struct {
+		Type   string        `json:"type"`
+		Name   string        `json:"name"`
+		Inputs []abiArgument `json:"inputs"`
+	}
+	errs := make([]abiError, 0, len(contractABI.Errors))
+	for _, customErr := range contractABI.Errors {
+		inputs := make([]abiArgument, 0, len(customErr.Inputs))
+		for _, input := range customErr.Inputs {
+			inputs = append(inputs, marshalABIArgument(input.Name, input.Type))
+		}
+		errs = append(errs, abiError{Type: "error", Name: customErr.Name, Inputs: inputs})
+	}
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Name < errs[j].Name })
+	b, err := json.Marshal(errs)
+	if err != nil {
+		return "", pkgerrors.Wrap(err, "failed to marshal revert ABI")
+	}
+	return string(b), nil
+}
+
+func marshalABIArgument(name string, t abi.Type) abiArgument {
+	switch t.T {
+	case abi.TupleTy:
+		components := make([]abiArgument, len(t.TupleElems))
+		for i, elem := range t.TupleElems {
+			components[i] = marshalABIArgument(t.TupleRawNames[i], *elem)
+		}
+		return abiArgument{Name: name, Type: "tuple", Components: components}
+	case abi.SliceTy:
+		elem := marshalABIArgument(name, *t.Elem)
+		elem.Type += "[]"
+		return elem
+	case abi.ArrayTy:
+		elem := marshalABIArgument(name, *t.Elem)
+		elem.Type += fmt.Sprintf("[%d]", t.Size)
+		return elem
+	default:
+		return abiArgument{Name: name, Type: t.String()}
+	}
+}
+
+// decodeSimulationRevert returns the reason of the revert jErr of a simulation, decoding the custom errors of
+// revertABI.
+func decodeSimulationRevert(revertABI abi.ABI, jErr *evmclient.JsonError) string {
+	data, ok := evmclient.ExtractRevertData(jErr)
+	if !ok {
+		return jErr.String()
+	}
+	reason, ok := evmclient.DecodeRevertReason(data, revertABI)
+	if !ok {
+		return jErr.String()
+	}
+	return reason
+}
+
+// SimulateChecker simulates transactions, producing an error wrapping txmgr.ErrTxSimulationFailed if they revert on
+// chain, with the decoded revert reason.
 type SimulateChecker struct {
 	Client evmclient.Client
+	// RevertABI defines the custom errors that are decoded in reverts.
+	RevertABI abi.ABI
 }
 
 // Check satisfies the TransmitChecker interface.
@@ -148,9 +242,10 @@ func (s *SimulateChecker) Check(
 	err := s.Client.CallContext(ctx, &b, "eth_call", callArg, evmclient.ToBlockNumArg(nil))
 	if err != nil {
 		if jErr := evmclient.ExtractRPCErrorOrNil(err); jErr != nil {
+			reason := decodeSimulationRevert(s.RevertABI, jErr)
 			l.Criticalw("Transaction reverted during simulation",
-				"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "rpcErr", jErr.String(), "returnValue", b.String())
-			return pkgerrors.Errorf("transaction reverted during simulation: %s", jErr.String())
+				"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "rpcErr", jErr.String(), "reason", reason, "returnValue", b.String())
+			return fmt.Errorf("%w: %s", txmgr.ErrTxSimulationFailed, reason)
 		}
 		l.Warnw("Transaction simulation failed, will attempt to send anyway",
 			"ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err, "returnValue", b.String())
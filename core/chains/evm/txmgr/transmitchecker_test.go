@@ -5,11 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	pkgerrors "github.com/pkg/errors"
@@ -122,6 +125,7 @@ func TestTransmitCheckers(t *testing.T) {
 			FeeLimit:       1e9,
 			CreatedAt:      time.Unix(0, 0),
 			State:          txmgrcommon.TxUnstarted,
+			ChainID:        big.NewInt(0),
 		}
 		attempt := txmgr.TxAttempt{
 			Tx:        tx,
@@ -157,6 +161,54 @@ func TestTransmitCheckers(t *testing.T) {
 			require.EqualError(t, err, expErrMsg)
 		})
 
+		t.Run("revert with a custom error of the spec's revert ABI", func(t *testing.T) {
+			contractABI, err := abi.JSON(strings.NewReader(`[
+				{"type":"function","name":"transmit","inputs":[]},
+				{"type":"error","name":"StaleReport","inputs":[{"name":"epoch","type":"uint32"}]},
+				{"type":"error","name":"InvalidReports","inputs":[{"name":"reports","type":"tuple[]","components":[{"name":"round","type":"uint8"},{"name":"signers","type":"address[2]"}]}]}
+			]`))
+			require.NoError(t, err)
+			spec, err := txmgr.NewSimulateCheckerSpec(contractABI)
+			require.NoError(t, err)
+			require.Equal(t, txmgr.TransmitCheckerTypeSimulate, spec.CheckerType)
+			require.NotContains(t, spec.RevertABI, "transmit")
+
+			revertABI, err := abi.JSON(strings.NewReader(spec.RevertABI))
+			require.NoError(t, err)
+			require.Equal(t, contractABI.Errors["InvalidReports"].ID, revertABI.Errors["InvalidReports"].ID)
+
+			specChecker, err := (&txmgr.CheckerFactory{Client: client}).BuildChecker(spec)
+			require.NoError(t, err)
+
+			args, err := contractABI.Errors["StaleReport"].Inputs.Pack(uint32(12))
+			require.NoError(t, err)
+			jerr := evmclient.JsonError{
+				Code:    3,
+				Message: "execution reverted",
+				Data:    hexutil.Encode(append(contractABI.Errors["StaleReport"].ID.Bytes()[:4], args...)),
+			}
+			client.On("CallContext", mock.Anything,
+				mock.AnythingOfType("*hexutil.Bytes"), "eth_call",
+				mock.Anything, "latest").Return(&jerr).Twice()
+
+			err = specChecker.Check(ctx, log, tx, attempt)
+			require.ErrorIs(t, err, txmgrcommon.ErrTxSimulationFailed)
+			require.EqualError(t, err, "transaction reverted during simulation: StaleReport(12)")
+
+			// Checkers without the revert ABI don't decode the custom error
+			err = checker.Check(ctx, log, tx, attempt)
+			require.ErrorIs(t, err, txmgrcommon.ErrTxSimulationFailed)
+			require.NotContains(t, err.Error(), "StaleReport")
+		})
+
+		t.Run("malformed revert ABI", func(t *testing.T) {
+			_, err := (&txmgr.CheckerFactory{Client: client}).BuildChecker(txmgr.TransmitCheckerSpec{
+				CheckerType: txmgr.TransmitCheckerTypeSimulate,
+				RevertABI:   "not an ABI",
+			})
+			require.ErrorContains(t, err, "invalid RevertABI")
+		})
+
 		t.Run("non revert error", func(t *testing.T) {
 			client.On("CallContext", mock.Anything,
 				mock.AnythingOfType("*hexutil.Bytes"), "eth_call",
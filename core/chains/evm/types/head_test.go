@@ -54,6 +54,54 @@ func TestHead_LatestFinalizedHead(t *testing.T) {
 	}
 }
 
+func TestHead_LatestSafeHead(t *testing.T) {
+	t.Parallel()
+	newHead := func(num int64, safe, finalized bool) *Head {
+		result := &Head{Number: num}
+		result.IsSafe.Store(safe)
+		result.IsFinalized.Store(finalized)
+		return result
+	}
+	cases := []struct {
+		Name string
+		Head *Head
+		Safe *Head
+	}{
+		{
+			Name: "Empty chain returns nil on safe",
+			Head: nil,
+			Safe: nil,
+		},
+		{
+			Name: "Chain without safe or finalized returns nil",
+			Head: sliceToChain(&Head{}, &Head{}, &Head{}),
+			Safe: nil,
+		},
+		{
+			Name: "Returns first safe block in chain",
+			Head: sliceToChain(&Head{Number: 4}, newHead(3, true, false), newHead(2, true, true)),
+			Safe: &Head{Number: 3},
+		},
+		{
+			Name: "Falls back to first finalized block in chain",
+			Head: sliceToChain(&Head{Number: 3}, newHead(2, false, true), newHead(1, false, true)),
+			Safe: &Head{Number: 2},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			actual := tc.Head.LatestSafeHead()
+			if tc.Safe == nil {
+				assert.Nil(t, actual)
+			} else {
+				require.NotNil(t, actual)
+				assert.Equal(t, tc.Safe.Number, actual.BlockNumber())
+			}
+		})
+	}
+}
+
 func TestHead_ChainString(t *testing.T) {
 	cases := []struct {
 		Name           string
@@ -49,6 +49,7 @@ type Head struct {
 	Difficulty       *big.Int
 	TotalDifficulty  *big.Int
 	IsFinalized      atomic.Bool
+	IsSafe           atomic.Bool
 }
 
 var _ commontypes.Head[common.Hash] = &Head{}
@@ -174,6 +175,16 @@ func (h *Head) LatestFinalizedHead() commontypes.Head[common.Hash] {
 	return nil
 }
 
+// LatestSafeHead returns the latest head marked as safe, or as finalized, as finalized heads are safe.
+func (h *Head) LatestSafeHead() commontypes.Head[common.Hash] {
+	for cur := h; cur != nil; cur = cur.Parent.Load() {
+		if cur.IsSafe.Load() || cur.IsFinalized.Load() {
+			return cur
+		}
+	}
+	return nil
+}
+
 func (h *Head) ChainID() *big.Int {
 	return h.EVMChainID.ToInt()
 }
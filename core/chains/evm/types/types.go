@@ -248,6 +248,8 @@ type Confirmations int
 const (
 	Finalized   = Confirmations(-1)
 	Unconfirmed = Confirmations(0)
+	// Safe maps to the latest safe block, which is finalized on chains that do not support the safe block tag.
+	Safe = Confirmations(-2)
 )
 
 // Log represents a contract log event.
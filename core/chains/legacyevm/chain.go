@@ -7,6 +7,7 @@ import (
 	"math/big"
 	"strconv"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	gotoml "github.com/pelletier/go-toml/v2"
 	"go.uber.org/multierr"
 
@@ -50,6 +51,8 @@ type Chain interface {
 	BalanceMonitor() monitor.BalanceMonitor
 	LogPoller() logpoller.LogPoller
 	GasEstimator() gas.EvmFeeEstimator
+	// KeyPool returns the pool assigning the sending keys of new transactions, or nil if disabled.
+	KeyPool() *txmgr.KeyPool
 }
 
 var (
@@ -115,6 +118,8 @@ type chain struct {
 	logBroadcaster  log.Broadcaster
 	logPoller       logpoller.LogPoller
 	balanceMonitor  monitor.BalanceMonitor
+	topUpper        *monitor.TopUpper
+	keyPool         *txmgr.KeyPool
 	keyStore        keystore.Eth
 	gasEstimator    gas.EvmFeeEstimator
 }
@@ -260,10 +265,27 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 
 	var balanceMonitor monitor.BalanceMonitor
 	if opts.AppConfig.EVMRPCEnabled() && cfg.EVM().BalanceMonitor().Enabled() {
-		balanceMonitor = monitor.NewBalanceMonitor(client, opts.KeyStore, l)
+		balanceMonitor = monitor.NewBalanceMonitor(client, opts.KeyStore, cfg.EVM().BalanceMonitor().LowBalanceThreshold(), l)
 		headBroadcaster.Subscribe(balanceMonitor)
 	}
 
+	var topUpper *monitor.TopUpper
+	if balanceMonitor != nil && cfg.EVM().BalanceMonitor().TopUp().TreasuryAddress() != nil {
+		topUpper = monitor.NewTopUpper(chainID, cfg.EVM().BalanceMonitor().TopUp(), cfg.EVM().GasEstimator().LimitTransfer(),
+			balanceMonitor, opts.KeyStore, txm, monitor.NewTopUpORM(opts.DS), l)
+		headBroadcaster.Subscribe(topUpper)
+	}
+
+	var keyPool *txmgr.KeyPool
+	if opts.AppConfig.EVMRPCEnabled() && cfg.EVM().Transactions().KeyPool().Enabled() {
+		// The treasury key only sends the top-ups of the other keys
+		var excluded []gethcommon.Address
+		if treasury := cfg.EVM().BalanceMonitor().TopUp().TreasuryAddress(); treasury != nil {
+			excluded = append(excluded, treasury.Address())
+		}
+		keyPool = txmgr.NewKeyPool(l, txmgr.NewTxStore(opts.DS, l), opts.KeyStore, balanceMonitor, cfg.EVM().Transactions().KeyPool().MaxInFlightPerKey(), excluded...)
+	}
+
 	var logBroadcaster log.Broadcaster
 	if !opts.AppConfig.EVMRPCEnabled() {
 		logBroadcaster = &log.NullBroadcaster{ErrMsg: fmt.Sprintf("Ethereum is disabled for chain %d", chainID)}
@@ -294,6 +316,8 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 		logBroadcaster:  logBroadcaster,
 		logPoller:       logPoller,
 		balanceMonitor:  balanceMonitor,
+		topUpper:        topUpper,
+		keyPool:         keyPool,
 		keyStore:        opts.KeyStore,
 		gasEstimator:    gasEstimator,
 	}, nil
@@ -322,6 +346,11 @@ func (c *chain) Start(ctx context.Context) error {
 				return err
 			}
 		}
+		if c.topUpper != nil {
+			if err := ms.Start(ctx, c.topUpper); err != nil {
+				return err
+			}
+		}
 
 		return nil
 	})
@@ -331,9 +360,13 @@ func (c *chain) Close() error {
 	return c.StopOnce("Chain", func() (merr error) {
 		c.logger.Debug("Chain: stopping")
 
+		if c.topUpper != nil {
+			c.logger.Debug("Chain: stopping top-upper")
+			merr = c.topUpper.Close()
+		}
 		if c.balanceMonitor != nil {
 			c.logger.Debug("Chain: stopping balance monitor")
-			merr = c.balanceMonitor.Close()
+			merr = multierr.Combine(merr, c.balanceMonitor.Close())
 		}
 		c.logger.Debug("Chain: stopping logBroadcaster")
 		merr = multierr.Combine(merr, c.logBroadcaster.Close())
@@ -361,6 +394,9 @@ func (c *chain) Ready() (merr error) {
 	if c.balanceMonitor != nil {
 		merr = multierr.Combine(merr, c.balanceMonitor.Ready())
 	}
+	if c.topUpper != nil {
+		merr = multierr.Combine(merr, c.topUpper.Ready())
+	}
 	return
 }
 
@@ -378,6 +414,9 @@ func (c *chain) HealthReport() map[string]error {
 	if c.balanceMonitor != nil {
 		services.CopyHealth(report, c.balanceMonitor.HealthReport())
 	}
+	if c.topUpper != nil {
+		services.CopyHealth(report, c.topUpper.HealthReport())
+	}
 
 	return report
 }
@@ -471,3 +510,4 @@ func (c *chain) HeadTracker() httypes.HeadTracker         { return c.headTracker
 func (c *chain) Logger() logger.Logger                    { return c.logger }
 func (c *chain) BalanceMonitor() monitor.BalanceMonitor   { return c.balanceMonitor }
 func (c *chain) GasEstimator() gas.EvmFeeEstimator        { return c.gasEstimator }
+func (c *chain) KeyPool() *txmgr.KeyPool                  { return c.keyPool }
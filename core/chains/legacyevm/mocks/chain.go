@@ -519,6 +519,53 @@ func (_c *Chain_ID_Call) RunAndReturn(run func() *big.Int) *Chain_ID_Call {
 	return _c
 }
 
+// KeyPool provides a mock function with given fields:
+func (_m *Chain) KeyPool() *txmgr.KeyPool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for KeyPool")
+	}
+
+	var r0 *txmgr.KeyPool
+	if rf, ok := ret.Get(0).(func() *txmgr.KeyPool); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*txmgr.KeyPool)
+		}
+	}
+
+	return r0
+}
+
+// Chain_KeyPool_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KeyPool'
+type Chain_KeyPool_Call struct {
+	*mock.Call
+}
+
+// KeyPool is a helper method to define mock.On call
+func (_e *Chain_Expecter) KeyPool() *Chain_KeyPool_Call {
+	return &Chain_KeyPool_Call{Call: _e.mock.On("KeyPool")}
+}
+
+func (_c *Chain_KeyPool_Call) Run(run func()) *Chain_KeyPool_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Chain_KeyPool_Call) Return(_a0 *txmgr.KeyPool) *Chain_KeyPool_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Chain_KeyPool_Call) RunAndReturn(run func() *txmgr.KeyPool) *Chain_KeyPool_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LatestHead provides a mock function with given fields: ctx
 func (_m *Chain) LatestHead(ctx context.Context) (types.Head, error) {
 	ret := _m.Called(ctx)
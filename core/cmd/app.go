@@ -185,6 +185,11 @@ func NewApp(s *Shell) *cli.App {
 			Usage:       "Commands for managing Jobs",
 			Subcommands: initJobsSubCmds(s),
 		},
+		{
+			Name:        "fragments",
+			Usage:       "Commands for managing reusable pipeline fragments",
+			Subcommands: initPipelineFragmentsSubCmds(s),
+		},
 		{
 			Name:  "keys",
 			Usage: "Commands for managing various types of keys used by the Chainlink node",
@@ -205,6 +210,11 @@ func NewApp(s *Shell) *cli.App {
 				initVRFKeysSubCmd(s),
 			},
 		},
+		{
+			Name:        "logpoller",
+			Usage:       "Commands for the LogPoller of EVM chains",
+			Subcommands: initLogPollerSubCmds(s),
+		},
 		{
 			Name:        "node",
 			Aliases:     []string{"local"},
@@ -11,6 +11,7 @@ import (
 	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
 func initBlocksSubCmds(s *Shell) []cli.Command {
@@ -48,6 +49,21 @@ func initBlocksSubCmds(s *Shell) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:   "reorgs",
+			Usage:  "List the reorgs detected by the head tracker in descending order",
+			Action: s.IndexReorgs,
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:  "evm-chain-id",
+					Usage: "Chain ID of the EVM-based blockchain",
+				},
+				cli.IntFlag{
+					Name:  "page",
+					Usage: "page of results to display",
+				},
+			},
+		},
 	}
 }
 
@@ -133,3 +149,38 @@ func (s *Shell) FindLCA(c *cli.Context) (err error) {
 
 	return s.renderAPIResponse(resp, &LCAPresenter{}, "Last Common Ancestor")
 }
+
+type ReorgPresenter struct {
+	JAID
+	presenters.ReorgResource
+}
+
+type ReorgPresenters []ReorgPresenter
+
+// RenderTable implements TableRenderer
+func (ps ReorgPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Chain ID", "Depth", "From Block", "To Block", "Old Head Hash", "New Head Hash", "Detected At"})
+	for _, p := range ps {
+		table.Append([]string{
+			p.EVMChainID.String(),
+			strconv.FormatInt(p.Depth, 10),
+			p.FromBlock,
+			p.ToBlock,
+			p.OldHeadHash.Hex(),
+			p.NewHeadHash.Hex(),
+			p.DetectedAt.String(),
+		})
+	}
+
+	render("EVM Reorgs", table)
+	return nil
+}
+
+// IndexReorgs returns the list of reorgs in descending order, taking optional evm-chain-id and page parameters
+func (s *Shell) IndexReorgs(c *cli.Context) error {
+	v := url.Values{}
+	if c.IsSet("evm-chain-id") {
+		v.Add("evmChainID", fmt.Sprintf("%d", c.Int64("evm-chain-id")))
+	}
+	return s.getPage("/v2/reorgs?"+v.Encode(), c.Int("page"), &ReorgPresenters{})
+}
@@ -1,8 +1,12 @@
 package cmd
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
@@ -16,6 +20,7 @@ func initBrideSubCmds(s *Shell) []cli.Command {
 			Name:   "create",
 			Usage:  "Create a new Bridge to an External Adapter",
 			Action: s.CreateBridge,
+			Flags:  bridgeAuthFlags(),
 		},
 		{
 			Name:   "destroy",
@@ -38,6 +43,41 @@ func initBrideSubCmds(s *Shell) []cli.Command {
 			Usage:  "Show a Bridge's details",
 			Action: s.ShowBridge,
 		},
+		{
+			Name:   "update",
+			Usage:  "Update a Bridge to an External Adapter",
+			Action: s.UpdateBridge,
+			Flags:  bridgeAuthFlags(),
+		},
+	}
+}
+
+func bridgeAuthFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  "auth-mode",
+			Usage: "how requests to the bridge are authenticated: token, hmac or mtls",
+		},
+		cli.StringFlag{
+			Name:  "hmac-secret-file",
+			Usage: "file holding the secret that requests are signed with in hmac mode",
+		},
+		cli.BoolFlag{
+			Name:  "verify-responses",
+			Usage: "require responses to be signed with the hmac secret",
+		},
+		cli.StringFlag{
+			Name:  "tls-client-cert-file",
+			Usage: "PEM encoded client certificate presented in mtls mode",
+		},
+		cli.StringFlag{
+			Name:  "tls-client-key-file",
+			Usage: "PEM encoded key of the client certificate",
+		},
+		cli.StringFlag{
+			Name:  "tls-root-ca-file",
+			Usage: "PEM encoded CA certificates that the bridge's certificate is checked against, defaults to the system roots",
+		},
 	}
 }
 
@@ -52,27 +92,61 @@ func (p *BridgePresenter) FriendlyConfirmations() string {
 
 // RenderTable implements TableRenderer
 func (p *BridgePresenter) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"Name", "URL", "Default Confirmations", "Outgoing Token"})
+	table := rt.newTable([]string{"Name", "URL", "Default Confirmations", "Outgoing Token", "Auth Mode", "Verify Responses"})
 	table.Append([]string{
 		p.Name,
 		p.URL,
 		p.FriendlyConfirmations(),
 		p.OutgoingToken,
+		string(p.AuthMode),
+		strconv.FormatBool(p.VerifyResponses),
 	})
 	render("Bridge", table)
+
+	cacheTable := rt.newTable([]string{"Cache TTL", "Stale While Revalidate", "Stale If Error"})
+	cacheTable.Append([]string{
+		p.CacheTTL.Duration().String(),
+		p.CacheStaleWhileRevalidate.Duration().String(),
+		p.CacheStaleIfError.Duration().String(),
+	})
+	render("Cache Policy", cacheTable)
+
+	if p.Circuit != nil {
+		var retryAt string
+		if p.Circuit.RetryAt != nil {
+			retryAt = p.Circuit.RetryAt.String()
+		}
+		circuitTable := rt.newTable([]string{"State", "Consecutive Failures", "Retry At", "Last Error"})
+		circuitTable.Append([]string{
+			string(p.Circuit.State),
+			strconv.Itoa(p.Circuit.ConsecutiveFailures),
+			retryAt,
+			p.Circuit.LastError,
+		})
+		render("Circuit Breaker", circuitTable)
+	}
 	return nil
 }
 
+// FriendlyCircuitState returns the state of the circuit breaker, if known
+func (p *BridgePresenter) FriendlyCircuitState() string {
+	if p.Circuit == nil {
+		return ""
+	}
+	return string(p.Circuit.State)
+}
+
 type BridgePresenters []BridgePresenter
 
 // RenderTable implements TableRenderer
 func (ps BridgePresenters) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"Name", "URL", "Confirmations"})
+	table := rt.newTable([]string{"Name", "URL", "Confirmations", "Circuit"})
 	for _, p := range ps {
 		table.Append([]string{
 			p.Name,
 			p.URL,
 			p.FriendlyConfirmations(),
+			p.FriendlyCircuitState(),
 		})
 	}
 
@@ -110,7 +184,7 @@ func (s *Shell) CreateBridge(c *cli.Context) (err error) {
 		return s.errorOut(errors.New("must pass in the bridge's parameters [JSON blob | JSON filepath]"))
 	}
 
-	buf, err := getBufferFromJSON(c.Args().First())
+	buf, err := bridgeRequestFromArgs(c, c.Args().First())
 	if err != nil {
 		return s.errorOut(err)
 	}
@@ -128,6 +202,73 @@ func (s *Shell) CreateBridge(c *cli.Context) (err error) {
 	return s.renderAPIResponse(resp, &BridgePresenter{})
 }
 
+// UpdateBridge updates a bridge of the chainlink node. The secrets of its
+// auth mode are kept when they are not passed, unless the auth mode changes.
+func (s *Shell) UpdateBridge(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return s.errorOut(errors.New("must pass the name of the bridge and its parameters [JSON blob | JSON filepath]"))
+	}
+
+	buf, err := bridgeRequestFromArgs(c, c.Args().Get(1))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Patch(s.ctx(), "/v2/bridge_types/"+c.Args().First(), buf)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &BridgePresenter{}, "Bridge updated")
+}
+
+// bridgeRequestFromArgs reads the bridge's parameters from a JSON blob or
+// filepath, and sets the auth settings passed as flags.
+func bridgeRequestFromArgs(c *cli.Context, arg string) (*bytes.Buffer, error) {
+	buf, err := getBufferFromJSON(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	var request map[string]interface{}
+	if err = json.Unmarshal(buf.Bytes(), &request); err != nil {
+		return nil, err
+	}
+	if mode := c.String("auth-mode"); mode != "" {
+		request["authMode"] = mode
+	}
+	if c.IsSet("verify-responses") {
+		request["verifyResponses"] = c.Bool("verify-responses")
+	}
+	for flag, field := range map[string]string{
+		"hmac-secret-file":     "hmacSecret",
+		"tls-client-cert-file": "tlsClientCert",
+		"tls-client-key-file":  "tlsClientKey",
+		"tls-root-ca-file":     "tlsRootCA",
+	} {
+		path := c.String(flag)
+		if path == "" {
+			continue
+		}
+		contents, ferr := fromFile(path)
+		if ferr != nil {
+			return nil, fmt.Errorf("error reading %s '%s': %w", flag, path, ferr)
+		}
+		request[field] = strings.TrimSpace(contents.String())
+	}
+
+	b, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewBuffer(b), nil
+}
+
 // RemoveBridge removes a specific Bridge by name.
 func (s *Shell) RemoveBridge(c *cli.Context) (err error) {
 	if !c.Args().Present() {
@@ -3,6 +3,8 @@ package cmd_test
 import (
 	"bytes"
 	"flag"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -124,6 +126,8 @@ func TestShell_ShowBridge(t *testing.T) {
 	assert.Equal(t, bt.Name.String(), p.Name)
 	assert.Equal(t, bt.URL.String(), p.URL)
 	assert.Equal(t, bt.Confirmations, p.Confirmations)
+	require.NotNil(t, p.Circuit)
+	assert.Equal(t, bridges.CircuitClosed, p.Circuit.State)
 }
 
 func TestShell_CreateBridge(t *testing.T) {
@@ -163,6 +167,67 @@ func TestShell_CreateBridge(t *testing.T) {
 	}
 }
 
+func TestShell_UpdateBridge(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	bt := &bridges.BridgeType{
+		Name:          bridges.MustParseBridgeName(testutils.RandomizeName("updatebridge")),
+		URL:           cltest.WebURL(t, "https://testing.com/bridges"),
+		Confirmations: 0,
+	}
+	require.NoError(t, app.BridgeORM().CreateBridgeType(testutils.Context(t), bt))
+
+	secretFile := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(secretFile, []byte("0123456789abcdef0123456789abcdef\n"), 0600))
+	params := `{ "name": "` + bt.Name.String() + `", "url": "https://testing.com/bridges/v2" }`
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.UpdateBridge, set, "")
+	require.NoError(t, set.Parse([]string{"--auth-mode", "hmac", "--hmac-secret-file", secretFile, "--verify-responses", bt.Name.String(), params}))
+	require.NoError(t, client.UpdateBridge(cli.NewContext(nil, set, nil)))
+
+	require.Len(t, r.Renders, 1)
+	p := r.Renders[0].(*cmd.BridgePresenter)
+	assert.Equal(t, "https://testing.com/bridges/v2", p.URL)
+	assert.Equal(t, bridges.AuthModeHMAC, p.AuthMode)
+	assert.True(t, p.VerifyResponses)
+
+	updated, err := app.BridgeORM().FindBridge(testutils.Context(t), bt.Name)
+	require.NoError(t, err)
+	assert.Equal(t, "0123456789abcdef0123456789abcdef", updated.HMACSecret)
+
+	// the secret is kept while the auth mode stays the same
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.UpdateBridge, set, "")
+	require.NoError(t, set.Parse([]string{"--auth-mode", "hmac", bt.Name.String(), params}))
+	require.NoError(t, client.UpdateBridge(cli.NewContext(nil, set, nil)))
+
+	updated, err = app.BridgeORM().FindBridge(testutils.Context(t), bt.Name)
+	require.NoError(t, err)
+	assert.Equal(t, "0123456789abcdef0123456789abcdef", updated.HMACSecret)
+	assert.True(t, updated.VerifyResponses)
+
+	// response verification can be turned off explicitly
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.UpdateBridge, set, "")
+	require.NoError(t, set.Parse([]string{"--verify-responses=false", bt.Name.String(), params}))
+	require.NoError(t, client.UpdateBridge(cli.NewContext(nil, set, nil)))
+
+	updated, err = app.BridgeORM().FindBridge(testutils.Context(t), bt.Name)
+	require.NoError(t, err)
+	assert.Equal(t, bridges.AuthModeHMAC, updated.AuthMode)
+	assert.False(t, updated.VerifyResponses)
+
+	// mtls requires a client certificate
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.UpdateBridge, set, "")
+	require.NoError(t, set.Parse([]string{"--auth-mode", "mtls", bt.Name.String(), params}))
+	require.Error(t, client.UpdateBridge(cli.NewContext(nil, set, nil)))
+}
+
 func TestShell_RemoveBridge(t *testing.T) {
 	t.Parallel()
 
@@ -1,9 +1,14 @@
 package cmd
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
+var evmNodeHeaders = []string{"Name", "Chain ID", "State", "Latency", "Error Rate", "Score", "Config"}
+
 // EVMNodePresenter implements TableRenderer for an EVMNodeResource.
 type EVMNodePresenter struct {
 	presenters.EVMNodeResource
@@ -11,14 +16,20 @@ type EVMNodePresenter struct {
 
 // ToRow presents the EVMNodeResource as a slice of strings.
 func (p *EVMNodePresenter) ToRow() []string {
-	return []string{p.Name, p.ChainID, p.State, p.Config}
+	var latency, errorRate, score string
+	if p.Score != nil {
+		latency = p.Score.Latency
+		errorRate = fmt.Sprintf("%.2f%%", p.Score.ErrorRate*100)
+		score = time.Duration(p.Score.Score * float64(time.Second)).Round(time.Microsecond).String()
+	}
+	return []string{p.Name, p.ChainID, p.State, latency, errorRate, score, p.Config}
 }
 
 // RenderTable implements TableRenderer
 func (p EVMNodePresenter) RenderTable(rt RendererTable) error {
 	var rows [][]string
 	rows = append(rows, p.ToRow())
-	renderList(nodeHeaders, rows, rt.Writer)
+	renderList(evmNodeHeaders, rows, rt.Writer)
 
 	return nil
 }
@@ -34,7 +45,7 @@ func (ps EVMNodePresenters) RenderTable(rt RendererTable) error {
 		rows = append(rows, p.ToRow())
 	}
 
-	renderList(nodeHeaders, rows, rt.Writer)
+	renderList(evmNodeHeaders, rows, rt.Writer)
 
 	return nil
 }
@@ -4,16 +4,20 @@ import (
 	"bytes"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pelletier/go-toml/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	evmcfg "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/cmd"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
 func assertTableRenders(t *testing.T, r *cltest.RendererMock) {
@@ -78,17 +82,32 @@ func TestShell_IndexEVMNodes(t *testing.T) {
 	rt := cmd.RendererTable{b}
 	require.NoError(t, nodes.RenderTable(rt))
 	renderLines := strings.Split(b.String(), "\n")
-	assert.Equal(t, 23, len(renderLines))
+	assert.Equal(t, 29, len(renderLines))
 	assert.Contains(t, renderLines[2], "Name")
 	assert.Contains(t, renderLines[2], n1.Name)
 	assert.Contains(t, renderLines[3], "Chain ID")
 	assert.Contains(t, renderLines[3], n1.ChainID)
 	assert.Contains(t, renderLines[4], "State")
 	assert.Contains(t, renderLines[4], n1.State)
-	assert.Contains(t, renderLines[12], "Name")
-	assert.Contains(t, renderLines[12], n2.Name)
-	assert.Contains(t, renderLines[13], "Chain ID")
-	assert.Contains(t, renderLines[13], n2.ChainID)
-	assert.Contains(t, renderLines[14], "State")
-	assert.Contains(t, renderLines[14], n2.State)
+	assert.Contains(t, renderLines[5], "Latency")
+	assert.Contains(t, renderLines[6], "Error Rate")
+	assert.Contains(t, renderLines[7], "Score")
+	assert.Contains(t, renderLines[15], "Name")
+	assert.Contains(t, renderLines[15], n2.Name)
+	assert.Contains(t, renderLines[16], "Chain ID")
+	assert.Contains(t, renderLines[16], n2.ChainID)
+	assert.Contains(t, renderLines[17], "State")
+	assert.Contains(t, renderLines[17], n2.State)
+}
+
+func TestEVMNodePresenter_ToRow(t *testing.T) {
+	t.Parallel()
+
+	p := cmd.EVMNodePresenter{EVMNodeResource: presenters.EVMNodeResource{
+		NodeResource: presenters.NodeResource{ChainID: "1", Name: "node", State: "Alive", Config: "cfg"},
+	}}
+	assert.Equal(t, []string{"node", "1", "Alive", "", "", "", "cfg"}, p.ToRow())
+
+	p.Score = presenters.NewEVMNodeScore(commonclient.NodeScore{Latency: 90 * time.Millisecond, ErrorRate: 0.1, Samples: 10})
+	assert.Equal(t, []string{"node", "1", "Alive", "90ms", "10.00%", "100ms", "cfg"}, p.ToRow())
 }
@@ -62,6 +62,17 @@ func initEVMTxSubCmd(s *Shell) cli.Command {
 				Usage:  "get information on a specific Ethereum Transaction",
 				Action: s.ShowTransaction,
 			},
+			{
+				Name:   "reconciliations",
+				Usage:  "List the actions taken to reconcile the nonces of the keys with the chain in descending order",
+				Action: s.IndexNonceReconciliations,
+				Flags: []cli.Flag{
+					cli.IntFlag{
+						Name:  "page",
+						Usage: "page of results to display",
+					},
+				},
+			},
 		},
 	}
 }
@@ -111,6 +122,44 @@ func (s *Shell) IndexTransactions(c *cli.Context) error {
 	return s.getPage("/v2/transactions/evm", c.Int("page"), &EthTxPresenters{})
 }
 
+type NonceReconciliationPresenter struct {
+	JAID
+	presenters.NonceReconciliationResource
+}
+
+type NonceReconciliationPresenters []NonceReconciliationPresenter
+
+// RenderTable implements TableRenderer
+func (ps NonceReconciliationPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Chain ID", "Address", "Action", "Nonce", "Local Nonce", "Mined Nonce", "Pending Nonce", "Tx Hash", "Created At"})
+	for _, p := range ps {
+		txHash := ""
+		if p.TxHash != nil {
+			txHash = p.TxHash.Hex()
+		}
+		table.Append([]string{
+			p.EVMChainID.String(),
+			p.Address.Hex(),
+			p.Action,
+			p.Nonce,
+			p.LocalNonce,
+			p.MinedNonce,
+			p.PendingNonce,
+			txHash,
+			p.CreatedAt.String(),
+		})
+	}
+
+	render("EVM Nonce Reconciliations", table)
+	return nil
+}
+
+// IndexNonceReconciliations returns the list of nonce reconciliations in descending order,
+// taking an optional page parameter
+func (s *Shell) IndexNonceReconciliations(c *cli.Context) error {
+	return s.getPage("/v2/transactions/evm/reconciliations", c.Int("page"), &NonceReconciliationPresenters{})
+}
+
 // ShowTransaction returns the info for the given transaction hash
 func (s *Shell) ShowTransaction(c *cli.Context) (err error) {
 	if !c.Args().Present() {
@@ -50,6 +50,21 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Trigger a job run",
 			Action: s.TriggerPipelineRun,
 		},
+		{
+			Name:   "simulate",
+			Usage:  "Dry-run the pipeline of a job spec, answering external tasks from fixtures",
+			Action: s.SimulateJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "fixtures",
+					Usage: "JSON string or path to a JSON file with responses keyed by task ID, URL or bridge name",
+				},
+				cli.StringFlag{
+					Name:  "vars",
+					Usage: "JSON string or path to a JSON file with the pipeline input variables",
+				},
+			},
+		},
 	}
 }
 
@@ -209,6 +224,31 @@ func (ps JobPresenters) RenderTable(rt RendererTable) error {
 	return nil
 }
 
+// SimulatedRunPresenter wraps the JSONAPI pipeline run resource returned by a
+// job simulation and renders every task run.
+type SimulatedRunPresenter struct {
+	JAID
+	presenters.PipelineRunResource
+}
+
+// RenderTable implements TableRenderer
+func (p *SimulatedRunPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Task", "Type", "Output", "Error"})
+	for _, tr := range p.TaskRuns {
+		var output, errStr string
+		if tr.Output != nil {
+			output = *tr.Output
+		}
+		if tr.Error != nil {
+			errStr = *tr.Error
+		}
+		table.Append([]string{tr.DotID, string(tr.Type), output, errStr})
+	}
+
+	render("Simulated Run", table)
+	return nil
+}
+
 // ListJobs lists all jobs
 func (s *Shell) ListJobs(c *cli.Context) (err error) {
 	return s.getPage("/v2/jobs", c.Int("page"), &JobPresenters{})
@@ -313,3 +353,65 @@ func (s *Shell) TriggerPipelineRun(c *cli.Context) error {
 	err = s.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// SimulateJob executes the pipeline of a job spec on the node without saving
+// the job, answering http, bridge, ethcall, ethtx and estimategaslimit tasks
+// from the given fixtures. It fails if the simulated run has fatal errors.
+// Valid input is a TOML string or a path to TOML file
+func (s *Shell) SimulateJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	request := web.SimulateJobRequest{TOML: tomlString}
+	if c.IsSet("fixtures") {
+		buf, ferr := getBufferFromJSON(c.String("fixtures"))
+		if ferr != nil {
+			return s.errorOut(ferr)
+		}
+		request.Fixtures, err = pipeline.ParseSimulationFixtures(buf.Bytes())
+		if err != nil {
+			return s.errorOut(err)
+		}
+	}
+	if c.IsSet("vars") {
+		buf, verr := getBufferFromJSON(c.String("vars"))
+		if verr != nil {
+			return s.errorOut(verr)
+		}
+		if err = json.Unmarshal(buf.Bytes(), &request.Vars); err != nil {
+			return s.errorOut(errors.Wrap(err, "failed to parse vars"))
+		}
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/simulate", bytes.NewReader(body))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var run SimulatedRunPresenter
+	if err = s.renderAPIResponse(resp, &run); err != nil {
+		return err
+	}
+	for _, fatal := range run.FatalErrors {
+		if fatal != nil {
+			return s.errorOut(errors.Errorf("simulated run failed: %s", *fatal))
+		}
+	}
+	return nil
+}
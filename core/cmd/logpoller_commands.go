@@ -0,0 +1,167 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initLogPollerSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "backfill",
+			Usage: "Commands for the backfills of the logs of LogPoller filters",
+			Subcommands: []cli.Command{
+				{
+					Name:   "start",
+					Usage:  "Start a parallel backfill of the logs of a filter, which resumes after failures and restarts",
+					Action: s.StartLogPollerBackfill,
+					Flags: []cli.Flag{
+						cli.Int64Flag{
+							Name:     "evm-chain-id",
+							Usage:    "Chain ID of the EVM-based blockchain",
+							Required: true,
+						},
+						cli.StringFlag{
+							Name:     "filter",
+							Usage:    "Name of the LogPoller filter to backfill",
+							Required: true,
+						},
+						cli.Int64Flag{
+							Name:     "from-block",
+							Usage:    "Block number to backfill from",
+							Required: true,
+						},
+					},
+				},
+				{
+					Name:   "status",
+					Usage:  "Show the progress of the backfills of a chain",
+					Action: s.ShowLogPollerBackfills,
+					Flags: []cli.Flag{
+						cli.Int64Flag{
+							Name:     "evm-chain-id",
+							Usage:    "Chain ID of the EVM-based blockchain",
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type LogPollerBackfillPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.LogPollerBackfillResource
+}
+
+var logPollerBackfillHeaders = []string{"Filter", "From Block", "To Block", "Next Block", "Progress", "Last Error", "Completed At"}
+
+// ToRow presents the LogPollerBackfillResource as a slice of strings.
+func (p *LogPollerBackfillPresenter) ToRow() []string {
+	completedAt := ""
+	if p.CompletedAt != nil {
+		completedAt = p.CompletedAt.Format(time.RFC3339)
+	}
+	return []string{
+		p.FilterName,
+		strconv.FormatInt(p.FromBlock, 10),
+		strconv.FormatInt(p.ToBlock, 10),
+		strconv.FormatInt(p.NextBlock, 10),
+		p.progress(),
+		p.LastError,
+		completedAt,
+	}
+}
+
+// progress is the share of the range of blocks that was backfilled in order.
+func (p *LogPollerBackfillPresenter) progress() string {
+	total := p.ToBlock - p.FromBlock + 1
+	if total <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("%.1f%%", float64(p.NextBlock-p.FromBlock)*100/float64(total))
+}
+
+// RenderTable implements TableRenderer
+func (p *LogPollerBackfillPresenter) RenderTable(rt RendererTable) error {
+	renderList(logPollerBackfillHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+// LogPollerBackfillPresenters implements TableRenderer for a slice of LogPollerBackfillPresenter.
+type LogPollerBackfillPresenters []LogPollerBackfillPresenter
+
+// RenderTable implements TableRenderer
+func (ps LogPollerBackfillPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(logPollerBackfillHeaders, rows, rt.Writer)
+	return nil
+}
+
+// ShowLogPollerBackfills shows the progress of the LogPoller backfills of a chain.
+func (s *Shell) ShowLogPollerBackfills(c *cli.Context) (err error) {
+	v := url.Values{}
+	v.Add("evmChainID", fmt.Sprintf("%d", c.Int64("evm-chain-id")))
+
+	resp, err := s.HTTP.Get(s.ctx(), fmt.Sprintf("/v2/log_poller/backfills?%s", v.Encode()))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &LogPollerBackfillPresenters{}, "LogPoller Backfills")
+}
+
+// StartLogPollerBackfill starts the backfill of the logs of a LogPoller filter of a chain.
+func (s *Shell) StartLogPollerBackfill(c *cli.Context) (err error) {
+	fromBlock := c.Int64("from-block")
+	if fromBlock <= 0 {
+		return s.errorOut(errors.New("Must pass a positive value in '--from-block' parameter"))
+	}
+
+	request, err := json.Marshal(web.StartLogPollerBackfillRequest{
+		FilterName: c.String("filter"),
+		FromBlock:  fromBlock,
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	v := url.Values{}
+	v.Add("evmChainID", fmt.Sprintf("%d", c.Int64("evm-chain-id")))
+
+	resp, err := s.HTTP.Post(s.ctx(), fmt.Sprintf("/v2/log_poller/backfills?%s", v.Encode()), bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if _, err = s.parseResponse(resp); err != nil {
+		return s.errorOut(err)
+	}
+	fmt.Println("Backfill started")
+	return nil
+}
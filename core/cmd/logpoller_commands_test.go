@@ -0,0 +1,69 @@
+package cmd_test
+
+import (
+	"flag"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+)
+
+func TestShell_ShowLogPollerBackfills(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].ChainID = (*ubig.Big)(big.NewInt(5))
+		c.EVM[0].Enabled = ptr(true)
+	})
+
+	client, _ := app.NewShellAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ShowLogPollerBackfills, set, "")
+
+	// Incorrect chain ID
+	require.NoError(t, set.Set("evm-chain-id", "1"))
+	c := cli.NewContext(nil, set, nil)
+	require.ErrorContains(t, client.ShowLogPollerBackfills(c), "does not match any local chains")
+
+	// Correct chain ID
+	require.NoError(t, set.Set("evm-chain-id", "5"))
+	c = cli.NewContext(nil, set, nil)
+	require.ErrorContains(t, client.ShowLogPollerBackfills(c), "log poller disabled")
+}
+
+func TestShell_StartLogPollerBackfill(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].ChainID = (*ubig.Big)(big.NewInt(5))
+		c.EVM[0].Enabled = ptr(true)
+	})
+
+	client, _ := app.NewShellAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.StartLogPollerBackfill, set, "")
+	require.NoError(t, set.Set("filter", "filter"))
+
+	// Invalid block number
+	require.NoError(t, set.Set("evm-chain-id", "5"))
+	require.NoError(t, set.Set("from-block", "0"))
+	c := cli.NewContext(nil, set, nil)
+	require.ErrorContains(t, client.StartLogPollerBackfill(c), "Must pass a positive value in '--from-block' parameter")
+
+	// Incorrect chain ID
+	require.NoError(t, set.Set("from-block", "10"))
+	require.NoError(t, set.Set("evm-chain-id", "1"))
+	c = cli.NewContext(nil, set, nil)
+	require.ErrorContains(t, client.StartLogPollerBackfill(c), "does not match any local chains")
+
+	// Correct chain ID
+	require.NoError(t, set.Set("evm-chain-id", "5"))
+	c = cli.NewContext(nil, set, nil)
+	require.ErrorContains(t, client.StartLogPollerBackfill(c), "log poller disabled")
+}
@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initPipelineFragmentsSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:   "list",
+			Usage:  "List the latest version of all pipeline fragments",
+			Action: s.ListPipelineFragments,
+		},
+		{
+			Name:   "show",
+			Usage:  "Show a pipeline fragment",
+			Action: s.ShowPipelineFragment,
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  "version",
+					Usage: "version of the fragment to show, defaults to the latest",
+				},
+			},
+		},
+		{
+			Name:   "create",
+			Usage:  "Create a pipeline fragment, or a new version of an existing one, from DOT or a DOT filepath",
+			Action: s.CreatePipelineFragment,
+		},
+		{
+			Name:   "delete",
+			Usage:  "Delete all versions of a pipeline fragment",
+			Action: s.DeletePipelineFragment,
+		},
+	}
+}
+
+type PipelineFragmentPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.PipelineFragmentResource
+}
+
+// RenderTable implements TableRenderer
+func (p *PipelineFragmentPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Name", "Version", "Created At"})
+	table.Append([]string{
+		p.Name,
+		strconv.FormatInt(int64(p.Version), 10),
+		p.CreatedAt.String(),
+	})
+	render("Pipeline Fragment", table)
+
+	source := rt.newTable([]string{"Source"})
+	source.Append([]string{p.Source})
+	render("", source)
+	return nil
+}
+
+type PipelineFragmentPresenters []PipelineFragmentPresenter
+
+// RenderTable implements TableRenderer
+func (ps PipelineFragmentPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Name", "Version", "Created At"})
+	for _, p := range ps {
+		table.Append([]string{
+			p.Name,
+			strconv.FormatInt(int64(p.Version), 10),
+			p.CreatedAt.String(),
+		})
+	}
+
+	render("Pipeline Fragments", table)
+	return nil
+}
+
+// ListPipelineFragments lists the latest version of every pipeline fragment.
+func (s *Shell) ListPipelineFragments(c *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/pipeline/fragments")
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &PipelineFragmentPresenters{})
+}
+
+// ShowPipelineFragment shows a version of a pipeline fragment.
+func (s *Shell) ShowPipelineFragment(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the name of the pipeline fragment to be shown"))
+	}
+	path := "/v2/pipeline/fragments/" + url.PathEscape(c.Args().First())
+	if version := c.Int("version"); version > 0 {
+		path += "?version=" + strconv.Itoa(version)
+	}
+	resp, err := s.HTTP.Get(s.ctx(), path)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &PipelineFragmentPresenter{})
+}
+
+// CreatePipelineFragment stores a pipeline fragment. Storing a fragment under an
+// existing name creates a new version of it.
+func (s *Shell) CreatePipelineFragment(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return s.errorOut(errors.New("must pass the name of the pipeline fragment and its DOT source or filepath"))
+	}
+
+	source := c.Args().Get(1)
+	if buf, ferr := fromFile(source); ferr == nil {
+		source = buf.String()
+	}
+
+	request, err := json.Marshal(web.CreatePipelineFragmentRequest{
+		Name:   c.Args().First(),
+		Source: source,
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/pipeline/fragments", bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &PipelineFragmentPresenter{}, "Pipeline fragment created")
+}
+
+// DeletePipelineFragment deletes all versions of a pipeline fragment.
+func (s *Shell) DeletePipelineFragment(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the name of the pipeline fragment to be deleted"))
+	}
+	resp, err := s.HTTP.Delete(s.ctx(), "/v2/pipeline/fragments/"+url.PathEscape(c.Args().First()))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &PipelineFragmentPresenter{}, "Pipeline fragment deleted")
+}
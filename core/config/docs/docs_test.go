@@ -97,6 +97,17 @@ func TestDoc(t *testing.T) {
 		docDefaults.Transactions.AutoPurge.Threshold = nil
 		docDefaults.Transactions.AutoPurge.MinAttempts = nil
 
+		// Transactions.PrivateSubmission configs are only set if the feature is enabled
+		docDefaults.Transactions.PrivateSubmission = evmcfg.PrivateSubmissionConfig{}
+		// Transactions.NonceReconciliation configs are only set if the feature is enabled
+		docDefaults.Transactions.NonceReconciliation = evmcfg.NonceReconciliationConfig{}
+		// Transactions.KeyPool configs are only set if the feature is enabled
+		docDefaults.Transactions.KeyPool = evmcfg.KeyPoolConfig{}
+		// BalanceMonitor.LowBalanceThreshold is not set
+		docDefaults.BalanceMonitor.LowBalanceThreshold = nil
+		// BalanceMonitor.TopUp configs are only set if the feature is enabled
+		docDefaults.BalanceMonitor.TopUp = evmcfg.TopUpConfig{}
+
 		// Fallback DA oracle is not set
 		docDefaults.GasEstimator.DAOracle = evmcfg.DAOracle{}
 
@@ -15,6 +15,8 @@ import (
 
 	feeds "github.com/smartcontractkit/chainlink/v2/core/services/feeds"
 
+	headtracker "github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+
 	job "github.com/smartcontractkit/chainlink/v2/core/services/job"
 
 	jsonserializable "github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
@@ -202,6 +204,53 @@ func (_c *Application_BasicAdminUsersORM_Call) RunAndReturn(run func() sessions.
 	return _c
 }
 
+// BridgeCircuits provides a mock function with given fields:
+func (_m *Application) BridgeCircuits() *bridges.CircuitBreakers {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BridgeCircuits")
+	}
+
+	var r0 *bridges.CircuitBreakers
+	if rf, ok := ret.Get(0).(func() *bridges.CircuitBreakers); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*bridges.CircuitBreakers)
+		}
+	}
+
+	return r0
+}
+
+// Application_BridgeCircuits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BridgeCircuits'
+type Application_BridgeCircuits_Call struct {
+	*mock.Call
+}
+
+// BridgeCircuits is a helper method to define mock.On call
+func (_e *Application_Expecter) BridgeCircuits() *Application_BridgeCircuits_Call {
+	return &Application_BridgeCircuits_Call{Call: _e.mock.On("BridgeCircuits")}
+}
+
+func (_c *Application_BridgeCircuits_Call) Run(run func()) *Application_BridgeCircuits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_BridgeCircuits_Call) Return(_a0 *bridges.CircuitBreakers) *Application_BridgeCircuits_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_BridgeCircuits_Call) RunAndReturn(run func() *bridges.CircuitBreakers) *Application_BridgeCircuits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BridgeORM provides a mock function with given fields:
 func (_m *Application) BridgeORM() bridges.ORM {
 	ret := _m.Called()
@@ -1200,6 +1249,53 @@ func (_c *Application_PipelineORM_Call) RunAndReturn(run func() pipeline.ORM) *A
 	return _c
 }
 
+// ReorgORM provides a mock function with given fields:
+func (_m *Application) ReorgORM() headtracker.ReorgORM {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReorgORM")
+	}
+
+	var r0 headtracker.ReorgORM
+	if rf, ok := ret.Get(0).(func() headtracker.ReorgORM); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(headtracker.ReorgORM)
+		}
+	}
+
+	return r0
+}
+
+// Application_ReorgORM_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReorgORM'
+type Application_ReorgORM_Call struct {
+	*mock.Call
+}
+
+// ReorgORM is a helper method to define mock.On call
+func (_e *Application_Expecter) ReorgORM() *Application_ReorgORM_Call {
+	return &Application_ReorgORM_Call{Call: _e.mock.On("ReorgORM")}
+}
+
+func (_c *Application_ReorgORM_Call) Run(run func()) *Application_ReorgORM_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_ReorgORM_Call) Return(_a0 headtracker.ReorgORM) *Application_ReorgORM_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_ReorgORM_Call) RunAndReturn(run func() headtracker.ReorgORM) *Application_ReorgORM_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReplayFromBlock provides a mock function with given fields: chainID, number, forceBroadcast
 func (_m *Application) ReplayFromBlock(chainID *big.Int, number uint64, forceBroadcast bool) error {
 	ret := _m.Called(chainID, number, forceBroadcast)
@@ -1506,6 +1602,67 @@ func (_c *Application_SetLogLevel_Call) RunAndReturn(run func(zapcore.Level) err
 	return _c
 }
 
+// SimulateJobV2 provides a mock function with given fields: ctx, jb, vars, fixtures
+func (_m *Application) SimulateJobV2(ctx context.Context, jb *job.Job, vars map[string]interface{}, fixtures pipeline.SimulationFixtures) (*pipeline.Run, error) {
+	ret := _m.Called(ctx, jb, vars, fixtures)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateJobV2")
+	}
+
+	var r0 *pipeline.Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *job.Job, map[string]interface{}, pipeline.SimulationFixtures) (*pipeline.Run, error)); ok {
+		return rf(ctx, jb, vars, fixtures)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *job.Job, map[string]interface{}, pipeline.SimulationFixtures) *pipeline.Run); ok {
+		r0 = rf(ctx, jb, vars, fixtures)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *job.Job, map[string]interface{}, pipeline.SimulationFixtures) error); ok {
+		r1 = rf(ctx, jb, vars, fixtures)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Application_SimulateJobV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateJobV2'
+type Application_SimulateJobV2_Call struct {
+	*mock.Call
+}
+
+// SimulateJobV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jb *job.Job
+//   - vars map[string]interface{}
+//   - fixtures pipeline.SimulationFixtures
+func (_e *Application_Expecter) SimulateJobV2(ctx interface{}, jb interface{}, vars interface{}, fixtures interface{}) *Application_SimulateJobV2_Call {
+	return &Application_SimulateJobV2_Call{Call: _e.mock.On("SimulateJobV2", ctx, jb, vars, fixtures)}
+}
+
+func (_c *Application_SimulateJobV2_Call) Run(run func(ctx context.Context, jb *job.Job, vars map[string]interface{}, fixtures pipeline.SimulationFixtures)) *Application_SimulateJobV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*job.Job), args[2].(map[string]interface{}), args[3].(pipeline.SimulationFixtures))
+	})
+	return _c
+}
+
+func (_c *Application_SimulateJobV2_Call) Return(_a0 *pipeline.Run, _a1 error) *Application_SimulateJobV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Application_SimulateJobV2_Call) RunAndReturn(run func(context.Context, *job.Job, map[string]interface{}, pipeline.SimulationFixtures) (*pipeline.Run, error)) *Application_SimulateJobV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Application) Start(ctx context.Context) error {
 	ret := _m.Called(ctx)
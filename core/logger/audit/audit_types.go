@@ -69,6 +69,9 @@ const (
 	BridgeUpdated EventID = "BRIDGE_UPDATED"
 	BridgeDeleted EventID = "BRIDGE_DELETED"
 
+	PipelineFragmentCreated EventID = "PIPELINE_FRAGMENT_CREATED"
+	PipelineFragmentDeleted EventID = "PIPELINE_FRAGMENT_DELETED"
+
 	ForwarderCreated EventID = "FORWARDER_CREATED"
 	ForwarderDeleted EventID = "FORWARDER_DELETED"
 
@@ -34,6 +34,7 @@ import (
 	gatewayconnector "github.com/smartcontractkit/chainlink/v2/core/capabilities/gateway_connector"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/remote"
 	remotetypes "github.com/smartcontractkit/chainlink/v2/core/capabilities/remote/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -77,6 +78,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/sessions/ldapauth"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions/localauth"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
+	clutils "github.com/smartcontractkit/chainlink/v2/core/utils"
 	"github.com/smartcontractkit/chainlink/v2/plugins"
 )
 
@@ -105,13 +107,17 @@ type Application interface {
 	EVMORM() evmtypes.Configs
 	PipelineORM() pipeline.ORM
 	BridgeORM() bridges.ORM
+	BridgeCircuits() *bridges.CircuitBreakers
 	BasicAdminUsersORM() sessions.BasicAdminUsersORM
 	AuthenticationProvider() sessions.AuthenticationProvider
 	TxmStorageService() txmgr.EvmTxStore
+	ReorgORM() headtracker.ReorgORM
 	AddJobV2(ctx context.Context, job *job.Job) error
 	DeleteJob(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
+	// SimulateJobV2 executes the pipeline of an unsaved job in memory, answering external tasks from fixtures.
+	SimulateJobV2(ctx context.Context, jb *job.Job, vars map[string]interface{}, fixtures pipeline.SimulationFixtures) (*pipeline.Run, error)
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
 
@@ -146,6 +152,7 @@ type ChainlinkApplication struct {
 	localAdminUsersORM       sessions.BasicAdminUsersORM
 	authenticationProvider   sessions.AuthenticationProvider
 	txmStorageService        txmgr.EvmTxStore
+	reorgORM                 headtracker.ReorgORM
 	FeedsService             feeds.Service
 	webhookJobRunner         webhook.JobRunner
 	Config                   GeneralConfig
@@ -429,9 +436,14 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		return nil, errors.Errorf("NewApplication: Unexpected 'AuthenticationMethod': %s supported values: %s, %s", authMethod, sessions.LocalAuth, sessions.LDAPAuth)
 	}
 
+	bridgeSecrets, err := bridges.NewSecretsCipher(cfg.Password().Keystore(), clutils.GetScryptParams(cfg))
+	if err != nil {
+		return nil, errors.Wrap(err, "NewApplication: failed to initialize bridge secrets")
+	}
+
 	var (
 		pipelineORM    = pipeline.NewORM(opts.DS, globalLogger, cfg.JobPipeline().MaxSuccessfulRuns())
-		bridgeORM      = bridges.NewORM(opts.DS)
+		bridgeORM      = bridges.NewORMWithSecrets(opts.DS, bridgeSecrets)
 		mercuryORM     = mercury.NewORM(opts.DS)
 		pipelineRunner = pipeline.NewRunner(pipelineORM, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), legacyEVMChains, keyStore.Eth(), keyStore.VRF(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
 		jobORM         = job.NewORM(opts.DS, pipelineORM, bridgeORM, keyStore, globalLogger)
@@ -692,6 +704,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		localAdminUsersORM:       localAdminUsersORM,
 		authenticationProvider:   authenticationProvider,
 		txmStorageService:        txmORM,
+		reorgORM:                 headtracker.NewReorgORM(opts.DS),
 		FeedsService:             feedsService,
 		Config:                   cfg,
 		webhookJobRunner:         webhookJobRunner,
@@ -869,6 +882,11 @@ func (app *ChainlinkApplication) BridgeORM() bridges.ORM {
 	return app.bridgeORM
 }
 
+// BridgeCircuits returns the circuit breakers of the bridges called by the pipeline runner.
+func (app *ChainlinkApplication) BridgeCircuits() *bridges.CircuitBreakers {
+	return app.pipelineRunner.BridgeCircuits()
+}
+
 func (app *ChainlinkApplication) BasicAdminUsersORM() sessions.BasicAdminUsersORM {
 	return app.localAdminUsersORM
 }
@@ -890,6 +908,10 @@ func (app *ChainlinkApplication) TxmStorageService() txmgr.EvmTxStore {
 	return app.txmStorageService
 }
 
+func (app *ChainlinkApplication) ReorgORM() headtracker.ReorgORM {
+	return app.reorgORM
+}
+
 func (app *ChainlinkApplication) GetExternalInitiatorManager() webhook.ExternalInitiatorManager {
 	return app.ExternalInitiatorManager
 }
@@ -990,6 +1012,39 @@ func (app *ChainlinkApplication) RunJobV2(
 	return runID, err
 }
 
+// SimulateJobV2 executes the pipeline of jb without persisting anything. Tasks that
+// would call out to adapters or chains are answered from fixtures instead.
+func (app *ChainlinkApplication) SimulateJobV2(
+	ctx context.Context,
+	jb *job.Job,
+	vars map[string]interface{},
+	fixtures pipeline.SimulationFixtures,
+) (*pipeline.Run, error) {
+	if jb.Pipeline.Source == "" {
+		return nil, errors.Errorf("job type %s has no pipeline to simulate", jb.Type)
+	}
+	spec := pipeline.Spec{
+		DotDagSource:      jb.Pipeline.Source,
+		MaxTaskDuration:   jb.MaxTaskDuration,
+		ForwardingAllowed: jb.ForwardingAllowed,
+		JobID:             jb.ID,
+		JobName:           jb.Name.ValueOrZero(),
+		JobType:           string(jb.Type),
+	}
+	if jb.GasLimit.Valid {
+		spec.GasLimit = &jb.GasLimit.Uint32
+	}
+	if jb.Pipeline.HasSubpipelines() {
+		fragments, err := pipeline.ResolveFragments(ctx, jb.Pipeline.Source, app.pipelineORM)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to resolve subpipelines")
+		}
+		spec.Fragments = fragments
+	}
+	run, _, err := app.pipelineRunner.ExecuteRun(pipeline.ContextWithSimulation(ctx, fixtures), spec, pipeline.NewVarsFrom(vars))
+	return run, err
+}
+
 func (app *ChainlinkApplication) ResumeJobV2(
 	ctx context.Context,
 	taskID uuid.UUID,
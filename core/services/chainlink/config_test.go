@@ -626,6 +626,12 @@ func TestConfig_Marshal(t *testing.T) {
 					FeeHistory: evmcfg.FeeHistoryEstimator{
 						CacheTimeout: &second,
 					},
+					Composite: evmcfg.CompositeEstimator{
+						Modes:       &[]string{"BlockHistory", "SuggestedPrice"},
+						Percentile:  ptr[uint8](100),
+						HistorySize: ptr[uint32](10),
+						BandPercent: ptr[uint16](50),
+					},
 				},
 
 				KeySpecific: []evmcfg.KeySpecific{
@@ -702,6 +708,12 @@ func TestConfig_Marshal(t *testing.T) {
 						ServiceUnavailable:                ptr[string]("(: |^)service unavailable"),
 						TooManyResults:                    ptr[string]("(: |^)too many results"),
 					},
+					CriticalReads: evmcfg.CriticalReads{
+						Mode:            ptr(client.CriticalReadModeQuorum),
+						HedgePercentile: ptr[uint8](95),
+						QuorumNodes:     ptr[uint32](5),
+						QuorumAgreement: ptr[uint32](3),
+					},
 				},
 				OCR: evmcfg.OCR{
 					ContractConfirmations:              ptr[uint16](11),
@@ -1169,6 +1181,12 @@ TransactionPercentile = 15
 [EVM.GasEstimator.FeeHistory]
 CacheTimeout = '1s'
 
+[EVM.GasEstimator.Composite]
+Modes = ['BlockHistory', 'SuggestedPrice']
+Percentile = 100
+HistorySize = 10
+BandPercent = 50
+
 [EVM.HeadTracker]
 HistoryDepth = 15
 MaxBufferSize = 17
@@ -1212,6 +1230,12 @@ Fatal = '(: |^)fatal'
 ServiceUnavailable = '(: |^)service unavailable'
 TooManyResults = '(: |^)too many results'
 
+[EVM.NodePool.CriticalReads]
+Mode = 'Quorum'
+HedgePercentile = 95
+QuorumNodes = 5
+QuorumAgreement = 3
+
 [EVM.OCR]
 ContractConfirmations = 11
 ContractTransmitterTransmitTimeout = '1m0s'
@@ -1401,6 +1425,15 @@ func TestConfig_full(t *testing.T) {
 			if got.EVM[c].Nodes[n].Order == nil {
 				got.EVM[c].Nodes[n].Order = ptr(int32(100))
 			}
+			if got.EVM[c].Nodes[n].RecordingPath == nil {
+				got.EVM[c].Nodes[n].RecordingPath = ptr("")
+			}
+			if got.EVM[c].Nodes[n].MaxRequestsPerSecond == nil {
+				got.EVM[c].Nodes[n].MaxRequestsPerSecond = ptr(uint32(0))
+			}
+			if got.EVM[c].Nodes[n].MaxComputeUnitsPerSecond == nil {
+				got.EVM[c].Nodes[n].MaxComputeUnitsPerSecond = ptr(uint32(0))
+			}
 		}
 		if got.EVM[c].Transactions.AutoPurge.Threshold == nil {
 			got.EVM[c].Transactions.AutoPurge.Threshold = ptr(uint32(0))
@@ -1411,6 +1444,45 @@ func TestConfig_full(t *testing.T) {
 		if got.EVM[c].Transactions.AutoPurge.DetectionApiUrl == nil {
 			got.EVM[c].Transactions.AutoPurge.DetectionApiUrl = new(commoncfg.URL)
 		}
+		if got.EVM[c].Transactions.PrivateSubmission.URL == nil {
+			got.EVM[c].Transactions.PrivateSubmission.URL = new(commoncfg.URL)
+		}
+		if got.EVM[c].Transactions.PrivateSubmission.Method == nil {
+			got.EVM[c].Transactions.PrivateSubmission.Method = ptr("")
+		}
+		if got.EVM[c].Transactions.PrivateSubmission.FallbackBlocks == nil {
+			got.EVM[c].Transactions.PrivateSubmission.FallbackBlocks = ptr(uint32(0))
+		}
+		if got.EVM[c].Transactions.PrivateSubmission.SigningAddress == nil {
+			got.EVM[c].Transactions.PrivateSubmission.SigningAddress = new(types.EIP55Address)
+		}
+		if got.EVM[c].Transactions.NonceReconciliation.Interval == nil {
+			got.EVM[c].Transactions.NonceReconciliation.Interval = new(commoncfg.Duration)
+		}
+		if got.EVM[c].Transactions.KeyPool.Enabled == nil {
+			got.EVM[c].Transactions.KeyPool.Enabled = ptr(false)
+		}
+		if got.EVM[c].Transactions.KeyPool.MaxInFlightPerKey == nil {
+			got.EVM[c].Transactions.KeyPool.MaxInFlightPerKey = ptr(uint32(0))
+		}
+		if got.EVM[c].BalanceMonitor.LowBalanceThreshold == nil {
+			got.EVM[c].BalanceMonitor.LowBalanceThreshold = new(assets.Wei)
+		}
+		if got.EVM[c].BalanceMonitor.TopUp.TreasuryAddress == nil {
+			got.EVM[c].BalanceMonitor.TopUp.TreasuryAddress = new(types.EIP55Address)
+		}
+		if got.EVM[c].BalanceMonitor.TopUp.Threshold == nil {
+			got.EVM[c].BalanceMonitor.TopUp.Threshold = new(assets.Wei)
+		}
+		if got.EVM[c].BalanceMonitor.TopUp.Target == nil {
+			got.EVM[c].BalanceMonitor.TopUp.Target = new(assets.Wei)
+		}
+		if got.EVM[c].BalanceMonitor.TopUp.DailySpendCap == nil {
+			got.EVM[c].BalanceMonitor.TopUp.DailySpendCap = new(assets.Wei)
+		}
+		if got.EVM[c].BalanceMonitor.TopUp.DryRun == nil {
+			got.EVM[c].BalanceMonitor.TopUp.DryRun = ptr(false)
+		}
 		if got.EVM[c].GasEstimator.DAOracle.OracleType == nil {
 			oracleType := evmcfg.DAOracleOPStack
 			got.EVM[c].GasEstimator.DAOracle.OracleType = &oracleType
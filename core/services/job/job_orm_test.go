@@ -542,6 +542,47 @@ func TestORM_CreateJob_VRFV2(t *testing.T) {
 	cltest.AssertCount(t, db, "jobs", 0)
 }
 
+func TestORM_CreateJob_Keeper(t *testing.T) {
+	ctx := testutils.Context(t)
+	config := configtest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db)
+
+	lggr := logger.TestLogger(t)
+	pipelineORM := pipeline.NewORM(db, lggr, config.JobPipeline().MaxSuccessfulRuns())
+	bridgesORM := bridges.NewORM(db)
+	jobORM := NewTestORM(t, db, pipelineORM, bridgesORM, keyStore)
+
+	fromAddresses := []evmtypes.EIP55Address{cltest.NewEIP55Address(), cltest.NewEIP55Address(), cltest.NewEIP55Address()}
+	jb, err := keeper.ValidatedKeeperSpec(fmt.Sprintf(`
+type              = "keeper"
+schemaVersion     = 1
+name              = "keeper with several from addresses"
+contractAddress   = "%s"
+fromAddress       = "%s"
+fromAddresses     = ["%s", "%s", "%s"]
+forwardingAllowed = true
+privateSubmission = true
+evmChainID        = 0
+`, cltest.NewEIP55Address(), cltest.NewEIP55Address(), fromAddresses[0], fromAddresses[1], fromAddresses[2]))
+	require.NoError(t, err)
+
+	require.NoError(t, jobORM.CreateJob(ctx, &jb))
+	cltest.AssertCount(t, db, "keeper_specs", 1)
+	cltest.AssertCount(t, db, "jobs", 1)
+
+	loaded, err := jobORM.FindJob(ctx, jb.ID)
+	require.NoError(t, err)
+	require.NotNil(t, loaded.KeeperSpec)
+	assert.Equal(t, fromAddresses, loaded.KeeperSpec.FromAddresses)
+	assert.Equal(t, jb.KeeperSpec.FromAddress, loaded.KeeperSpec.FromAddress)
+	assert.True(t, loaded.KeeperSpec.PrivateSubmission)
+
+	require.NoError(t, jobORM.DeleteJob(ctx, jb.ID, jb.Type))
+	cltest.AssertCount(t, db, "keeper_specs", 0)
+	cltest.AssertCount(t, db, "jobs", 0)
+}
+
 func TestORM_CreateJob_VRFV2Plus(t *testing.T) {
 	ctx := testutils.Context(t)
 	config := configtest.NewTestGeneralConfig(t)
@@ -774,6 +774,65 @@ func (_c *ORM_FindJobIDsWithBridge_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// FindJobIDsWithFragment provides a mock function with given fields: ctx, name
+func (_m *ORM) FindJobIDsWithFragment(ctx context.Context, name string) ([]int32, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindJobIDsWithFragment")
+	}
+
+	var r0 []int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]int32, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []int32); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int32)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_FindJobIDsWithFragment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindJobIDsWithFragment'
+type ORM_FindJobIDsWithFragment_Call struct {
+	*mock.Call
+}
+
+// FindJobIDsWithFragment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *ORM_Expecter) FindJobIDsWithFragment(ctx interface{}, name interface{}) *ORM_FindJobIDsWithFragment_Call {
+	return &ORM_FindJobIDsWithFragment_Call{Call: _e.mock.On("FindJobIDsWithFragment", ctx, name)}
+}
+
+func (_c *ORM_FindJobIDsWithFragment_Call) Run(run func(ctx context.Context, name string)) *ORM_FindJobIDsWithFragment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ORM_FindJobIDsWithFragment_Call) Return(_a0 []int32, _a1 error) *ORM_FindJobIDsWithFragment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_FindJobIDsWithFragment_Call) RunAndReturn(run func(context.Context, string) ([]int32, error)) *ORM_FindJobIDsWithFragment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindJobWithoutSpecErrors provides a mock function with given fields: ctx, id
 func (_m *ORM) FindJobWithoutSpecErrors(ctx context.Context, id int32) (job.Job, error) {
 	ret := _m.Called(ctx, id)
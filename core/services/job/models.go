@@ -547,6 +547,13 @@ type KeeperSpec struct {
 	EVMChainID               *big.Big              `toml:"evmChainID"`
 	CreatedAt                time.Time             `toml:"-"`
 	UpdatedAt                time.Time             `toml:"-"`
+
+	// FromAddresses are additional keys sending the perform transactions along with FromAddress, assigned by the key
+	// pool of the chain if enabled. They must be authorized on the forwarder of FromAddress.
+	FromAddresses []evmtypes.EIP55Address `toml:"fromAddresses"`
+
+	// PrivateSubmission sends the perform transactions to the private relay of the chain instead of the public mempool.
+	PrivateSubmission bool `toml:"privateSubmission"`
 }
 
 type VRFSpec struct {
@@ -599,6 +606,10 @@ type VRFSpec struct {
 	// only.
 	BackoffMaxDelay time.Duration `toml:"backoffMaxDelay"`
 
+	// PrivateSubmission sends the fulfillment transactions to the private relay of the chain instead of the public
+	// mempool. V2 only.
+	PrivateSubmission bool `toml:"privateSubmission"`
+
 	CreatedAt time.Time `toml:"-"`
 	UpdatedAt time.Time `toml:"-"`
 }
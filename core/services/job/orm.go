@@ -51,6 +51,7 @@ type ORM interface {
 	FindJobIDByAddress(ctx context.Context, address evmtypes.EIP55Address, evmChainID *big.Big) (int32, error)
 	FindOCR2JobIDByAddress(ctx context.Context, contractID string, feedID *common.Hash) (int32, error)
 	FindJobIDsWithBridge(ctx context.Context, name string) ([]int32, error)
+	FindJobIDsWithFragment(ctx context.Context, name string) ([]int32, error)
 	DeleteJob(ctx context.Context, id int32, jobType Type) error
 	RecordError(ctx context.Context, jobID int32, description string) error
 	// TryRecordError is a helper which calls RecordError and logs the returned error if present.
@@ -548,9 +549,9 @@ func (o *orm) insertOCR2OracleSpec(ctx context.Context, spec *OCR2OracleSpec) (s
 }
 
 func (o *orm) insertKeeperSpec(ctx context.Context, spec *KeeperSpec) (specID int32, err error) {
-	return o.prepareQuerySpecID(ctx, `INSERT INTO keeper_specs (contract_address, from_address, evm_chain_id, created_at, updated_at)
-			VALUES (:contract_address, :from_address, :evm_chain_id, NOW(), NOW())
-			RETURNING id;`, spec)
+	return o.prepareQuerySpecID(ctx, `INSERT INTO keeper_specs (contract_address, from_address, from_addresses, evm_chain_id, private_submission, created_at, updated_at)
+			VALUES (:contract_address, :from_address, :from_addresses, :evm_chain_id, :private_submission, NOW(), NOW())
+			RETURNING id;`, toKeeperSpecRow(spec))
 }
 
 func (o *orm) insertCronSpec(ctx context.Context, spec *CronSpec) (specID int32, err error) {
@@ -565,14 +566,14 @@ func (o *orm) insertVRFSpec(ctx context.Context, spec *VRFSpec) (specID int32, e
 				evm_chain_id, from_addresses, poll_period, requested_confs_delay,
 				request_timeout, chunk_size, batch_coordinator_address, batch_fulfillment_enabled,
 				batch_fulfillment_gas_multiplier, backoff_initial_delay, backoff_max_delay, gas_lane_price,
-                vrf_owner_address, custom_reverts_pipeline_enabled,
+                vrf_owner_address, custom_reverts_pipeline_enabled, private_submission,
 				created_at, updated_at)
 			VALUES (
 				:coordinator_address, :public_key, :min_incoming_confirmations,
 				:evm_chain_id, :from_addresses, :poll_period, :requested_confs_delay,
 				:request_timeout, :chunk_size, :batch_coordinator_address, :batch_fulfillment_enabled,
 				:batch_fulfillment_gas_multiplier, :backoff_initial_delay, :backoff_max_delay, :gas_lane_price,
-			    :vrf_owner_address, :custom_reverts_pipeline_enabled,
+			    :vrf_owner_address, :custom_reverts_pipeline_enabled, :private_submission,
 				NOW(), NOW())
 			RETURNING id;`, toVRFSpecRow(spec))
 }
@@ -1108,6 +1109,20 @@ func (o *orm) FindJobIDsWithBridge(ctx context.Context, name string) (jids []int
 	return
 }
 
+// FindJobIDsWithFragment returns the IDs of the jobs whose pipeline includes
+// the named fragment, directly or through another fragment.
+func (o *orm) FindJobIDsWithFragment(ctx context.Context, name string) (jids []int32, err error) {
+	query := `SELECT jobs.id
+		FROM jobs
+		    JOIN job_pipeline_specs ON job_pipeline_specs.job_id = jobs.id
+		    JOIN pipeline_specs ON pipeline_specs.id = job_pipeline_specs.pipeline_spec_id
+		WHERE pipeline_specs.fragments @> jsonb_build_array(jsonb_build_object('name', $1::text)) ORDER BY id`
+	if err = o.ds.SelectContext(ctx, &jids, query, name); err != nil {
+		return nil, err
+	}
+	return jids, nil
+}
+
 func (o *orm) FindJobIDByWorkflow(ctx context.Context, spec WorkflowSpec) (jobID int32, err error) {
 	stmt := `
 SELECT jobs.id FROM jobs
@@ -1438,7 +1453,7 @@ func (o *orm) loadAllJobTypes(ctx context.Context, job *Job) error {
 		o.loadJobType(ctx, job, "DirectRequestSpec", "direct_request_specs", job.DirectRequestSpecID),
 		o.loadJobType(ctx, job, "OCROracleSpec", "ocr_oracle_specs", job.OCROracleSpecID),
 		o.loadJobType(ctx, job, "OCR2OracleSpec", "ocr2_oracle_specs", job.OCR2OracleSpecID),
-		o.loadJobType(ctx, job, "KeeperSpec", "keeper_specs", job.KeeperSpecID),
+		o.loadKeeperJob(ctx, job, job.KeeperSpecID),
 		o.loadJobType(ctx, job, "CronSpec", "cron_specs", job.CronSpecID),
 		o.loadJobType(ctx, job, "WebhookSpec", "webhook_specs", job.WebhookSpecID),
 		o.loadVRFJob(ctx, job, job.VRFSpecID),
@@ -1500,6 +1515,45 @@ func (o *orm) loadJobPipelineSpec(ctx context.Context, job *Job, id *int32) erro
 	return nil
 }
 
+func (o *orm) loadKeeperJob(ctx context.Context, job *Job, id *int32) error {
+	if id == nil {
+		return nil
+	}
+
+	var row keeperSpecRow
+	err := o.ds.GetContext(ctx, &row, `SELECT * FROM keeper_specs WHERE id = $1`, *id)
+	if err != nil {
+		return errors.Wrapf(err, `failed to load job type KeeperSpec with id %d`, *id)
+	}
+
+	job.KeeperSpec = row.toKeeperSpec()
+	return nil
+}
+
+// keeperSpecRow is a helper type for reading and writing keeper specs to the database. This is necessary
+// because the bytea[] in the DB is not automatically convertible to or from the spec's
+// FromAddresses field. pq.ByteaArray must be used instead.
+type keeperSpecRow struct {
+	*KeeperSpec
+	FromAddresses pq.ByteaArray
+}
+
+func toKeeperSpecRow(spec *KeeperSpec) keeperSpecRow {
+	addresses := make(pq.ByteaArray, len(spec.FromAddresses))
+	for i, a := range spec.FromAddresses {
+		addresses[i] = a.Bytes()
+	}
+	return keeperSpecRow{KeeperSpec: spec, FromAddresses: addresses}
+}
+
+func (r keeperSpecRow) toKeeperSpec() *KeeperSpec {
+	for _, a := range r.FromAddresses {
+		r.KeeperSpec.FromAddresses = append(r.KeeperSpec.FromAddresses,
+			evmtypes.EIP55AddressFromAddress(common.BytesToAddress(a)))
+	}
+	return r.KeeperSpec
+}
+
 func (o *orm) loadVRFJob(ctx context.Context, job *Job, id *int32) error {
 	if id == nil {
 		return nil
@@ -100,6 +100,16 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) (services
 			svcLogger.Warnw("Skipping forwarding for job, will fallback to default behavior", "job", spec.Name, "err", fwderr)
 		}
 	}
+	// The perform transactions of all the fromAddresses are sent through the keeper forwarder
+	for _, fromAddress := range spec.KeeperSpec.FromAddresses {
+		fwdrAddress, fwderr := chain.TxManager().GetForwarderForEOA(ctx, fromAddress.Address())
+		if fwderr != nil {
+			return nil, errors.Wrapf(fwderr, "unable to get forwarder of fromAddress %s", fromAddress)
+		}
+		if fwdrAddress != effectiveKeeperAddress {
+			return nil, errors.Errorf("forwarder %s of fromAddress %s is not the keeper address %s", fwdrAddress, fromAddress, effectiveKeeperAddress)
+		}
+	}
 
 	keeper := d.cfg.Keeper()
 	registry := keeper.Registry()
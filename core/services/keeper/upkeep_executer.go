@@ -267,10 +267,14 @@ func buildJobSpec(
 	gasFeeCap *assets.Wei,
 	chainID string,
 ) map[string]interface{} {
+	privateSubmission := jb.KeeperSpec != nil && jb.KeeperSpec.PrivateSubmission
 	return map[string]interface{}{
 		"jobSpec": map[string]interface{}{
 			"jobID":                  jb.ID,
+			"externalJobID":          jb.ExternalJobID,
+			"privateSubmission":      privateSubmission,
 			"fromAddress":            upkeep.Registry.FromAddress.String(),
+			"fromAddresses":          performFromAddresses(jb, upkeep),
 			"effectiveKeeperAddress": effectiveKeeperAddress.String(),
 			"contractAddress":        upkeep.Registry.ContractAddress.String(),
 			"upkeepID":               upkeep.UpkeepID.String(),
@@ -287,3 +291,18 @@ func buildJobSpec(
 		},
 	}
 }
+
+// performFromAddresses returns the keys sending the perform transactions: the fromAddress of the registry, followed by
+// the fromAddresses of the job.
+func performFromAddresses(jb job.Job, upkeep UpkeepRegistration) []interface{} {
+	addresses := []interface{}{upkeep.Registry.FromAddress.String()}
+	if jb.KeeperSpec == nil {
+		return addresses
+	}
+	for _, address := range jb.KeeperSpec.FromAddresses {
+		if address != upkeep.Registry.FromAddress {
+			addresses = append(addresses, address.String())
+		}
+	}
+	return addresses
+}
@@ -25,12 +25,14 @@ func (r *registry) MaxPerformDataSize() uint32 { return r.mpds }
 
 func TestBuildJobSpec(t *testing.T) {
 	from := types.EIP55Address(testutils.NewAddress().Hex())
+	pooled := types.EIP55Address(testutils.NewAddress().Hex())
 	contract := types.EIP55Address(testutils.NewAddress().Hex())
 	chainID := "250"
 	jb := job.Job{
 		ID: 10,
 		KeeperSpec: &job.KeeperSpec{
 			FromAddress:     from,
+			FromAddresses:   []types.EIP55Address{from, pooled},
 			ContractAddress: contract,
 		}}
 
@@ -58,7 +60,10 @@ func TestBuildJobSpec(t *testing.T) {
 	expected := map[string]interface{}{
 		"jobSpec": map[string]interface{}{
 			"jobID":                  int32(10),
+			"externalJobID":          jb.ExternalJobID,
+			"privateSubmission":      false,
 			"fromAddress":            from.String(),
+			"fromAddresses":          []interface{}{from.String(), pooled.String()},
 			"effectiveKeeperAddress": jb.KeeperSpec.FromAddress.String(),
 			"contractAddress":        contract.String(),
 			"upkeepID":               "4",
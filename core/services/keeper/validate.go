@@ -38,6 +38,10 @@ func ValidatedKeeperSpec(tomlString string) (job.Job, error) {
 		return j, errors.Errorf("unsupported type %s", j.Type)
 	}
 
+	if len(spec.FromAddresses) > 0 && !j.ForwardingAllowed {
+		return j, errors.New("fromAddresses requires forwardingAllowed, as the keys must share the forwarder registered as keeper")
+	}
+
 	if strings.Contains(tomlString, "observationSource") ||
 		strings.Contains(tomlString, "ObservationSource") {
 		return j, errors.New("There should be no 'observationSource' parameter included in the toml")
@@ -71,6 +71,45 @@ func TestValidatedKeeperSpec(t *testing.T) {
 			wantErr: false,
 		},
 
+		{
+			name: "valid job spec with fromAddresses",
+			args: args{
+				tomlString: `
+						    type                        = "keeper"
+						    name                        = "example keeper spec"
+						    contractAddress             = "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba"
+						    fromAddress                 = "0xa8037A20989AFcBC51798de9762b351D63ff462e"
+						    fromAddresses               = ["0x4a5A7a2E35C1fd4c3E45c1F1CCd7f6e1d5CCf38d"]
+						    forwardingAllowed           = true
+						    externalJobID               =  "123e4567-e89b-12d3-a456-426655440002"
+					    `,
+			},
+			want: want{
+				id:           0,
+				contractAddr: "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba",
+				fromAddr:     "0xa8037A20989AFcBC51798de9762b351D63ff462e",
+				createdAt:    time.Time{},
+				updatedAt:    time.Time{},
+			},
+			wantErr: false,
+		},
+
+		{
+			name: "invalid job spec because fromAddresses are not forwarded",
+			args: args{
+				tomlString: `
+						    type                        = "keeper"
+						    name                        = "invalid keeper spec example 3"
+						    contractAddress             = "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba"
+						    fromAddress                 = "0xa8037A20989AFcBC51798de9762b351D63ff462e"
+						    fromAddresses               = ["0x4a5A7a2E35C1fd4c3E45c1F1CCd7f6e1d5CCf38d"]
+						    externalJobID               =  "123e4567-e89b-12d3-a456-426655440002"
+					    `,
+			},
+			want:    want{},
+			wantErr: true,
+		},
+
 		{
 			name: "invalid job spec because of type",
 			args: args{
@@ -60,6 +60,7 @@ type (
 		TaskRetries() uint32
 		TaskMinBackoff() time.Duration
 		TaskMaxBackoff() time.Duration
+		TaskRetryPolicy() RetryPolicy
 		TaskTags() string
 		GetDescendantTasks() []Task
 	}
@@ -95,6 +96,7 @@ var (
 	ErrParameterEmpty        = errors.New("parameter is empty")
 	ErrIndexOutOfRange       = errors.New("index out of range")
 	ErrTooManyErrors         = errors.New("too many errors")
+	ErrQuorumNotMet          = errors.New("quorum not met")
 	ErrTimeout               = errors.New("timeout")
 	ErrTaskRunFailed         = errors.New("task run failed")
 	ErrCancelled             = errors.New("task run cancelled (fail early)")
@@ -206,6 +208,8 @@ type TaskRunResult struct {
 	Attempts   uint
 	CreatedAt  time.Time
 	FinishedAt null.Time
+	// PreviousAttempts holds the failed attempts that preceded this result when the task was retried
+	PreviousAttempts TaskRunAttempts
 	// runInfo is never persisted
 	runInfo RunInfo
 }
@@ -295,6 +299,7 @@ func (t TaskType) String() string {
 }
 
 const (
+	TaskTypeAggregate        TaskType = "aggregate"
 	TaskTypeAny              TaskType = "any"
 	TaskTypeBase64Decode     TaskType = "base64decode"
 	TaskTypeBase64Encode     TaskType = "base64encode"
@@ -313,6 +318,7 @@ const (
 	TaskTypeHexDecode        TaskType = "hexdecode"
 	TaskTypeHexEncode        TaskType = "hexencode"
 	TaskTypeJSONParse        TaskType = "jsonparse"
+	TaskTypeJSONPath         TaskType = "jsonpath"
 	TaskTypeLength           TaskType = "length"
 	TaskTypeLessThan         TaskType = "lessthan"
 	TaskTypeLookup           TaskType = "lookup"
@@ -322,6 +328,7 @@ const (
 	TaskTypeMerge            TaskType = "merge"
 	TaskTypeMode             TaskType = "mode"
 	TaskTypeMultiply         TaskType = "multiply"
+	TaskTypeSubpipeline      TaskType = "subpipeline"
 	TaskTypeSum              TaskType = "sum"
 	TaskTypeUppercase        TaskType = "uppercase"
 	TaskTypeVRF              TaskType = "vrf"
@@ -365,6 +372,8 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &MeanTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMedian:
 		task = &MedianTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeAggregate:
+		task = &AggregateTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMode:
 		task = &ModeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeSum:
@@ -373,6 +382,8 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &AnyTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeJSONParse:
 		task = &JSONParseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeJSONPath:
+		task = &JSONPathTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMemo:
 		task = &MemoTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMultiply:
@@ -405,6 +416,8 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &FailTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMerge:
 		task = &MergeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeSubpipeline:
+		task = &SubpipelineTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeLength:
 		task = &LengthTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeLessThan:
@@ -467,6 +480,10 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		}
 	}
 
+	if err = task.Base().RetryOn.validate(); err != nil {
+		return nil, err
+	}
+
 	// the 'unset' value should be -1 to allow explicit indexes to be 0-based
 	for _, key := range metadata.Unset {
 		if key == "index" {
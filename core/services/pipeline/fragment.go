@@ -0,0 +1,404 @@
+package pipeline
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gonum.org/v1/gonum/graph"
+)
+
+var (
+	ErrSubpipelineCycle       = errors.New("subpipeline cycle detected")
+	ErrSubpipelineNotExpanded = errors.New("subpipeline was not expanded")
+
+	fragmentNameRegexp = regexp.MustCompile("^[a-zA-Z0-9_-]+$")
+)
+
+const (
+	// maxSubpipelineDepth bounds how deeply fragments may reference other fragments.
+	maxSubpipelineDepth = 8
+	// fragmentParamsPrefix is the variable that fragments use to refer to the
+	// parameters bound by a subpipeline task, e.g. $(params.symbol).
+	fragmentParamsPrefix = "params"
+)
+
+// Fragment is a named, versioned piece of DOT that jobs can include in their
+// pipeline with a subpipeline task. Every change to a fragment creates a new
+// version; subpipeline tasks that do not pin a version use the latest one when
+// their job runs.
+type Fragment struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	Version   int32     `json:"version"`
+	Source    string    `json:"source"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// FragmentResolver looks up the fragments referenced by subpipeline tasks. A
+// zero version refers to the latest version of the fragment.
+type FragmentResolver interface {
+	FindFragment(ctx context.Context, name string, version int32) (Fragment, error)
+}
+
+// Fragments holds the fragment versions that the subpipeline tasks of a
+// pipeline spec were resolved to when the spec was created. It records the
+// fragments that the jobs include, while runs look the fragments up again to
+// pick up their latest versions, and only expand their subpipelines from it
+// when the lookup fails.
+type Fragments []Fragment
+
+var _ FragmentResolver = Fragments(nil)
+
+// FindFragment implements FragmentResolver. A zero version refers to the
+// latest version of the fragment when it was resolved.
+func (fs Fragments) FindFragment(_ context.Context, name string, version int32) (fragment Fragment, err error) {
+	found := false
+	for _, f := range fs {
+		if f.Name == name && (f.Version == version || version == 0 && f.Version > fragment.Version) {
+			fragment, found = f, true
+		}
+	}
+	if !found {
+		return fragment, errors.Wrap(sql.ErrNoRows, "fragment was not resolved with the pipeline spec")
+	}
+	return fragment, nil
+}
+
+func (fs *Fragments) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("Fragments#Scan received a value of type %T", value)
+	}
+	return json.Unmarshal(bytes, fs)
+}
+
+func (fs Fragments) Value() (driver.Value, error) {
+	if len(fs) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(fs)
+}
+
+// ResolveFragments looks up with resolver every fragment that the subpipeline
+// tasks of text reference, including nested ones, and checks that they can be
+// expanded. The result is meant to be stored with the pipeline spec.
+func ResolveFragments(ctx context.Context, text string, resolver FragmentResolver) (Fragments, error) {
+	r := &recordingResolver{resolver: resolver}
+	if _, err := ParseWithFragments(ctx, text, r); err != nil {
+		return nil, err
+	}
+	return r.fragments, nil
+}
+
+// recordingResolver records the fragments found by resolver.
+type recordingResolver struct {
+	resolver  FragmentResolver
+	fragments Fragments
+}
+
+func (r *recordingResolver) FindFragment(ctx context.Context, name string, version int32) (Fragment, error) {
+	fragment, err := r.resolver.FindFragment(ctx, name, version)
+	if err != nil {
+		return fragment, err
+	}
+	for _, f := range r.fragments {
+		if f.Name == fragment.Name && f.Version == fragment.Version {
+			return fragment, nil
+		}
+	}
+	r.fragments = append(r.fragments, fragment)
+	return fragment, nil
+}
+
+// ReferencesFragment returns true if source includes the named fragment with a subpipeline task.
+func ReferencesFragment(source string, name string) (bool, error) {
+	p, err := Parse(source)
+	if err != nil {
+		return false, err
+	}
+	for _, task := range p.Tasks {
+		if task.Type() == TaskTypeSubpipeline && task.(*SubpipelineTask).Name == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ValidateFragmentName checks that name can be stored and referenced by a subpipeline task.
+func ValidateFragmentName(name string) error {
+	if !fragmentNameRegexp.MatchString(name) {
+		return fmt.Errorf("fragment name %q must only contain letters, numbers, dashes and underscores", name)
+	}
+	return nil
+}
+
+// ValidateFragment checks that fragment is valid DOT with a single output task
+// and that every subpipeline it references can be expanded without a cycle,
+// so that an update creating a cycle is rejected before it is stored.
+func ValidateFragment(ctx context.Context, fragment Fragment, resolver FragmentResolver) error {
+	if err := ValidateFragmentName(fragment.Name); err != nil {
+		return err
+	}
+	if _, err := Parse(fragment.Source); err != nil {
+		return err
+	}
+	g := NewGraph()
+	if err := g.UnmarshalText([]byte(fragment.Source)); err != nil {
+		return err
+	}
+	if _, err := g.fragmentOutput(); err != nil {
+		return err
+	}
+	return g.expandSubpipelines(ctx, resolver, []string{fragment.Name})
+}
+
+// ParseWithFragments parses text like Parse, then expands every subpipeline
+// task with the fragment it references.
+func ParseWithFragments(ctx context.Context, text string, resolver FragmentResolver) (*Pipeline, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, errors.New("empty pipeline")
+	}
+	g := NewGraph()
+	if err := g.UnmarshalText([]byte(text)); err != nil {
+		return nil, err
+	}
+	if err := g.expandSubpipelines(ctx, resolver, nil); err != nil {
+		return nil, err
+	}
+	return newPipeline(g, text)
+}
+
+// HasSubpipelines returns true if the pipeline contains subpipeline tasks that
+// still need to be expanded with ParseWithFragments.
+func (p *Pipeline) HasSubpipelines() bool {
+	for _, task := range p.Tasks {
+		if task.Type() == TaskTypeSubpipeline {
+			return true
+		}
+	}
+	return false
+}
+
+// expandSubpipelines replaces every subpipeline node of g with the nodes of the
+// fragment it references. The fragment's output node takes over the ID of the
+// subpipeline node, so that other tasks can keep referring to its result. Its
+// remaining nodes are prefixed with the subpipeline node's ID. Inputs of the
+// subpipeline node are passed to the fragment nodes without inputs of their
+// own. stack holds the names of the fragments being expanded and is used to
+// detect cycles.
+func (g *Graph) expandSubpipelines(ctx context.Context, resolver FragmentResolver, stack []string) error {
+	var subpipelines []*GraphNode
+	for _, node := range g.sortedNodes() {
+		if TaskType(strings.ToLower(node.attrs["type"])) == TaskTypeSubpipeline {
+			subpipelines = append(subpipelines, node)
+		}
+	}
+	if len(subpipelines) == 0 {
+		return nil
+	}
+	if len(stack) >= maxSubpipelineDepth {
+		return errors.Errorf("subpipelines are nested deeper than %d levels: %s", maxSubpipelineDepth, strings.Join(stack, " -> "))
+	}
+
+	for _, node := range subpipelines {
+		if err := g.expandSubpipeline(ctx, resolver, stack, node); err != nil {
+			return errors.Wrapf(err, "subpipeline %s", node.dotID)
+		}
+	}
+	g.AddImplicitDependenciesAsEdges()
+	return nil
+}
+
+func (g *Graph) expandSubpipeline(ctx context.Context, resolver FragmentResolver, stack []string, node *GraphNode) error {
+	name := node.attrs["name"]
+	if name == "" {
+		return errors.New("name is required")
+	}
+	for _, s := range stack {
+		if s == name {
+			return errors.Wrapf(ErrSubpipelineCycle, "%s -> %s", strings.Join(stack, " -> "), name)
+		}
+	}
+	var version int32
+	if v := node.attrs["version"]; v != "" {
+		parsed, err := strconv.ParseInt(v, 10, 32)
+		if err != nil || parsed < 1 {
+			return errors.Errorf("invalid version %q", v)
+		}
+		version = int32(parsed)
+	}
+	params := map[string]string{}
+	if p := node.attrs["params"]; p != "" {
+		var raw map[string]interface{}
+		if err := json.Unmarshal([]byte(p), &raw); err != nil {
+			return errors.Wrap(err, "params must be a JSON object")
+		}
+		for k, v := range raw {
+			if s, ok := v.(string); ok {
+				params[k] = s
+				continue
+			}
+			bs, err := json.Marshal(v)
+			if err != nil {
+				return errors.Wrapf(err, "param %s", k)
+			}
+			params[k] = string(bs)
+		}
+	}
+
+	fragment, err := resolver.FindFragment(ctx, name, version)
+	if err != nil {
+		return errors.Wrapf(err, "could not find fragment %s (version %d)", name, version)
+	}
+	fg := NewGraph()
+	if err = fg.UnmarshalText([]byte(fragment.Source)); err != nil {
+		return errors.Wrapf(err, "fragment %s (version %d)", name, fragment.Version)
+	}
+	if err = fg.expandSubpipelines(ctx, resolver, append(stack[:len(stack):len(stack)], name)); err != nil {
+		return errors.Wrapf(err, "fragment %s (version %d)", name, fragment.Version)
+	}
+	output, err := fg.fragmentOutput()
+	if err != nil {
+		return errors.Wrapf(err, "fragment %s (version %d)", name, fragment.Version)
+	}
+
+	fragmentNodes := fg.sortedNodes()
+	renamed := make(map[string]string)
+	for _, fn := range fragmentNodes {
+		if fn == output {
+			renamed[fn.dotID] = node.dotID
+		} else {
+			renamed[fn.dotID] = node.dotID + "__" + fn.dotID
+		}
+	}
+	for nodes := g.Nodes(); nodes.Next(); {
+		n := nodes.Node().(*GraphNode)
+		for from, to := range renamed {
+			if n != node && n.dotID == to {
+				return errors.Errorf("task %s of fragment %s conflicts with existing task %s", from, name, to)
+			}
+		}
+	}
+
+	// copy the fragment's nodes, binding params and renaming references to other fragment nodes
+	added := make(map[int64]*GraphNode)
+	var entries []*GraphNode
+	for _, fn := range fragmentNodes {
+		n := g.NewNode().(*GraphNode)
+		n.dotID = renamed[fn.dotID]
+		n.attrs = make(map[string]string, len(fn.attrs))
+		for k, v := range fn.attrs {
+			n.attrs[k], err = bindFragmentAttribute(v, params, renamed)
+			if err != nil {
+				return errors.Wrapf(err, "fragment %s (version %d), task %s", name, fragment.Version, fn.dotID)
+			}
+		}
+		if fn == output {
+			// attributes such as index or timeout set on the subpipeline task apply to its result
+			for k, v := range node.attrs {
+				switch k {
+				case "type", "name", "version", "params":
+				default:
+					n.attrs[k] = v
+				}
+			}
+		}
+		g.AddNode(n)
+		added[fn.ID()] = n
+		if fg.To(fn.ID()).Len() == 0 {
+			entries = append(entries, n)
+		}
+	}
+	for edges := fg.Edges(); edges.Next(); {
+		e := edges.Edge().(*GraphEdge)
+		g.setEdge(added[e.From().ID()], added[e.To().ID()], e.IsImplicit())
+	}
+
+	// inputs of the subpipeline feed the fragment's entry nodes, its outputs read from the fragment's output
+	for _, from := range graph.NodesOf(g.To(node.ID())) {
+		implicit := g.IsImplicitEdge(from.ID(), node.ID())
+		for _, n := range entries {
+			g.setEdge(from, n, implicit)
+		}
+	}
+	for _, to := range graph.NodesOf(g.From(node.ID())) {
+		g.setEdge(added[output.ID()], to, g.IsImplicitEdge(node.ID(), to.ID()))
+	}
+	g.RemoveNode(node.ID())
+	return nil
+}
+
+func (g *Graph) setEdge(from, to graph.Node, implicit bool) {
+	edge := g.NewEdge(from, to).(*GraphEdge)
+	edge.SetIsImplicit(implicit)
+	g.SetEdge(edge)
+}
+
+// sortedNodes returns the nodes of g ordered by ID, so that expansion is deterministic.
+func (g *Graph) sortedNodes() []*GraphNode {
+	var nodes []*GraphNode
+	for it := g.Nodes(); it.Next(); {
+		nodes = append(nodes, it.Node().(*GraphNode))
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].ID() < nodes[j].ID()
+	})
+	return nodes
+}
+
+// fragmentOutput returns the single node of a fragment that has no outputs.
+func (g *Graph) fragmentOutput() (*GraphNode, error) {
+	var outputs []string
+	var output *GraphNode
+	for _, n := range g.sortedNodes() {
+		if g.From(n.ID()).Len() == 0 {
+			outputs = append(outputs, n.dotID)
+			output = n
+		}
+	}
+	if len(outputs) != 1 {
+		return nil, errors.Errorf("a fragment must have exactly one output task, got %d: %v", len(outputs), outputs)
+	}
+	return output, nil
+}
+
+// bindFragmentAttribute substitutes $(params.x) variables with the bound
+// params and renames variables that refer to other nodes of the fragment.
+func bindFragmentAttribute(value string, params map[string]string, renamed map[string]string) (string, error) {
+	var missing []string
+	bound := variableRegexp.ReplaceAllStringFunc(value, func(expr string) string {
+		keypath := strings.TrimSpace(expr[2 : len(expr)-1])
+		head, rest, _ := strings.Cut(keypath, ".")
+		if head == fragmentParamsPrefix {
+			v, ok := params[rest]
+			if !ok {
+				missing = append(missing, rest)
+				return expr
+			}
+			return v
+		}
+		if to, ok := renamed[head]; ok {
+			if rest == "" {
+				return "$(" + to + ")"
+			}
+			return "$(" + to + "." + rest + ")"
+		}
+		return expr
+	})
+	if len(missing) > 0 {
+		return "", errors.Errorf("missing params %v", missing)
+	}
+	return bound, nil
+}
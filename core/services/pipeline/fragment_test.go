@@ -0,0 +1,267 @@
+package pipeline_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
+)
+
+// fragments resolves fragments by name, where index i holds version i+1.
+type fragments map[string][]string
+
+func (f fragments) FindFragment(_ context.Context, name string, version int32) (pipeline.Fragment, error) {
+	versions := f[name]
+	if len(versions) == 0 || int(version) > len(versions) {
+		return pipeline.Fragment{}, sql.ErrNoRows
+	}
+	if version == 0 {
+		version = int32(len(versions))
+	}
+	return pipeline.Fragment{Name: name, Version: version, Source: versions[version-1]}, nil
+}
+
+const priceFragment = `
+fetch  [type=memo value="$(params.price)"];
+scale  [type=multiply input="$(fetch)" times="$(params.times)"];
+fetch -> scale;
+`
+
+func TestParseWithFragments(t *testing.T) {
+	t.Parallel()
+
+	resolver := fragments{
+		"price": {priceFragment},
+	}
+
+	p, err := pipeline.ParseWithFragments(testutils.Context(t), `
+	a      [type=subpipeline name=price params=<{"price": 10, "times": 2}>];
+	b      [type=subpipeline name=price params=<{"price": 20, "times": "$(jobRun.times)"}> index=0];
+	median [type=median];
+	a -> median;
+	b -> median;
+	`, resolver)
+	require.NoError(t, err)
+	assert.False(t, p.HasSubpipelines())
+
+	var dotIDs []string
+	for _, task := range p.Tasks {
+		dotIDs = append(dotIDs, task.DotID())
+	}
+	assert.ElementsMatch(t, []string{"a__fetch", "a", "b__fetch", "b", "median"}, dotIDs)
+
+	a := p.ByDotID("a").(*pipeline.MultiplyTask)
+	assert.Equal(t, "2", a.Times)
+	require.Len(t, a.Inputs(), 1)
+	assert.Equal(t, "a__fetch", a.Inputs()[0].InputTask.DotID())
+	assert.Equal(t, "$(a__fetch)", a.Input)
+
+	b := p.ByDotID("b").(*pipeline.MultiplyTask)
+	assert.Equal(t, "$(jobRun.times)", b.Times)
+	assert.Equal(t, int32(0), b.OutputIndex())
+	assert.Equal(t, "20", p.ByDotID("b__fetch").(*pipeline.MemoTask).Value)
+
+	median := p.ByDotID("median")
+	require.Len(t, median.Inputs(), 2)
+	assert.Equal(t, "a", median.Inputs()[0].InputTask.DotID())
+	assert.Equal(t, "b", median.Inputs()[1].InputTask.DotID())
+
+	t.Run("runner expands subpipelines from the fragments resolved with the spec if the lookup fails", func(t *testing.T) {
+		orm := mocks.NewORM(t)
+		orm.On("FindFragment", mock.Anything, "price", int32(0)).Return(pipeline.Fragment{}, errors.New("connection refused")).Once()
+		cfg := configtest.NewTestGeneralConfig(t)
+		r := pipeline.NewRunner(orm, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), nil, nil)
+
+		spec := pipeline.Spec{
+			DotDagSource: `ds [type=subpipeline name=price params=<{"price": 10, "times": "$(jobRun.times)"}>];`,
+			Fragments:    pipeline.Fragments{{Name: "price", Version: 1, Source: priceFragment}},
+		}
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"jobRun": map[string]interface{}{"times": 3}})
+		_, trrs, err := r.ExecuteRun(testutils.Context(t), spec, vars)
+		require.NoError(t, err)
+		require.Len(t, trrs, 2)
+
+		result, err := trrs.FinalResult().SingularResult()
+		require.NoError(t, err)
+		assert.Equal(t, "30", result.Value.(decimal.Decimal).String())
+	})
+}
+
+func TestParseWithFragments_Versions(t *testing.T) {
+	t.Parallel()
+
+	resolver := fragments{
+		"answer": {`v [type=memo value=1];`, `v [type=memo value=2];`},
+	}
+
+	p, err := pipeline.ParseWithFragments(testutils.Context(t), `a [type=subpipeline name=answer];`, resolver)
+	require.NoError(t, err)
+	assert.Equal(t, "2", p.ByDotID("a").(*pipeline.MemoTask).Value)
+
+	p, err = pipeline.ParseWithFragments(testutils.Context(t), `a [type=subpipeline name=answer version=1];`, resolver)
+	require.NoError(t, err)
+	assert.Equal(t, "1", p.ByDotID("a").(*pipeline.MemoTask).Value)
+
+	_, err = pipeline.ParseWithFragments(testutils.Context(t), `a [type=subpipeline name=answer version=3];`, resolver)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, sql.ErrNoRows))
+}
+
+func TestParseWithFragments_Nested(t *testing.T) {
+	t.Parallel()
+
+	resolver := fragments{
+		"outer": {`
+		inner  [type=subpipeline name=inner params=<{"value": "$(params.value)"}>];
+		double [type=multiply times=2 input="$(inner)"];
+		inner -> double;
+		`},
+		"inner": {`v [type=memo value="$(params.value)"];`},
+	}
+
+	p, err := pipeline.ParseWithFragments(testutils.Context(t), `
+	ds [type=subpipeline name=outer params=<{"value": 21}>];
+	`, resolver)
+	require.NoError(t, err)
+	assert.Equal(t, "21", p.ByDotID("ds__inner").(*pipeline.MemoTask).Value)
+	assert.Equal(t, "$(ds__inner)", p.ByDotID("ds").(*pipeline.MultiplyTask).Input)
+}
+
+func TestParseWithFragments_Errors(t *testing.T) {
+	t.Parallel()
+
+	resolver := fragments{
+		"a":       {`x [type=subpipeline name=b];`},
+		"b":       {`x [type=subpipeline name=a];`},
+		"self":    {`x [type=subpipeline name=self];`},
+		"twoOuts": {`x [type=memo value=1]; y [type=memo value=2];`},
+		"param":   {`x [type=memo value="$(params.missing)"];`},
+		"price":   {priceFragment},
+	}
+
+	tests := []struct {
+		name      string
+		source    string
+		wantCause error
+		wantError string
+	}{
+		{"cycle", `ds [type=subpipeline name=a];`, pipeline.ErrSubpipelineCycle, "a -> b -> a"},
+		{"self reference", `ds [type=subpipeline name=self];`, pipeline.ErrSubpipelineCycle, "self -> self"},
+		{"unknown fragment", `ds [type=subpipeline name=nope];`, sql.ErrNoRows, "could not find fragment nope"},
+		{"missing name", `ds [type=subpipeline];`, nil, "name is required"},
+		{"invalid version", `ds [type=subpipeline name=price version=latest];`, nil, `invalid version "latest"`},
+		{"invalid params", `ds [type=subpipeline name=price params="[1]"];`, nil, "params must be a JSON object"},
+		{"multiple outputs", `ds [type=subpipeline name=twoOuts];`, nil, "exactly one output task"},
+		{"unbound param", `ds [type=subpipeline name=param];`, nil, "missing params [missing]"},
+		{"conflicting task", `ds [type=subpipeline name=price params=<{"price": 1, "times": 1}>]; ds__fetch [type=memo value=1];`, nil, "conflicts with existing task ds__fetch"},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			_, err := pipeline.ParseWithFragments(testutils.Context(t), test.source, resolver)
+			require.Error(t, err)
+			if test.wantCause != nil {
+				assert.True(t, errors.Is(err, test.wantCause), err.Error())
+			}
+			assert.Contains(t, err.Error(), test.wantError)
+		})
+	}
+}
+
+func TestResolveFragments(t *testing.T) {
+	t.Parallel()
+
+	resolver := fragments{
+		"outer":  {`x [type=subpipeline name=inner version=1];`},
+		"inner":  {`v [type=memo value=1];`, `v [type=memo value=2];`},
+		"unused": {`v [type=memo value=3];`},
+	}
+
+	resolved, err := pipeline.ResolveFragments(testutils.Context(t), `
+	a [type=subpipeline name=outer];
+	b [type=subpipeline name=inner];
+	c [type=subpipeline name=inner version=1];
+	`, resolver)
+	require.NoError(t, err)
+	require.Len(t, resolved, 3)
+
+	// the latest version when resolved is used for subpipelines without a version, even if later versions are added
+	resolver["inner"] = append(resolver["inner"], `v [type=memo value=4];`)
+	p, err := pipeline.ParseWithFragments(testutils.Context(t), `
+	a [type=subpipeline name=outer];
+	b [type=subpipeline name=inner];
+	c [type=subpipeline name=inner version=1];
+	`, resolved)
+	require.NoError(t, err)
+	assert.Equal(t, "1", p.ByDotID("a").(*pipeline.MemoTask).Value)
+	assert.Equal(t, "2", p.ByDotID("b").(*pipeline.MemoTask).Value)
+	assert.Equal(t, "1", p.ByDotID("c").(*pipeline.MemoTask).Value)
+
+	_, err = resolved.FindFragment(testutils.Context(t), "unused", 0)
+	assert.True(t, errors.Is(err, sql.ErrNoRows))
+
+	_, err = pipeline.ResolveFragments(testutils.Context(t), `a [type=subpipeline name=nope];`, resolver)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, sql.ErrNoRows))
+}
+
+func TestReferencesFragment(t *testing.T) {
+	t.Parallel()
+
+	references, err := pipeline.ReferencesFragment(`a [type=subpipeline name=price]; b [type=memo value="price"];`, "price")
+	require.NoError(t, err)
+	assert.True(t, references)
+
+	references, err = pipeline.ReferencesFragment(`a [type=subpipeline name=prices]; b [type=memo value="price"];`, "price")
+	require.NoError(t, err)
+	assert.False(t, references)
+}
+
+func TestValidateFragment(t *testing.T) {
+	t.Parallel()
+
+	resolver := fragments{
+		"b": {`x [type=subpipeline name=a];`},
+	}
+
+	require.NoError(t, pipeline.ValidateFragment(testutils.Context(t), pipeline.Fragment{Name: "price", Source: priceFragment}, resolver))
+
+	err := pipeline.ValidateFragment(testutils.Context(t), pipeline.Fragment{Name: "a", Source: `x [type=subpipeline name=b];`}, resolver)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, pipeline.ErrSubpipelineCycle))
+
+	err = pipeline.ValidateFragment(testutils.Context(t), pipeline.Fragment{Name: "bad name", Source: priceFragment}, resolver)
+	require.Error(t, err)
+
+	err = pipeline.ValidateFragment(testutils.Context(t), pipeline.Fragment{Name: "two", Source: `x [type=memo value=1]; y [type=memo value=2];`}, resolver)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exactly one output task")
+}
+
+func TestSubpipelineTask_NotExpanded(t *testing.T) {
+	t.Parallel()
+
+	p, err := pipeline.Parse(`ds [type=subpipeline name=price version=2 params=<{"price": 1}>];`)
+	require.NoError(t, err)
+	require.True(t, p.HasSubpipelines())
+
+	task := p.Tasks[0].(*pipeline.SubpipelineTask)
+	assert.Equal(t, "price", task.Name)
+	assert.Equal(t, "2", task.Version)
+	assert.Equal(t, `{"price": 1}`, task.Params)
+
+	result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	assert.True(t, errors.Is(result.Error, pipeline.ErrSubpipelineNotExpanded))
+}
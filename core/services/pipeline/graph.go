@@ -219,6 +219,10 @@ func Parse(text string) (*Pipeline, error) {
 		return nil, err
 	}
 
+	return newPipeline(g, text)
+}
+
+func newPipeline(g *Graph, text string) (*Pipeline, error) {
 	p := &Pipeline{
 		tree:   g,
 		Tasks:  make([]Task, 0, g.Nodes().Len()),
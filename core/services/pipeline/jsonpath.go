@@ -0,0 +1,640 @@
+package pipeline
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+var ErrWrongJSONPath = errors.New("wrong JSONPath expression")
+
+// JSONPath is a compiled JSONPath expression, as used by the jsonpath task.
+//
+// Supported syntax:
+//
+//	$                    root
+//	.name, ['name']      child member
+//	[0], [-1]            array index, negative indexes count from the end
+//	[0,2], ['a','b']     union
+//	[1:3], [::2]         array slice
+//	.*, [*]              all children
+//	..name, ..[0]        recursive descent
+//	[?(@.price > 10)]    filter, with ==, !=, <, <=, >, >=, &&, || and parentheses
+//
+// A path is definite if it contains no wildcards, unions, slices, filters or
+// recursive descents. Definite paths yield a single value, all others an array of
+// every match.
+type JSONPath struct {
+	expr     string
+	segments []jsonPathSegment
+}
+
+type jsonPathSegment struct {
+	recursive bool
+	selectors []jsonPathSelector
+}
+
+type jsonPathSelector interface {
+	// appendMatches appends the children of node matched by the selector to out
+	appendMatches(out []interface{}, node interface{}) []interface{}
+}
+
+// CompileJSONPath parses a JSONPath expression.
+func CompileJSONPath(expr string) (JSONPath, error) {
+	expr = strings.TrimSpace(expr)
+	if !strings.HasPrefix(expr, "$") {
+		return JSONPath{}, errors.Wrapf(ErrWrongJSONPath, "%q must start with $", expr)
+	}
+	p := &jsonPathParser{expr: expr, pos: 1}
+	segments, err := p.parseSegments(false)
+	if err != nil {
+		return JSONPath{}, err
+	}
+	return JSONPath{expr: expr, segments: segments}, nil
+}
+
+func (p JSONPath) String() string {
+	return p.expr
+}
+
+// IsDefinite returns true if the path can match at most a single value.
+func (p JSONPath) IsDefinite() bool {
+	for _, seg := range p.segments {
+		if seg.recursive || len(seg.selectors) != 1 {
+			return false
+		}
+		switch seg.selectors[0].(type) {
+		case jsonPathName, jsonPathIndex:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// Find returns every value in doc matched by the path, in document order.
+func (p JSONPath) Find(doc interface{}) []interface{} {
+	nodes := []interface{}{doc}
+	for _, seg := range p.segments {
+		var next []interface{}
+		for _, node := range nodes {
+			if seg.recursive {
+				for _, descendant := range jsonDescendants(nil, node) {
+					for _, sel := range seg.selectors {
+						next = sel.appendMatches(next, descendant)
+					}
+				}
+				continue
+			}
+			for _, sel := range seg.selectors {
+				next = sel.appendMatches(next, node)
+			}
+		}
+		nodes = next
+	}
+	return nodes
+}
+
+// jsonDescendants appends node and all of its descendants to out.
+func jsonDescendants(out []interface{}, node interface{}) []interface{} {
+	out = append(out, node)
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for _, key := range sortedKeys(n) {
+			out = jsonDescendants(out, n[key])
+		}
+	case []interface{}:
+		for _, child := range n {
+			out = jsonDescendants(out, child)
+		}
+	}
+	return out
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+type jsonPathName string
+
+func (s jsonPathName) appendMatches(out []interface{}, node interface{}) []interface{} {
+	if m, ok := node.(map[string]interface{}); ok {
+		if v, exists := m[string(s)]; exists {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+type jsonPathWildcard struct{}
+
+func (jsonPathWildcard) appendMatches(out []interface{}, node interface{}) []interface{} {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for _, key := range sortedKeys(n) {
+			out = append(out, n[key])
+		}
+	case []interface{}:
+		out = append(out, n...)
+	}
+	return out
+}
+
+type jsonPathIndex int
+
+func (s jsonPathIndex) appendMatches(out []interface{}, node interface{}) []interface{} {
+	if a, ok := node.([]interface{}); ok {
+		i := int(s)
+		if i < 0 {
+			i += len(a)
+		}
+		if i >= 0 && i < len(a) {
+			out = append(out, a[i])
+		}
+	}
+	return out
+}
+
+type jsonPathSlice struct {
+	start, end *int
+	step       int
+}
+
+func (s jsonPathSlice) appendMatches(out []interface{}, node interface{}) []interface{} {
+	a, ok := node.([]interface{})
+	if !ok {
+		return out
+	}
+	normalize := func(i *int, def int) int {
+		if i == nil {
+			return def
+		}
+		v := *i
+		if v < 0 {
+			v += len(a)
+		}
+		return min(max(v, 0), len(a))
+	}
+	if s.step > 0 {
+		for i := normalize(s.start, 0); i < normalize(s.end, len(a)); i += s.step {
+			out = append(out, a[i])
+		}
+		return out
+	}
+	start, end := len(a)-1, -1
+	if s.start != nil {
+		start = min(normalize(s.start, 0), len(a)-1)
+	}
+	if s.end != nil {
+		end = normalize(s.end, 0)
+	}
+	for i := start; i > end; i += s.step {
+		out = append(out, a[i])
+	}
+	return out
+}
+
+type jsonPathFilter struct {
+	expr jsonFilterExpr
+}
+
+func (s jsonPathFilter) appendMatches(out []interface{}, node interface{}) []interface{} {
+	var children []interface{}
+	children = jsonPathWildcard{}.appendMatches(children, node)
+	for _, child := range children {
+		if truthy(s.expr.eval(child)) {
+			out = append(out, child)
+		}
+	}
+	return out
+}
+
+// jsonFilterExpr is a node of a filter expression, evaluated against the
+// current element (@).
+type jsonFilterExpr interface {
+	eval(current interface{}) interface{}
+}
+
+type jsonFilterLiteral struct{ value interface{} }
+
+func (e jsonFilterLiteral) eval(interface{}) interface{} { return e.value }
+
+// jsonFilterMissing is the value of a relative path that matched nothing.
+type jsonFilterMissing struct{}
+
+type jsonFilterPath struct{ segments []jsonPathSegment }
+
+func (e jsonFilterPath) eval(current interface{}) interface{} {
+	matches := JSONPath{segments: e.segments}.Find(current)
+	if len(matches) == 0 {
+		return jsonFilterMissing{}
+	}
+	return matches[0]
+}
+
+type jsonFilterBinary struct {
+	op          string
+	left, right jsonFilterExpr
+}
+
+func (e jsonFilterBinary) eval(current interface{}) interface{} {
+	switch e.op {
+	case "&&":
+		return truthy(e.left.eval(current)) && truthy(e.right.eval(current))
+	case "||":
+		return truthy(e.left.eval(current)) || truthy(e.right.eval(current))
+	}
+
+	left, right := e.left.eval(current), e.right.eval(current)
+	if _, missing := left.(jsonFilterMissing); missing {
+		return false
+	}
+	if _, missing := right.(jsonFilterMissing); missing {
+		return false
+	}
+
+	if ld, lok := jsonDecimal(left); lok {
+		if rd, rok := jsonDecimal(right); rok {
+			return compareResult(e.op, ld.Cmp(rd))
+		}
+	}
+	if ls, lok := left.(string); lok {
+		if rs, rok := right.(string); rok {
+			return compareResult(e.op, strings.Compare(ls, rs))
+		}
+	}
+	switch e.op {
+	case "==":
+		return jsonEqual(left, right)
+	case "!=":
+		return !jsonEqual(left, right)
+	}
+	return false
+}
+
+func compareResult(op string, cmp int) bool {
+	switch op {
+	case "==":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	}
+	return false
+}
+
+func jsonEqual(a, b interface{}) bool {
+	ab, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	bb, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return string(ab) == string(bb)
+}
+
+func jsonDecimal(v interface{}) (decimal.Decimal, bool) {
+	switch n := v.(type) {
+	case json.Number:
+		d, err := decimal.NewFromString(n.String())
+		return d, err == nil
+	case decimal.Decimal:
+		return n, true
+	case float64:
+		return decimal.NewFromFloat(n), true
+	case int64:
+		return decimal.NewFromInt(n), true
+	}
+	return decimal.Decimal{}, false
+}
+
+func truthy(v interface{}) bool {
+	switch b := v.(type) {
+	case jsonFilterMissing, nil:
+		return false
+	case bool:
+		return b
+	}
+	return true
+}
+
+type jsonPathParser struct {
+	expr string
+	pos  int
+}
+
+func (p *jsonPathParser) errorf(format string, args ...interface{}) error {
+	return errors.Wrapf(ErrWrongJSONPath, "%s at position %d in %q", fmt.Sprintf(format, args...), p.pos, p.expr)
+}
+
+func (p *jsonPathParser) peek() byte {
+	if p.pos >= len(p.expr) {
+		return 0
+	}
+	return p.expr[p.pos]
+}
+
+func (p *jsonPathParser) skipSpaces() {
+	for p.pos < len(p.expr) && p.expr[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+// parseSegments parses path segments until the end of the expression or, for
+// relative paths inside filters, until the first character that cannot
+// continue a path.
+func (p *jsonPathParser) parseSegments(relative bool) (segments []jsonPathSegment, err error) {
+	for p.pos < len(p.expr) {
+		var seg jsonPathSegment
+		switch {
+		case strings.HasPrefix(p.expr[p.pos:], ".."):
+			p.pos += 2
+			seg.recursive = true
+			if p.peek() == '[' {
+				seg.selectors, err = p.parseBracket()
+			} else {
+				seg.selectors, err = p.parseDotted()
+			}
+		case p.peek() == '.':
+			p.pos++
+			seg.selectors, err = p.parseDotted()
+		case p.peek() == '[':
+			seg.selectors, err = p.parseBracket()
+		default:
+			if relative {
+				return segments, nil
+			}
+			return nil, p.errorf("unexpected character %q", p.peek())
+		}
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, seg)
+	}
+	return segments, nil
+}
+
+func (p *jsonPathParser) parseDotted() ([]jsonPathSelector, error) {
+	if p.peek() == '*' {
+		p.pos++
+		return []jsonPathSelector{jsonPathWildcard{}}, nil
+	}
+	start := p.pos
+	for p.pos < len(p.expr) && isJSONPathNameChar(p.expr[p.pos]) {
+		p.pos++
+	}
+	if start == p.pos {
+		return nil, p.errorf("expected member name")
+	}
+	return []jsonPathSelector{jsonPathName(p.expr[start:p.pos])}, nil
+}
+
+func isJSONPathNameChar(c byte) bool {
+	return c == '_' || c == '-' || c == '$' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80
+}
+
+func (p *jsonPathParser) parseBracket() ([]jsonPathSelector, error) {
+	p.pos++ // [
+	p.skipSpaces()
+
+	var selectors []jsonPathSelector
+	switch {
+	case p.peek() == '*':
+		p.pos++
+		selectors = append(selectors, jsonPathWildcard{})
+	case strings.HasPrefix(p.expr[p.pos:], "?("):
+		p.pos += 2
+		expr, err := p.parseFilterOr()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpaces()
+		if p.peek() != ')' {
+			return nil, p.errorf("expected ) to close filter")
+		}
+		p.pos++
+		selectors = append(selectors, jsonPathFilter{expr: expr})
+	default:
+		for {
+			p.skipSpaces()
+			sel, err := p.parseUnionMember()
+			if err != nil {
+				return nil, err
+			}
+			selectors = append(selectors, sel)
+			p.skipSpaces()
+			if p.peek() != ',' {
+				break
+			}
+			p.pos++
+		}
+	}
+
+	p.skipSpaces()
+	if p.peek() != ']' {
+		return nil, p.errorf("expected ]")
+	}
+	p.pos++
+	return selectors, nil
+}
+
+func (p *jsonPathParser) parseUnionMember() (jsonPathSelector, error) {
+	if c := p.peek(); c == '\'' || c == '"' {
+		s, err := p.parseQuoted()
+		if err != nil {
+			return nil, err
+		}
+		return jsonPathName(s), nil
+	}
+
+	var parts []*int
+	for {
+		p.skipSpaces()
+		start := p.pos
+		if p.peek() == '-' {
+			p.pos++
+		}
+		for p.peek() >= '0' && p.peek() <= '9' {
+			p.pos++
+		}
+		if start == p.pos {
+			parts = append(parts, nil)
+		} else {
+			i, err := strconv.Atoi(p.expr[start:p.pos])
+			if err != nil {
+				return nil, p.errorf("invalid index %q", p.expr[start:p.pos])
+			}
+			parts = append(parts, &i)
+		}
+		p.skipSpaces()
+		if p.peek() != ':' || len(parts) == 3 {
+			break
+		}
+		p.pos++
+	}
+
+	if len(parts) == 1 {
+		if parts[0] == nil {
+			return nil, p.errorf("expected index, name or slice")
+		}
+		return jsonPathIndex(*parts[0]), nil
+	}
+	slice := jsonPathSlice{start: parts[0], end: parts[1], step: 1}
+	if len(parts) == 3 && parts[2] != nil {
+		slice.step = *parts[2]
+	}
+	if slice.step == 0 {
+		return nil, p.errorf("slice step cannot be 0")
+	}
+	return slice, nil
+}
+
+func (p *jsonPathParser) parseQuoted() (string, error) {
+	quote := p.peek()
+	p.pos++
+	var sb strings.Builder
+	for p.pos < len(p.expr) {
+		c := p.expr[p.pos]
+		p.pos++
+		switch c {
+		case quote:
+			return sb.String(), nil
+		case '\\':
+			if p.pos < len(p.expr) {
+				sb.WriteByte(p.expr[p.pos])
+				p.pos++
+			}
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return "", p.errorf("unterminated string")
+}
+
+func (p *jsonPathParser) parseFilterOr() (jsonFilterExpr, error) {
+	left, err := p.parseFilterAnd()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		p.skipSpaces()
+		if !strings.HasPrefix(p.expr[p.pos:], "||") {
+			return left, nil
+		}
+		p.pos += 2
+		right, err := p.parseFilterAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = jsonFilterBinary{op: "||", left: left, right: right}
+	}
+}
+
+func (p *jsonPathParser) parseFilterAnd() (jsonFilterExpr, error) {
+	left, err := p.parseFilterComparison()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		p.skipSpaces()
+		if !strings.HasPrefix(p.expr[p.pos:], "&&") {
+			return left, nil
+		}
+		p.pos += 2
+		right, err := p.parseFilterComparison()
+		if err != nil {
+			return nil, err
+		}
+		left = jsonFilterBinary{op: "&&", left: left, right: right}
+	}
+}
+
+var jsonFilterOperators = []string{"==", "!=", "<=", ">=", "<", ">"}
+
+func (p *jsonPathParser) parseFilterComparison() (jsonFilterExpr, error) {
+	left, err := p.parseFilterOperand()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	for _, op := range jsonFilterOperators {
+		if strings.HasPrefix(p.expr[p.pos:], op) {
+			p.pos += len(op)
+			right, err := p.parseFilterOperand()
+			if err != nil {
+				return nil, err
+			}
+			return jsonFilterBinary{op: op, left: left, right: right}, nil
+		}
+	}
+	return left, nil
+}
+
+func (p *jsonPathParser) parseFilterOperand() (jsonFilterExpr, error) {
+	p.skipSpaces()
+	switch c := p.peek(); {
+	case c == '(':
+		p.pos++
+		expr, err := p.parseFilterOr()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpaces()
+		if p.peek() != ')' {
+			return nil, p.errorf("expected )")
+		}
+		p.pos++
+		return expr, nil
+	case c == '@':
+		p.pos++
+		segments, err := p.parseSegments(true)
+		if err != nil {
+			return nil, err
+		}
+		return jsonFilterPath{segments: segments}, nil
+	case c == '\'' || c == '"':
+		s, err := p.parseQuoted()
+		if err != nil {
+			return nil, err
+		}
+		return jsonFilterLiteral{value: s}, nil
+	}
+
+	start := p.pos
+	for p.pos < len(p.expr) && !strings.ContainsRune(" )&|=!<>", rune(p.expr[p.pos])) {
+		p.pos++
+	}
+	switch token := p.expr[start:p.pos]; token {
+	case "true":
+		return jsonFilterLiteral{value: true}, nil
+	case "false":
+		return jsonFilterLiteral{value: false}, nil
+	case "null":
+		return jsonFilterLiteral{value: nil}, nil
+	default:
+		if _, err := decimal.NewFromString(token); err != nil {
+			p.pos = start
+			return nil, p.errorf("invalid filter operand %q", token)
+		}
+		return jsonFilterLiteral{value: json.Number(token)}, nil
+	}
+}
@@ -5,9 +5,12 @@ package mocks
 import (
 	context "context"
 
-	pipeline "github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	bridges "github.com/smartcontractkit/chainlink/v2/core/bridges"
+
 	mock "github.com/stretchr/testify/mock"
 
+	pipeline "github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+
 	sqlutil "github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 
 	uuid "github.com/google/uuid"
@@ -26,6 +29,53 @@ func (_m *Runner) EXPECT() *Runner_Expecter {
 	return &Runner_Expecter{mock: &_m.Mock}
 }
 
+// BridgeCircuits provides a mock function with given fields:
+func (_m *Runner) BridgeCircuits() *bridges.CircuitBreakers {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BridgeCircuits")
+	}
+
+	var r0 *bridges.CircuitBreakers
+	if rf, ok := ret.Get(0).(func() *bridges.CircuitBreakers); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*bridges.CircuitBreakers)
+		}
+	}
+
+	return r0
+}
+
+// Runner_BridgeCircuits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BridgeCircuits'
+type Runner_BridgeCircuits_Call struct {
+	*mock.Call
+}
+
+// BridgeCircuits is a helper method to define mock.On call
+func (_e *Runner_Expecter) BridgeCircuits() *Runner_BridgeCircuits_Call {
+	return &Runner_BridgeCircuits_Call{Call: _e.mock.On("BridgeCircuits")}
+}
+
+func (_c *Runner_BridgeCircuits_Call) Run(run func()) *Runner_BridgeCircuits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Runner_BridgeCircuits_Call) Return(_a0 *bridges.CircuitBreakers) *Runner_BridgeCircuits_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Runner_BridgeCircuits_Call) RunAndReturn(run func() *bridges.CircuitBreakers) *Runner_BridgeCircuits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with given fields:
 func (_m *Runner) Close() error {
 	ret := _m.Called()
@@ -31,6 +31,10 @@ type Spec struct {
 	JobName string `json:"-"`
 	JobType string `json:"-"`
 
+	// Fragments are the fragments that subpipeline tasks were resolved to when the spec was created. Runs look them up
+	// again, and only fall back to these if the lookup fails.
+	Fragments Fragments `json:"-"`
+
 	Pipeline *Pipeline `json:"-" db:"-"` // This may be nil, or may be populated manually as a cache. There is no locking on this, so be careful
 }
 
@@ -221,6 +225,34 @@ func (re RunErrors) Value() (driver.Value, error) {
 	return json.Marshal(re)
 }
 
+// TaskRunAttempt describes a single failed attempt of a retried task run.
+type TaskRunAttempt struct {
+	Error      string    `json:"error"`
+	CreatedAt  time.Time `json:"createdAt"`
+	FinishedAt null.Time `json:"finishedAt"`
+}
+
+// TaskRunAttempts is the list of failed attempts of a task run, stored as JSON.
+type TaskRunAttempts []TaskRunAttempt
+
+func (a *TaskRunAttempts) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("TaskRunAttempts#Scan received a value of type %T", value)
+	}
+	return json.Unmarshal(bytes, a)
+}
+
+func (a TaskRunAttempts) Value() (driver.Value, error) {
+	if len(a) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(a)
+}
+
 func (re RunErrors) HasError() bool {
 	for _, e := range re {
 		if !e.IsZero() {
@@ -275,6 +307,8 @@ type TaskRun struct {
 	FinishedAt    null.Time                         `json:"finishedAt"`
 	Index         int32                             `json:"index"`
 	DotID         string                            `json:"dotId"`
+	// PreviousAttempts holds the failed attempts that preceded this task run when the task was retried
+	PreviousAttempts TaskRunAttempts `json:"previousAttempts"`
 
 	// Used internally for sorting completed results
 	task Task
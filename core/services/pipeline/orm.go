@@ -62,10 +62,11 @@ const KeepersObservationSource = `
     perform_upkeep_tx        	[type=ethtx
                                  minConfirmations=0
                                  to="$(jobSpec.contractAddress)"
-                                 from="[$(jobSpec.fromAddress)]"
+                                 from="$(jobSpec.fromAddresses)"
                                  evmChainID="$(jobSpec.evmChainID)"
                                  data="$(encode_perform_upkeep_tx)"
                                  gasLimit="$(jobSpec.performUpkeepGasLimit)"
+                                 privateSubmission="$(jobSpec.privateSubmission)"
                                  txMeta="{\"jobID\":$(jobSpec.jobID),\"upkeepID\":$(jobSpec.prettyID)}"]
     encode_check_upkeep_tx -> check_upkeep_tx -> decode_check_upkeep_tx -> calculate_perform_data_len -> perform_data_lessthan_limit -> check_perform_data_limit -> encode_perform_upkeep_tx -> simulate_perform_upkeep_tx -> decode_check_perform_tx -> check_success -> perform_upkeep_tx
 `
@@ -95,6 +96,17 @@ type ORM interface {
 	GetAllRuns(ctx context.Context) ([]Run, error)
 	GetUnfinishedRuns(context.Context, time.Time, func(run Run) error) error
 
+	// CreateFragment stores source as the next version of the named fragment.
+	CreateFragment(ctx context.Context, name string, source string) (Fragment, error)
+	// FindFragment returns the given version of a fragment, or the latest one if version is 0.
+	FindFragment(ctx context.Context, name string, version int32) (Fragment, error)
+	// FindFragments returns the latest version of every fragment.
+	FindFragments(ctx context.Context) ([]Fragment, error)
+	// FindFragmentsReferencing returns the names of the other fragments that include the named fragment in any version.
+	FindFragmentsReferencing(ctx context.Context, name string) ([]string, error)
+	// DeleteFragment deletes all versions of a fragment.
+	DeleteFragment(ctx context.Context, name string) error
+
 	DataSource() sqlutil.DataSource
 	WithDataSource(sqlutil.DataSource) ORM
 	Transact(context.Context, func(ORM) error) error
@@ -185,10 +197,19 @@ func (o *orm) transact(ctx context.Context, fn func(*orm) error) error {
 }
 
 func (o *orm) CreateSpec(ctx context.Context, pipeline Pipeline, maxTaskDuration models.Interval) (id int32, err error) {
-	sql := `INSERT INTO pipeline_specs (dot_dag_source, max_task_duration, created_at)
-	VALUES ($1, $2, NOW())
+	var fragments Fragments
+	if pipeline.HasSubpipelines() {
+		// fragments are resolved to check that they can be expanded, and recorded to find the jobs that include them.
+		// Runs look them up again, so that they pick up later versions.
+		fragments, err = ResolveFragments(ctx, pipeline.Source, o)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to resolve subpipelines")
+		}
+	}
+	sql := `INSERT INTO pipeline_specs (dot_dag_source, max_task_duration, fragments, created_at)
+	VALUES ($1, $2, $3, NOW())
 	RETURNING id;`
-	err = o.ds.GetContext(ctx, &id, sql, pipeline.Source, maxTaskDuration)
+	err = o.ds.GetContext(ctx, &id, sql, pipeline.Source, maxTaskDuration, fragments)
 	return id, errors.WithStack(err)
 }
 
@@ -212,8 +233,8 @@ func (o *orm) CreateRun(ctx context.Context, run *Run) (err error) {
 			run.PipelineTaskRuns[i].PipelineRunID = run.ID
 		}
 
-		sql := `INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at);`
+		sql := `INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, previous_attempts)
+		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :previous_attempts);`
 		_, err = tx.ds.NamedExecContext(ctx, sql, run.PipelineTaskRuns)
 		return err
 	})
@@ -292,10 +313,10 @@ func (o *orm) StoreRun(ctx context.Context, run *Run) (restart bool, err error)
 		}
 
 		sql := `
-		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at)
+		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at, previous_attempts)
+		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at, :previous_attempts)
 		ON CONFLICT (pipeline_run_id, dot_id) DO UPDATE SET
-		output = EXCLUDED.output, error = EXCLUDED.error, finished_at = EXCLUDED.finished_at
+		output = EXCLUDED.output, error = EXCLUDED.error, finished_at = EXCLUDED.finished_at, previous_attempts = EXCLUDED.previous_attempts
 		RETURNING *;
 		`
 
@@ -399,8 +420,8 @@ RETURNING id
 		}()
 
 		pipelineTaskRunsQuery := `
-INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at);
+INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at, previous_attempts)
+VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at, :previous_attempts);
 	`
 		var pipelineTaskRuns []TaskRun
 		for _, run := range runs {
@@ -462,10 +483,16 @@ func (o *orm) InsertFinishedRunWithSpec(ctx context.Context, run *Run, saveSucce
 	}
 
 	err = o.transact(ctx, func(tx *orm) error {
-		sqlStmt1 := `INSERT INTO pipeline_specs (dot_dag_source, max_task_duration, created_at)
-	VALUES ($1, $2, NOW())
+		fragments := run.PipelineSpec.Fragments
+		if len(fragments) == 0 {
+			if fragments, err = tx.resolveFragments(ctx, run.PipelineSpec.DotDagSource); err != nil {
+				return err
+			}
+		}
+		sqlStmt1 := `INSERT INTO pipeline_specs (dot_dag_source, max_task_duration, fragments, created_at)
+	VALUES ($1, $2, $3, NOW())
 	RETURNING id;`
-		err = tx.ds.GetContext(ctx, &run.PipelineSpecID, sqlStmt1, run.PipelineSpec.DotDagSource, run.PipelineSpec.MaxTaskDuration)
+		err = tx.ds.GetContext(ctx, &run.PipelineSpecID, sqlStmt1, run.PipelineSpec.DotDagSource, run.PipelineSpec.MaxTaskDuration, fragments)
 		if err != nil {
 			return errors.Wrap(err, "failed to insert pipeline_specs")
 		}
@@ -480,6 +507,19 @@ func (o *orm) InsertFinishedRunWithSpec(ctx context.Context, run *Run, saveSucce
 	return errors.Wrap(err, "InsertFinishedRun failed")
 }
 
+// resolveFragments resolves the fragments that the subpipeline tasks of source include, if any.
+func (o *orm) resolveFragments(ctx context.Context, source string) (Fragments, error) {
+	p, err := Parse(source)
+	if err != nil {
+		return nil, err
+	}
+	if !p.HasSubpipelines() {
+		return nil, nil
+	}
+	fragments, err := ResolveFragments(ctx, source, o)
+	return fragments, errors.Wrap(err, "failed to resolve subpipelines")
+}
+
 func (o *orm) insertFinishedRun(ctx context.Context, run *Run, saveSuccessfulTaskRuns bool) error {
 	sql := `INSERT INTO pipeline_runs (pipeline_spec_id, pruning_key, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state)
 		VALUES (:pipeline_spec_id, :pruning_key, :meta, :all_errors, :fatal_errors, :inputs, :outputs, :created_at, :finished_at, :state)
@@ -505,8 +545,8 @@ func (o *orm) insertFinishedRun(ctx context.Context, run *Run, saveSuccessfulTas
 
 	defer o.prune(ctx, o.ds, run.PruningKey)
 	sql = `
-		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at);`
+		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at, previous_attempts)
+		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at, :previous_attempts);`
 	_, err = o.ds.NamedExecContext(ctx, sql, run.PipelineTaskRuns)
 	return errors.Wrap(err, "failed to insert pipeline_task_runs")
 }
@@ -645,6 +685,7 @@ func loadAssociations(ctx context.Context, ds sqlutil.DataSource, runs []*Run) e
 			ps.dot_dag_source,
 			ps.created_at,
 			ps.max_task_duration,
+			ps.fragments,
 			coalesce(jobs.id, 0) "job_id",
 			coalesce(jobs.name, '') "job_name",
 			coalesce(jobs.type, '') "job_type"
@@ -768,3 +809,61 @@ LIMIT $3
 		o.lggr.Debugw("Pruned runs", "rowsAffected", rowsAffected, "jobID", jobID)
 	}
 }
+
+func (o *orm) CreateFragment(ctx context.Context, name string, source string) (fragment Fragment, err error) {
+	sql := `INSERT INTO pipeline_fragments (name, version, source, created_at)
+	SELECT $1, COALESCE(MAX(version), 0) + 1, $2, NOW() FROM pipeline_fragments WHERE name = $1
+	RETURNING *;`
+	err = o.ds.GetContext(ctx, &fragment, sql, name, source)
+	return fragment, errors.Wrap(err, "CreateFragment failed")
+}
+
+func (o *orm) FindFragment(ctx context.Context, name string, version int32) (fragment Fragment, err error) {
+	if version == 0 {
+		err = o.ds.GetContext(ctx, &fragment, `SELECT * FROM pipeline_fragments WHERE name = $1 ORDER BY version DESC LIMIT 1`, name)
+	} else {
+		err = o.ds.GetContext(ctx, &fragment, `SELECT * FROM pipeline_fragments WHERE name = $1 AND version = $2`, name, version)
+	}
+	return fragment, err
+}
+
+func (o *orm) FindFragments(ctx context.Context) (fragments []Fragment, err error) {
+	err = o.ds.SelectContext(ctx, &fragments, `SELECT DISTINCT ON (name) * FROM pipeline_fragments ORDER BY name, version DESC`)
+	return fragments, errors.Wrap(err, "FindFragments failed")
+}
+
+func (o *orm) FindFragmentsReferencing(ctx context.Context, name string) (names []string, err error) {
+	var fragments []Fragment
+	err = o.ds.SelectContext(ctx, &fragments, `SELECT * FROM pipeline_fragments WHERE name <> $1 AND source ILIKE '%' || $1 || '%' ORDER BY name, version`, name)
+	if err != nil {
+		return nil, errors.Wrap(err, "FindFragmentsReferencing failed")
+	}
+	for _, f := range fragments {
+		if len(names) > 0 && names[len(names)-1] == f.Name {
+			continue
+		}
+		references, err := ReferencesFragment(f.Source, name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse fragment %s (version %d)", f.Name, f.Version)
+		}
+		if references {
+			names = append(names, f.Name)
+		}
+	}
+	return names, nil
+}
+
+func (o *orm) DeleteFragment(ctx context.Context, name string) error {
+	result, err := o.ds.ExecContext(ctx, `DELETE FROM pipeline_fragments WHERE name = $1`, name)
+	if err != nil {
+		return errors.Wrap(err, "DeleteFragment failed")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "DeleteFragment failed")
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
@@ -107,6 +107,44 @@ func Test_PipelineORM_CreateSpec(t *testing.T) {
 	assert.Equal(t, maxTaskDuration, actual.MaxTaskDuration)
 }
 
+func Test_PipelineORM_CreateSpec_ResolvesFragments(t *testing.T) {
+	ctx := testutils.Context(t)
+	db, orm, _ := setupLiteORM(t)
+
+	_, err := orm.CreateFragment(ctx, "price", `v [type=memo value=1];`)
+	require.NoError(t, err)
+	_, err = orm.CreateFragment(ctx, "feed", `p [type=subpipeline name=price];`)
+	require.NoError(t, err)
+
+	p, err := pipeline.Parse(`ds [type=subpipeline name=feed];`)
+	require.NoError(t, err)
+	id, err := orm.CreateSpec(ctx, *p, models.Interval(time.Minute))
+	require.NoError(t, err)
+
+	actual := pipeline.Spec{}
+	require.NoError(t, db.Get(&actual, "SELECT * FROM pipeline_specs WHERE pipeline_specs.id = $1", id))
+	require.Len(t, actual.Fragments, 2)
+
+	// later versions are not recorded with the spec, but are picked up when looked up again
+	_, err = orm.CreateFragment(ctx, "price", `v [type=memo value=2];`)
+	require.NoError(t, err)
+	expanded, err := pipeline.ParseWithFragments(ctx, actual.DotDagSource, actual.Fragments)
+	require.NoError(t, err)
+	assert.Equal(t, "1", expanded.ByDotID("ds").(*pipeline.MemoTask).Value)
+	expanded, err = pipeline.ParseWithFragments(ctx, actual.DotDagSource, orm)
+	require.NoError(t, err)
+	assert.Equal(t, "2", expanded.ByDotID("ds").(*pipeline.MemoTask).Value)
+
+	names, err := orm.FindFragmentsReferencing(ctx, "price")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"feed"}, names)
+
+	p, err = pipeline.Parse(`ds [type=subpipeline name=nope];`)
+	require.NoError(t, err)
+	_, err = orm.CreateSpec(ctx, *p, models.Interval(time.Minute))
+	require.ErrorContains(t, err, "could not find fragment nope")
+}
+
 func Test_PipelineORM_FindRun(t *testing.T) {
 	db, orm, _ := setupLiteORM(t)
 
@@ -276,6 +314,7 @@ answer2 [type=bridge name=election_winner index=1];
 		JobID:           jb.ID,
 		JobName:         jb.Name.ValueOrZero(),
 		JobType:         string(jb.Type),
+		Fragments:       pipeline.Fragments{{ID: 1, Name: "price", Version: 1, Source: `v [type=memo value=1];`}},
 	}
 	defaultVars := map[string]interface{}{
 		"jb": map[string]interface{}{
@@ -325,6 +364,9 @@ answer2 [type=bridge name=election_winner index=1];
 
 	assert.Equal(t, run.PipelineSpecID, pipelineSpec.ID)
 	assert.False(t, jobPipelineSpec.IsPrimary)
+	require.Len(t, pipelineSpec.Fragments, 1)
+	assert.Equal(t, "price", pipelineSpec.Fragments[0].Name)
+	assert.Equal(t, int32(1), pipelineSpec.Fragments[0].Version)
 }
 
 // Tests that inserting run results, then later updating the run results via upsert will work correctly.
@@ -0,0 +1,65 @@
+package pipeline
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jpillora/backoff"
+)
+
+// RetryOn classifies which task errors are retried.
+type RetryOn string
+
+const (
+	// RetryOnAny retries every error. This is the default.
+	RetryOnAny RetryOn = "any"
+	// RetryOnRetryable only retries errors that the task marked as retryable
+	// via RunInfo.IsRetryable, e.g. timeouts and 5xx responses.
+	RetryOnRetryable RetryOn = "retryable"
+)
+
+func (r RetryOn) validate() error {
+	switch r {
+	case "", RetryOnAny, RetryOnRetryable:
+		return nil
+	default:
+		return fmt.Errorf("invalid retryOn %q: expected %q or %q", string(r), RetryOnAny, RetryOnRetryable)
+	}
+}
+
+// RetryPolicy controls how the scheduler retries a failed task run.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts uint32
+	MinBackoff  time.Duration
+	MaxBackoff  time.Duration
+	// Jitter randomizes each backoff between MinBackoff and the exponential delay.
+	Jitter bool
+	// RetryableOnly gives up immediately on errors that the task did not mark as retryable.
+	// Every attempt is bounded by the task timeout, which applies per attempt.
+	RetryableOnly bool
+}
+
+// ShouldRetry returns true if a task that finished with result after the given
+// number of attempts must be scheduled again.
+func (p RetryPolicy) ShouldRetry(attempts uint, result Result, runInfo RunInfo) bool {
+	if result.Error == nil || attempts >= uint(p.MaxAttempts) {
+		return false
+	}
+	return !p.RetryableOnly || runInfo.IsRetryable
+}
+
+// Backoff returns how long to wait before the next attempt, given the number of
+// attempts made so far.
+func (p RetryPolicy) Backoff(attempts uint) time.Duration {
+	b := backoff.Backoff{
+		Factor: 2,
+		Jitter: p.Jitter,
+		Min:    p.MinBackoff,
+		Max:    p.MaxBackoff,
+	}
+	if attempts == 0 {
+		return b.ForAttempt(0)
+	}
+	return b.ForAttempt(float64(attempts - 1)) // we subtract 1 because backoff 0-indexes
+}
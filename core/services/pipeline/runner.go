@@ -39,6 +39,7 @@ type Runner interface {
 
 	// ExecuteRun executes a new run in-memory according to a spec and returns the results.
 	// We expect spec.JobID and spec.JobName to be set for logging/prometheus.
+	// If ctx carries fixtures from ContextWithSimulation, tasks with external side effects are answered from them.
 	ExecuteRun(ctx context.Context, spec Spec, vars Vars) (run *Run, trrs TaskRunResults, err error)
 	// InsertFinishedRun saves the run results in the database.
 	// ds is an optional override, for example when executing a transaction.
@@ -53,6 +54,9 @@ type Runner interface {
 
 	OnRunFinished(func(*Run))
 	InitializePipeline(spec Spec) (*Pipeline, error)
+
+	// BridgeCircuits returns the circuit breakers of the bridges called by bridge tasks.
+	BridgeCircuits() *bridges.CircuitBreakers
 }
 
 type runner struct {
@@ -68,6 +72,8 @@ type runner struct {
 	lggr                   logger.Logger
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	bridgeClients          *bridgeClients
+	multicall3Deployments  *multicall3Deployments
 
 	// test helper
 	runFinished func(*Run)
@@ -104,6 +110,12 @@ var (
 	},
 		[]string{"job_id", "job_name", "task_id", "task_type", "bridge_name", "status"},
 	)
+	PromPipelineTaskRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_retries",
+		Help: "The total number of retries scheduled for pipeline tasks according to their retry policy",
+	},
+		[]string{"job_id", "job_name", "task_id", "task_type"},
+	)
 )
 
 func NewRunner(
@@ -133,6 +145,8 @@ func NewRunner(
 		lggr:                   lggr,
 		httpClient:             httpClient,
 		unrestrictedHTTPClient: unrestrictedHTTPClient,
+		bridgeClients:          newBridgeClients(),
+		multicall3Deployments:  newMulticall3Deployments(),
 	}
 
 	r.runReaperWorker = commonutils.NewSleeperTask(
@@ -193,6 +207,13 @@ func (r *runner) HealthReport() map[string]error {
 	return runnerHealth
 }
 
+func (r *runner) BridgeCircuits() *bridges.CircuitBreakers {
+	if cache, isCache := r.btORM.(*bridges.Cache); isCache {
+		return cache.Circuits()
+	}
+	return nil
+}
+
 func (r *runner) destroy() {
 	err := r.runReaperWorker.Stop()
 	if err != nil {
@@ -266,6 +287,9 @@ var (
 	}
 )
 
+// fragmentsLookupTimeout bounds the lookup of the fragments of a pipeline with subpipelines before every run.
+const fragmentsLookupTimeout = 5 * time.Second
+
 func init() {
 	// undocumented escape hatch
 	if v := env.PipelineOvertime.Get(); v != "" {
@@ -301,7 +325,7 @@ func (r *runner) ExecuteRun(ctx context.Context, spec Spec, vars Vars) (*Run, Ta
 	defer cancel()
 
 	var pipeline *Pipeline
-	if spec.Pipeline != nil {
+	if spec.Pipeline != nil && !spec.Pipeline.HasSubpipelines() {
 		// assume if set that it has been pre-initialized
 		pipeline = spec.Pipeline
 	} else {
@@ -322,12 +346,36 @@ func (r *runner) ExecuteRun(ctx context.Context, spec Spec, vars Vars) (*Run, Ta
 	return run, taskRunResults, nil
 }
 
+// expandSubpipelines parses source with its subpipelines expanded, falling back to the fragments resolved when the spec
+// was created if they can't be looked up.
+func (r *runner) expandSubpipelines(spec Spec, source string) (*Pipeline, error) {
+	ctx, cancel := r.chStop.CtxWithTimeout(fragmentsLookupTimeout)
+	defer cancel()
+	expanded, err := ParseWithFragments(ctx, source, r.orm)
+	if err != nil && len(spec.Fragments) > 0 {
+		r.lggr.Warnw("Failed to look up subpipelines, falling back to the fragments resolved when the spec was created", "err", err, "specID", spec.ID)
+		expanded, err = ParseWithFragments(ctx, source, spec.Fragments)
+	}
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to expand subpipelines")
+	}
+	return expanded, nil
+}
+
 func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 	pipeline, err = spec.GetOrParsePipeline()
 	if err != nil {
 		return
 	}
 
+	if pipeline.HasSubpipelines() {
+		// expand into a new pipeline, leaving the cached spec.Pipeline unexpanded, so that the fragments are looked up
+		// for every run and subpipelines without a pinned version pick up the latest version of their fragment.
+		if pipeline, err = r.expandSubpipelines(spec, pipeline.Source); err != nil {
+			return nil, err
+		}
+	}
+
 	// initialize certain task params
 	for _, task := range pipeline.Tasks {
 		task.Base().uuid = uuid.New()
@@ -347,6 +395,7 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			// must use the unrestrictedHTTPClient because some node operators
 			// may run external adapters on their own hardware
 			task.(*BridgeTask).httpClient = r.unrestrictedHTTPClient
+			task.(*BridgeTask).bridgeClients = r.bridgeClients
 		case TaskTypeETHCall:
 			task.(*ETHCallTask).legacyChains = r.legacyEVMChains
 			task.(*ETHCallTask).config = r.config
@@ -368,6 +417,7 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*ETHTxTask).specGasLimit = spec.GasLimit
 			task.(*ETHTxTask).jobType = spec.JobType
 			task.(*ETHTxTask).forwardingAllowed = spec.ForwardingAllowed
+			task.(*ETHTxTask).multicall3 = r.multicall3Deployments
 		default:
 		}
 	}
@@ -442,7 +492,9 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 			DotID:         result.Task.DotID(),
 			CreatedAt:     result.CreatedAt,
 			FinishedAt:    result.FinishedAt,
-			task:          result.Task,
+			// failed attempts of retried tasks are persisted so they survive resumption
+			PreviousAttempts: result.PreviousAttempts,
+			task:             result.Task,
 		})
 
 		sort.Slice(run.PipelineTaskRuns, func(i, j int) bool {
@@ -541,7 +593,7 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 
 	// Task timeout will be whichever of the following timesout/cancels first:
 	// - Pipeline-level timeout
-	// - Specific task timeout (task.TaskTimeout)
+	// - Specific task timeout (task.TaskTimeout), applied to every retry attempt
 	// - Job level task timeout (spec.MaxTaskDuration)
 	// - Passed in context
 
@@ -560,7 +612,13 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		defer cancel()
 	}
 
-	result, runInfo := taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	var result Result
+	var runInfo RunInfo
+	if fixtures, simulated := simulationFromContext(ctx); simulated && IsSimulatedTaskType(taskRun.task.Type()) {
+		result = fixtures.Run(taskRun.task, taskRun.vars)
+	} else {
+		result, runInfo = taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	}
 	loggerFields := []interface{}{"runInfo", runInfo,
 		"resultValue", result.Value,
 		"resultError", result.Error,
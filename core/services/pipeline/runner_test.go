@@ -1011,6 +1011,37 @@ en->de
 	assert.Equal(t, inputBytes, result.Value)
 }
 
+func Test_PipelineRunner_ExecuteRun_Subpipelines(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	orm := mocks.NewORM(t)
+	r := pipeline.NewRunner(orm, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), nil, nil)
+
+	spec := pipeline.Spec{DotDagSource: `
+fragment [type=subpipeline name="answer"];
+`}
+	orm.On("FindFragment", mock.Anything, "answer", int32(0)).Return(pipeline.Fragment{
+		Name: "answer", Version: 1, Source: `answer [type=memo value=0];`,
+	}, nil).Once()
+	var err error
+	spec.Pipeline, err = spec.ParsePipeline()
+	require.NoError(t, err)
+	_, err = r.InitializePipeline(spec)
+	require.NoError(t, err)
+	require.True(t, spec.Pipeline.HasSubpipelines(), "the cached pipeline is not expanded")
+
+	vars := pipeline.NewVarsFrom(nil)
+	for _, answer := range []string{"1", "2"} {
+		orm.On("FindFragment", mock.Anything, "answer", int32(0)).Return(pipeline.Fragment{
+			Name: "answer", Version: 1, Source: fmt.Sprintf(`answer [type=memo value=%s];`, answer),
+		}, nil).Once()
+
+		_, trrs, err := r.ExecuteRun(testutils.Context(t), spec, vars)
+		require.NoError(t, err)
+		require.Len(t, trrs, 1)
+		assert.Equal(t, answer, trrs[0].Result.Value.(pipeline.ObjectParam).DecimalValue.Decimal().String(), "the fragment is looked up on every run")
+	}
+}
+
 func Test_PipelineRunner_ExecuteRun(t *testing.T) {
 	t.Run("uses cached *Pipeline if available", func(t *testing.T) {
 		db := pgtest.NewSqlxDB(t)
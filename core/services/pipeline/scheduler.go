@@ -2,10 +2,10 @@ package pipeline
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"time"
 
-	"github.com/jpillora/backoff"
 	"github.com/pkg/errors"
 	"gopkg.in/guregu/null.v4"
 
@@ -135,10 +135,11 @@ func (s *scheduler) reconstructResults() {
 		}
 
 		s.results[task.ID()] = TaskRunResult{
-			Task:       task,
-			Result:     result,
-			CreatedAt:  r.CreatedAt,
-			FinishedAt: r.FinishedAt,
+			Task:             task,
+			Result:           result,
+			CreatedAt:        r.CreatedAt,
+			FinishedAt:       r.FinishedAt,
+			PreviousAttempts: r.PreviousAttempts,
 		}
 
 		// store the result in vars
@@ -175,7 +176,15 @@ func (s *scheduler) Run() {
 		s.waiting--
 
 		// retrieve previous attempt count
-		result.Attempts = s.results[result.Task.ID()].Attempts
+		previous, retried := s.results[result.Task.ID()]
+		result.Attempts = previous.Attempts
+		if retried && previous.Result.Error != nil {
+			result.PreviousAttempts = append(previous.PreviousAttempts, TaskRunAttempt{
+				Error:      previous.Result.Error.Error(),
+				CreatedAt:  previous.CreatedAt,
+				FinishedAt: previous.FinishedAt,
+			})
+		}
 
 		// only count as an attempt if the job actually ran. If we're exiting then it got cancelled
 		if !s.exiting {
@@ -219,17 +228,14 @@ func (s *scheduler) Run() {
 			continue
 		}
 
-		// if task hasn't reached it's max retry count yet, we schedule it again
-		if result.Attempts < uint(result.Task.TaskRetries()) && result.Result.Error != nil {
+		// if task hasn't reached it's max retry count yet and the error is retryable, we schedule it again
+		if policy := result.Task.TaskRetryPolicy(); policy.ShouldRetry(result.Attempts, result.Result, result.runInfo) {
 			// we immediately increase the in-flight counter so the pipeline doesn't terminate
 			// while we wait for the next retry
 			s.waiting++
 
-			backoff := backoff.Backoff{
-				Factor: 2,
-				Min:    result.Task.TaskMinBackoff(),
-				Max:    result.Task.TaskMaxBackoff(),
-			}
+			spec := s.run.PipelineSpec
+			PromPipelineTaskRetries.WithLabelValues(fmt.Sprintf("%d", spec.JobID), spec.JobName, result.Task.DotID(), string(result.Task.Type())).Inc()
 
 			go func(vars Vars) {
 				select {
@@ -242,7 +248,7 @@ func (s *scheduler) Run() {
 						CreatedAt:  now, // TODO: more accurate start time
 						FinishedAt: null.TimeFrom(now),
 					})
-				case <-time.After(backoff.ForAttempt(float64(result.Attempts - 1))): // we subtract 1 because backoff 0-indexes
+				case <-time.After(policy.Backoff(result.Attempts)):
 					// schedule a new attempt
 					run := s.newMemoryTaskRun(result.Task, vars)
 					run.attempts = result.Attempts
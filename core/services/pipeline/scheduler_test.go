@@ -8,6 +8,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 )
@@ -15,6 +17,7 @@ import (
 type event struct {
 	expected string
 	result   Result
+	runInfo  RunInfo
 }
 
 func TestScheduler(t *testing.T) {
@@ -74,6 +77,10 @@ func TestScheduler(t *testing.T) {
 				// a is marked as errored with the last error in sequence
 				require.Equal(t, uint(3), result.Attempts)
 				require.Equal(t, ErrTimeout, result.Result.Error)
+				// every failed attempt is recorded
+				require.Len(t, result.PreviousAttempts, 2)
+				require.Equal(t, ErrTaskRunFailed.Error(), result.PreviousAttempts[0].Error)
+				require.Equal(t, ErrTaskRunFailed.Error(), result.PreviousAttempts[1].Error)
 			},
 		},
 		{
@@ -104,6 +111,34 @@ func TestScheduler(t *testing.T) {
 				require.Equal(t, uint(2), result.Attempts)
 			},
 		},
+		{
+			name: "retry retryable errors only: give up on non-retryable error",
+			spec: `
+			a [type=median retries=3 retryOn=retryable minBackoff="1us" maxBackoff="1us"]
+			b [type=median index=0]
+			a -> b`,
+			events: []event{
+				{
+					expected: "a",
+					result:   Result{Error: ErrTimeout},
+					runInfo:  RunInfo{IsRetryable: true},
+				},
+				{
+					expected: "a",
+					result:   Result{Error: ErrBadInput},
+				},
+				{
+					expected: "b",
+					result:   Result{Value: 1},
+				},
+			},
+			assertion: func(t *testing.T, p Pipeline, results map[int]TaskRunResult) {
+				result := results[p.ByDotID("a").ID()]
+				require.Equal(t, uint(2), result.Attempts)
+				require.Equal(t, ErrBadInput, result.Result.Error)
+				require.Len(t, result.PreviousAttempts, 1)
+			},
+		},
 		{
 			name: "retry task + failEarly: cancel pending retries",
 			spec: `
@@ -150,6 +185,7 @@ func TestScheduler(t *testing.T) {
 					Result:     event.result,
 					FinishedAt: null.TimeFrom(now),
 					CreatedAt:  now,
+					runInfo:    event.runInfo,
 				})
 			case <-time.After(time.Second):
 				t.Fatal("timed out waiting for task run")
@@ -167,3 +203,103 @@ func TestScheduler(t *testing.T) {
 		test.assertion(t, *p, s.results)
 	}
 }
+
+func TestRetryPolicy(t *testing.T) {
+	t.Run("parses policy from task attributes", func(t *testing.T) {
+		p, err := Parse(`a [type=http url="https://example.com" retries=4 minBackoff="1s" maxBackoff="10s" retryJitter=true retryOn=retryable]`)
+		require.NoError(t, err)
+
+		policy := p.ByDotID("a").TaskRetryPolicy()
+		require.Equal(t, RetryPolicy{
+			MaxAttempts:   4,
+			MinBackoff:    time.Second,
+			MaxBackoff:    10 * time.Second,
+			Jitter:        true,
+			RetryableOnly: true,
+		}, policy)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		p, err := Parse(`a [type=http url="https://example.com" maxBackoff="30s"]`)
+		require.NoError(t, err)
+
+		policy := p.ByDotID("a").TaskRetryPolicy()
+		require.Equal(t, uint32(0), policy.MaxAttempts)
+		require.Equal(t, 5*time.Second, policy.MinBackoff)
+		require.Equal(t, time.Minute, policy.MaxBackoff)
+		require.False(t, policy.RetryableOnly)
+	})
+
+	t.Run("keeps the backoff of specs that only set minBackoff", func(t *testing.T) {
+		p, err := Parse(`a [type=http url="https://example.com" minBackoff="1s"]`)
+		require.NoError(t, err)
+
+		policy := p.ByDotID("a").TaskRetryPolicy()
+		require.Equal(t, time.Second, policy.MinBackoff)
+		require.Equal(t, time.Duration(0), policy.MaxBackoff)
+	})
+
+	t.Run("honours maxBackoff without minBackoff with the retry policy", func(t *testing.T) {
+		p, err := Parse(`a [type=http url="https://example.com" maxBackoff="30s" retryOn=any]`)
+		require.NoError(t, err)
+
+		policy := p.ByDotID("a").TaskRetryPolicy()
+		require.Equal(t, 5*time.Second, policy.MinBackoff)
+		require.Equal(t, 30*time.Second, policy.MaxBackoff)
+
+		p, err = Parse(`a [type=http url="https://example.com" minBackoff="1s" retryJitter=true]`)
+		require.NoError(t, err)
+		require.Equal(t, time.Minute, p.ByDotID("a").TaskRetryPolicy().MaxBackoff)
+	})
+
+	t.Run("honours maxBackoff without minBackoff with retries", func(t *testing.T) {
+		p, err := Parse(`a [type=http url="https://example.com" retries=3 maxBackoff="30s"]`)
+		require.NoError(t, err)
+
+		policy := p.ByDotID("a").TaskRetryPolicy()
+		require.Equal(t, uint32(3), policy.MaxAttempts)
+		require.Equal(t, 5*time.Second, policy.MinBackoff)
+		require.Equal(t, 30*time.Second, policy.MaxBackoff)
+		require.Equal(t, 5*time.Second, policy.Backoff(1))
+		require.Equal(t, 20*time.Second, policy.Backoff(3))
+		require.Equal(t, 30*time.Second, policy.Backoff(4))
+	})
+
+	t.Run("rejects unknown retryOn", func(t *testing.T) {
+		_, err := Parse(`a [type=http url="https://example.com" retryOn=sometimes]`)
+		require.ErrorContains(t, err, "invalid retryOn")
+	})
+
+	t.Run("backoff grows exponentially up to the max", func(t *testing.T) {
+		policy := RetryPolicy{MinBackoff: time.Second, MaxBackoff: 5 * time.Second}
+		require.Equal(t, time.Second, policy.Backoff(1))
+		require.Equal(t, 2*time.Second, policy.Backoff(2))
+		require.Equal(t, 4*time.Second, policy.Backoff(3))
+		require.Equal(t, 5*time.Second, policy.Backoff(4))
+	})
+}
+
+func TestScheduler_ReconstructsPreviousAttempts(t *testing.T) {
+	p, err := Parse(`
+	a [type=median retries=3 minBackoff="1us" maxBackoff="1us"]
+	b [type=median index=0]
+	a -> b`)
+	require.NoError(t, err)
+	vars := NewVarsFrom(nil)
+	run := NewRun(Spec{}, vars)
+	now := time.Now()
+	attempts := TaskRunAttempts{{Error: ErrTaskRunFailed.Error(), CreatedAt: now, FinishedAt: null.TimeFrom(now)}}
+	run.PipelineTaskRuns = []TaskRun{{
+		ID:               uuid.New(),
+		DotID:            "a",
+		Output:           jsonserializable.JSONSerializable{Val: 1, Valid: true},
+		CreatedAt:        now,
+		FinishedAt:       null.TimeFrom(now),
+		PreviousAttempts: attempts,
+	}}
+
+	s := newScheduler(p, run, vars, logger.TestLogger(t))
+
+	result := s.results[p.ByDotID("a").ID()]
+	require.Equal(t, attempts, result.PreviousAttempts)
+}
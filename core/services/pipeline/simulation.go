@@ -0,0 +1,126 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+)
+
+// ErrSimulationFixtureMissing is returned by simulated tasks that have no
+// matching entry in the fixtures of a simulated run.
+var ErrSimulationFixtureMissing = errors.New("no simulation fixture for task")
+
+// SimulationFixture is a canned response for a single task in a simulated run.
+// If Error is set, the task fails with that error and Value is ignored.
+type SimulationFixture struct {
+	Value interface{} `json:"value"`
+	Error string      `json:"error,omitempty"`
+}
+
+// SimulationFixtures holds the responses used in place of external calls
+// while simulating a pipeline run.
+//
+// Tasks are matched by DOT ID first. HTTP tasks then fall back to their
+// resolved URL and bridge tasks to their bridge name.
+type SimulationFixtures struct {
+	Tasks   map[string]SimulationFixture `json:"tasks,omitempty"`
+	URLs    map[string]SimulationFixture `json:"urls,omitempty"`
+	Bridges map[string]SimulationFixture `json:"bridges,omitempty"`
+}
+
+// ParseSimulationFixtures decodes a JSON fixture file.
+func ParseSimulationFixtures(b []byte) (fixtures SimulationFixtures, err error) {
+	err = json.Unmarshal(b, &fixtures)
+	return fixtures, errors.Wrap(err, "failed to parse simulation fixtures")
+}
+
+type simulationCtxKey struct{}
+
+// ContextWithSimulation returns a context that makes Runner.ExecuteRun
+// answer http, bridge, ethcall, ethtx and estimategaslimit tasks from the
+// given fixtures instead of calling out to adapters or chains.
+func ContextWithSimulation(ctx context.Context, fixtures SimulationFixtures) context.Context {
+	return context.WithValue(ctx, simulationCtxKey{}, &fixtures)
+}
+
+func simulationFromContext(ctx context.Context) (*SimulationFixtures, bool) {
+	fixtures, ok := ctx.Value(simulationCtxKey{}).(*SimulationFixtures)
+	return fixtures, ok
+}
+
+// IsSimulatedTaskType returns true for task types that have external side
+// effects and are therefore answered from fixtures in a simulated run.
+func IsSimulatedTaskType(taskType TaskType) bool {
+	switch taskType {
+	case TaskTypeHTTP, TaskTypeBridge, TaskTypeETHCall, TaskTypeETHTx, TaskTypeEstimateGasLimit:
+		return true
+	default:
+		return false
+	}
+}
+
+func (f *SimulationFixtures) lookup(task Task, vars Vars) (SimulationFixture, bool) {
+	if fixture, ok := f.Tasks[task.DotID()]; ok {
+		return fixture, true
+	}
+	switch t := task.(type) {
+	case *HTTPTask:
+		var u URLParam
+		if err := ResolveParam(&u, From(VarExpr(t.URL, vars), NonemptyString(t.URL))); err != nil {
+			return SimulationFixture{}, false
+		}
+		resolved := url.URL(u)
+		fixture, ok := f.URLs[resolved.String()]
+		return fixture, ok
+	case *BridgeTask:
+		fixture, ok := f.Bridges[t.Name]
+		return fixture, ok
+	}
+	return SimulationFixture{}, false
+}
+
+// Run returns the fixture result for the task, converted to the type the
+// real task would have produced.
+func (f *SimulationFixtures) Run(task Task, vars Vars) Result {
+	fixture, ok := f.lookup(task, vars)
+	if !ok {
+		return Result{Error: errors.Wrapf(ErrSimulationFixtureMissing, "%s (%s)", task.DotID(), task.Type())}
+	}
+	if fixture.Error != "" {
+		return Result{Error: errors.New(fixture.Error)}
+	}
+
+	switch task.Type() {
+	case TaskTypeHTTP, TaskTypeBridge:
+		// adapters always return the raw response body
+		if s, isString := fixture.Value.(string); isString {
+			return Result{Value: s}
+		}
+		b, err := json.Marshal(fixture.Value)
+		if err != nil {
+			return Result{Error: errors.Wrap(err, "failed to marshal simulation fixture")}
+		}
+		return Result{Value: string(b)}
+	case TaskTypeETHCall:
+		s, isString := fixture.Value.(string)
+		if !isString {
+			return Result{Error: errors.Errorf("ethcall simulation fixture must be a hex string, got %T", fixture.Value)}
+		}
+		b, err := hexutil.Decode(s)
+		if err != nil {
+			return Result{Error: errors.Wrap(err, "failed to decode ethcall simulation fixture")}
+		}
+		return Result{Value: b}
+	case TaskTypeEstimateGasLimit:
+		var limit Uint64Param
+		if err := limit.UnmarshalPipelineParam(fixture.Value); err != nil {
+			return Result{Error: errors.Wrap(err, "failed to decode estimategaslimit simulation fixture")}
+		}
+		return Result{Value: uint64(limit)}
+	default:
+		return Result{Value: fixture.Value}
+	}
+}
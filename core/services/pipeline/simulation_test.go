@@ -0,0 +1,82 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func Test_PipelineRunner_ExecuteRun_Simulation(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, logger.TestLogger(t), nil, nil)
+
+	spec := pipeline.Spec{DotDagSource: `
+ds1          [type=http url="https://example.com/price"];
+ds1_parse    [type=jsonparse path="data,price"];
+ds2          [type=bridge name="my-bridge"];
+ds2_parse    [type=jsonparse path="price"];
+gas          [type=estimategaslimit to="0x0000000000000000000000000000000000000001" data="0x"];
+answer       [type=median];
+
+ds1 -> ds1_parse -> answer;
+ds2 -> ds2_parse -> answer;
+`}
+
+	t.Run("answers external tasks from fixtures", func(t *testing.T) {
+		fixtures, err := pipeline.ParseSimulationFixtures([]byte(`{
+			"urls": {"https://example.com/price": {"value": {"data": {"price": 100}}}},
+			"bridges": {"my-bridge": {"value": "{\"price\": 200}"}},
+			"tasks": {"gas": {"value": "21000"}}
+		}`))
+		require.NoError(t, err)
+
+		ctx := pipeline.ContextWithSimulation(testutils.Context(t), fixtures)
+		run, trrs, err := r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+		require.False(t, run.HasErrors())
+		require.Len(t, trrs, 6)
+
+		for _, trr := range trrs {
+			switch trr.Task.DotID() {
+			case "ds1":
+				assert.Equal(t, `{"data":{"price":100}}`, trr.Result.Value)
+			case "ds2":
+				assert.Equal(t, `{"price": 200}`, trr.Result.Value)
+			case "gas":
+				assert.Equal(t, uint64(21000), trr.Result.Value)
+			case "answer":
+				assert.Equal(t, "150", trr.Result.Value.(decimal.Decimal).String())
+			}
+		}
+	})
+
+	t.Run("fails tasks without a fixture", func(t *testing.T) {
+		fixtures := pipeline.SimulationFixtures{
+			Tasks: map[string]pipeline.SimulationFixture{
+				"ds1": {Error: "adapter unavailable"},
+				"gas": {Value: 21000},
+			},
+		}
+
+		ctx := pipeline.ContextWithSimulation(testutils.Context(t), fixtures)
+		run, trrs, err := r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+		require.True(t, run.HasErrors())
+
+		for _, trr := range trrs {
+			switch trr.Task.DotID() {
+			case "ds1":
+				assert.EqualError(t, trr.Result.Error, "adapter unavailable")
+			case "ds2":
+				assert.ErrorIs(t, trr.Result.Error, pipeline.ErrSimulationFixtureMissing)
+			}
+		}
+	})
+}
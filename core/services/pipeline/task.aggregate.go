@@ -0,0 +1,272 @@
+package pipeline
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// AggregateMethod names the estimator used by an aggregate task.
+type AggregateMethod string
+
+const (
+	AggregateMethodMedian         AggregateMethod = "median"
+	AggregateMethodMean           AggregateMethod = "mean"
+	AggregateMethodTrimmedMean    AggregateMethod = "trimmedMean"
+	AggregateMethodWeightedMedian AggregateMethod = "weightedMedian"
+)
+
+// Reasons reported for dropped inputs in the aggregate task output.
+const (
+	AggregateDroppedErrored = "errored"
+	AggregateDroppedOutlier = "outlier"
+	AggregateDroppedTrimmed = "trimmed"
+)
+
+// AggregateTask robustly combines many numeric sources into one value.
+//
+// Errored inputs are tolerated up to AllowedFaults, exactly like the median
+// task. When OutlierMADs is set, values further than OutlierMADs median
+// absolute deviations from the median are rejected before aggregating. The
+// remaining values must satisfy MinQuorum.
+//
+// Return types:
+//
+//	map[string]interface{}{
+//		"result":  decimal.Decimal,
+//		"used":    int,
+//		"dropped": []interface{}{map[string]interface{}{"index": int, "reason": string}},
+//	}
+type AggregateTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	Weights       string `json:"weights"`
+	Method        string `json:"method"`
+	Trim          string `json:"trim"`
+	OutlierMADs   string `json:"outlierMADs"`
+	MinQuorum     string `json:"minQuorum"`
+	AllowedFaults string `json:"allowedFaults"`
+}
+
+var _ Task = (*AggregateTask)(nil)
+
+func (t *AggregateTask) Type() TaskType {
+	return TaskTypeAggregate
+}
+
+func (t *AggregateTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		maybeAllowedFaults MaybeUint64Param
+		valuesAndErrs      SliceParam
+		weightsParam       DecimalSliceParam
+		method             StringParam
+		trim               DecimalParam
+		outlierMADs        DecimalParam
+		minQuorum          Uint64Param
+		allowedFaults      int
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
+		errors.Wrap(ResolveParam(&weightsParam, From(VarExpr(t.Weights, vars), JSONWithVarExprs(t.Weights, vars, false), nil)), "weights"),
+		errors.Wrap(ResolveParam(&method, From(NonemptyString(t.Method), string(AggregateMethodMedian))), "method"),
+		errors.Wrap(ResolveParam(&trim, From(VarExpr(t.Trim, vars), NonemptyString(t.Trim), 0)), "trim"),
+		errors.Wrap(ResolveParam(&outlierMADs, From(VarExpr(t.OutlierMADs, vars), NonemptyString(t.OutlierMADs), 0)), "outlierMADs"),
+		errors.Wrap(ResolveParam(&minQuorum, From(VarExpr(t.MinQuorum, vars), NonemptyString(t.MinQuorum), 1)), "minQuorum"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if allowed, isSet := maybeAllowedFaults.Uint64(); isSet {
+		allowedFaults = int(allowed)
+	} else {
+		allowedFaults = len(valuesAndErrs) - 1
+	}
+
+	weights := []decimal.Decimal(weightsParam)
+	if len(weights) > 0 && len(weights) != len(valuesAndErrs) {
+		return Result{Error: errors.Wrapf(ErrBadInput, "got %d weights for %d values", len(weights), len(valuesAndErrs))}, runInfo
+	}
+	for i, w := range weights {
+		if w.IsNegative() {
+			return Result{Error: errors.Wrapf(ErrBadInput, "weight %d is negative", i)}, runInfo
+		}
+	}
+	if trim.Decimal().IsNegative() || trim.Decimal().GreaterThanOrEqual(decimal.NewFromFloat(0.5)) {
+		return Result{Error: errors.Wrapf(ErrBadInput, "trim must be in [0, 0.5), got %s", trim.Decimal())}, runInfo
+	}
+
+	var (
+		samples []aggregateSample
+		dropped []aggregateDropped
+		faults  int
+	)
+	for i, v := range valuesAndErrs {
+		if _, is := v.(error); is {
+			faults++
+			dropped = append(dropped, aggregateDropped{i, AggregateDroppedErrored})
+			continue
+		}
+		var d DecimalParam
+		if err = d.UnmarshalPipelineParam(v); err != nil {
+			return Result{Error: errors.Wrapf(err, "value %d", i)}, runInfo
+		}
+		s := aggregateSample{index: i, value: d.Decimal(), weight: decimal.NewFromInt(1)}
+		if len(weights) > 0 {
+			s.weight = weights[i]
+		}
+		samples = append(samples, s)
+	}
+	if faults > allowedFaults {
+		return Result{Error: errors.Wrapf(ErrTooManyErrors, "Number of faulty inputs %v to aggregate task > number allowed faults %v", faults, allowedFaults)}, runInfo
+	} else if len(samples) == 0 {
+		return Result{Error: errors.Wrap(ErrWrongInputCardinality, "no values to aggregate")}, runInfo
+	}
+
+	sort.SliceStable(samples, func(i, j int) bool {
+		return samples[i].value.LessThan(samples[j].value)
+	})
+
+	if outlierMADs.Decimal().IsPositive() {
+		var outliers []aggregateSample
+		samples, outliers = rejectOutliers(samples, outlierMADs.Decimal())
+		for _, s := range outliers {
+			dropped = append(dropped, aggregateDropped{s.index, AggregateDroppedOutlier})
+		}
+	}
+
+	if len(samples) < int(minQuorum) {
+		return Result{Error: errors.Wrapf(ErrQuorumNotMet, "%d values left after dropping %d inputs, need at least %d", len(samples), len(dropped), minQuorum)}, runInfo
+	}
+
+	var value decimal.Decimal
+	switch m := AggregateMethod(method); m {
+	case AggregateMethodMedian:
+		value = medianOfSorted(samples)
+	case AggregateMethodMean:
+		value = meanOf(samples)
+	case AggregateMethodTrimmedMean:
+		k := int(trim.Decimal().Mul(decimal.NewFromInt(int64(len(samples)))).IntPart())
+		for _, s := range samples[:k] {
+			dropped = append(dropped, aggregateDropped{s.index, AggregateDroppedTrimmed})
+		}
+		for _, s := range samples[len(samples)-k:] {
+			dropped = append(dropped, aggregateDropped{s.index, AggregateDroppedTrimmed})
+		}
+		samples = samples[k : len(samples)-k]
+		value = meanOf(samples)
+	case AggregateMethodWeightedMedian:
+		value, err = weightedMedianOfSorted(samples)
+		if err != nil {
+			return Result{Error: err}, runInfo
+		}
+	default:
+		return Result{Error: errors.Wrapf(ErrBadInput, "unknown method %q", m)}, runInfo
+	}
+
+	sort.Slice(dropped, func(i, j int) bool {
+		return dropped[i].index < dropped[j].index
+	})
+	droppedOutput := make([]interface{}, len(dropped))
+	for i, d := range dropped {
+		droppedOutput[i] = map[string]interface{}{"index": d.index, "reason": d.reason}
+	}
+
+	return Result{Value: map[string]interface{}{
+		"result":  value,
+		"used":    len(samples),
+		"dropped": droppedOutput,
+	}}, runInfo
+}
+
+type aggregateSample struct {
+	index  int
+	value  decimal.Decimal
+	weight decimal.Decimal
+}
+
+type aggregateDropped struct {
+	index  int
+	reason string
+}
+
+// rejectOutliers splits sorted samples into those within k median absolute
+// deviations of the median and those outside. Nothing is rejected when the
+// MAD is zero, as every value that differs from the median would be an outlier.
+func rejectOutliers(samples []aggregateSample, k decimal.Decimal) (kept, outliers []aggregateSample) {
+	median := medianOfSorted(samples)
+	deviations := make([]aggregateSample, len(samples))
+	for i, s := range samples {
+		deviations[i] = aggregateSample{value: s.value.Sub(median).Abs()}
+	}
+	sort.Slice(deviations, func(i, j int) bool {
+		return deviations[i].value.LessThan(deviations[j].value)
+	})
+	mad := medianOfSorted(deviations)
+	if mad.IsZero() {
+		return samples, nil
+	}
+
+	limit := mad.Mul(k)
+	for _, s := range samples {
+		if s.value.Sub(median).Abs().GreaterThan(limit) {
+			outliers = append(outliers, s)
+		} else {
+			kept = append(kept, s)
+		}
+	}
+	return kept, outliers
+}
+
+func medianOfSorted(samples []aggregateSample) decimal.Decimal {
+	k := len(samples) / 2
+	if len(samples)%2 == 1 {
+		return samples[k].value
+	}
+	return samples[k].value.Add(samples[k-1].value).Div(decimal.NewFromInt(2))
+}
+
+func meanOf(samples []aggregateSample) decimal.Decimal {
+	sum := decimal.Zero
+	for _, s := range samples {
+		sum = sum.Add(s.value)
+	}
+	return sum.Div(decimal.NewFromInt(int64(len(samples))))
+}
+
+// weightedMedianOfSorted returns the value at which the cumulative weight
+// reaches half of the total. If it lands exactly on half, the two neighbouring
+// values are averaged, so that equal weights give the ordinary median.
+func weightedMedianOfSorted(samples []aggregateSample) (decimal.Decimal, error) {
+	total := decimal.Zero
+	for _, s := range samples {
+		total = total.Add(s.weight)
+	}
+	if !total.IsPositive() {
+		return decimal.Decimal{}, errors.Wrap(ErrBadInput, "weights of the remaining values sum to zero")
+	}
+
+	half := total.Div(decimal.NewFromInt(2))
+	cumulative := decimal.Zero
+	for i, s := range samples {
+		cumulative = cumulative.Add(s.weight)
+		if cumulative.LessThan(half) {
+			continue
+		}
+		if cumulative.Equal(half) {
+			for _, next := range samples[i+1:] {
+				if next.weight.IsPositive() {
+					return s.value.Add(next.value).Div(decimal.NewFromInt(2)), nil
+				}
+			}
+		}
+		return s.value, nil
+	}
+	return samples[len(samples)-1].value, nil
+}
@@ -0,0 +1,270 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestAggregateTask(t *testing.T) {
+	t.Parallel()
+
+	values := func(vs ...string) []pipeline.Result {
+		var results []pipeline.Result
+		for _, v := range vs {
+			if v == "err" {
+				results = append(results, pipeline.Result{Error: errors.New("source failed")})
+			} else {
+				results = append(results, pipeline.Result{Value: mustDecimal(t, v)})
+			}
+		}
+		return results
+	}
+	dropped := func(pairs ...interface{}) []interface{} {
+		out := []interface{}{}
+		for i := 0; i < len(pairs); i += 2 {
+			out = append(out, map[string]interface{}{"index": pairs[i], "reason": pairs[i+1]})
+		}
+		return out
+	}
+
+	tests := []struct {
+		name          string
+		inputs        []pipeline.Result
+		method        string
+		weights       string
+		trim          string
+		outlierMADs   string
+		minQuorum     string
+		allowedFaults string
+		vars          map[string]interface{}
+		wantResult    string
+		wantUsed      int
+		wantDropped   []interface{}
+		wantError     error
+	}{
+		{
+			name:        "defaults to median",
+			inputs:      values("3", "1", "2", "4"),
+			wantResult:  "2.5",
+			wantUsed:    4,
+			wantDropped: dropped(),
+		},
+		{
+			name:        "mean",
+			inputs:      values("1", "2", "6"),
+			method:      "mean",
+			wantResult:  "3",
+			wantUsed:    3,
+			wantDropped: dropped(),
+		},
+		{
+			name:        "trimmed mean",
+			inputs:      values("100", "1", "2", "3", "4", "5", "6", "7", "8", "-50"),
+			method:      "trimmedMean",
+			trim:        "0.1",
+			wantResult:  "4.5",
+			wantUsed:    8,
+			wantDropped: dropped(0, "trimmed", 9, "trimmed"),
+		},
+		{
+			name:        "trimmed mean rounds the trimmed count down",
+			inputs:      values("1", "2", "3", "10"),
+			method:      "trimmedMean",
+			trim:        "0.2",
+			wantResult:  "4",
+			wantUsed:    4,
+			wantDropped: dropped(),
+		},
+		{
+			name:        "weighted median",
+			inputs:      values("10", "20", "30"),
+			method:      "weightedMedian",
+			weights:     "[1, 1, 5]",
+			wantResult:  "30",
+			wantUsed:    3,
+			wantDropped: dropped(),
+		},
+		{
+			name:        "weighted median with equal weights matches median",
+			inputs:      values("10", "20", "30", "40"),
+			method:      "weightedMedian",
+			weights:     "[2, 2, 2, 2]",
+			wantResult:  "25",
+			wantUsed:    4,
+			wantDropped: dropped(),
+		},
+		{
+			name:        "weighted median with weights from vars",
+			inputs:      values("10", "20", "30"),
+			method:      "weightedMedian",
+			weights:     "$(weights)",
+			vars:        map[string]interface{}{"weights": []interface{}{3, 1, 1}},
+			wantResult:  "10",
+			wantUsed:    3,
+			wantDropped: dropped(),
+		},
+		{
+			name:        "weighted median with weights from json vars",
+			inputs:      values("10", "20", "30"),
+			method:      "weightedMedian",
+			weights:     "[ $(w.a), $(w.b), $(w.c) ]",
+			vars:        map[string]interface{}{"w": map[string]interface{}{"a": 1, "b": 3, "c": 1}},
+			wantResult:  "20",
+			wantUsed:    3,
+			wantDropped: dropped(),
+		},
+		{
+			name:        "outliers are rejected",
+			inputs:      values("100", "101", "99", "102", "98", "500"),
+			method:      "mean",
+			outlierMADs: "3",
+			wantResult:  "100",
+			wantUsed:    5,
+			wantDropped: dropped(5, "outlier"),
+		},
+		{
+			name:        "zero MAD rejects nothing",
+			inputs:      values("100", "100", "100", "500"),
+			method:      "mean",
+			outlierMADs: "3",
+			wantResult:  "200",
+			wantUsed:    4,
+			wantDropped: dropped(),
+		},
+		{
+			name:          "errors are dropped within allowed faults",
+			inputs:        values("err", "1", "2", "3"),
+			allowedFaults: "1",
+			wantResult:    "2",
+			wantUsed:      3,
+			wantDropped:   dropped(0, "errored"),
+		},
+		{
+			name:          "more errors than allowed faults",
+			inputs:        values("err", "err", "2", "3"),
+			allowedFaults: "1",
+			wantError:     pipeline.ErrTooManyErrors,
+		},
+		{
+			name:      "(unspecified AllowedFaults) all errored",
+			inputs:    values("err", "err"),
+			wantError: pipeline.ErrTooManyErrors,
+		},
+		{
+			name:          "zero inputs",
+			inputs:        values(),
+			allowedFaults: "0",
+			wantError:     pipeline.ErrWrongInputCardinality,
+		},
+		{
+			name:        "quorum met after dropping",
+			inputs:      values("err", "100", "101", "99", "500"),
+			outlierMADs: "3",
+			minQuorum:   "3",
+			wantResult:  "100",
+			wantUsed:    3,
+			wantDropped: dropped(0, "errored", 4, "outlier"),
+		},
+		{
+			name:        "quorum not met after dropping",
+			inputs:      values("err", "100", "101", "99", "500"),
+			outlierMADs: "3",
+			minQuorum:   "4",
+			wantError:   pipeline.ErrQuorumNotMet,
+		},
+		{
+			name:      "weights of the wrong length",
+			inputs:    values("1", "2"),
+			method:    "weightedMedian",
+			weights:   "[1]",
+			wantError: pipeline.ErrBadInput,
+		},
+		{
+			name:      "negative weight",
+			inputs:    values("1", "2"),
+			method:    "weightedMedian",
+			weights:   "[1, -1]",
+			wantError: pipeline.ErrBadInput,
+		},
+		{
+			name:      "trim out of range",
+			inputs:    values("1", "2"),
+			method:    "trimmedMean",
+			trim:      "0.5",
+			wantError: pipeline.ErrBadInput,
+		},
+		{
+			name:      "unknown method",
+			inputs:    values("1", "2"),
+			method:    "geometricMean",
+			wantError: pipeline.ErrBadInput,
+		},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.AggregateTask{
+				BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Method:        test.method,
+				Weights:       test.weights,
+				Trim:          test.trim,
+				OutlierMADs:   test.outlierMADs,
+				MinQuorum:     test.minQuorum,
+				AllowedFaults: test.allowedFaults,
+			}
+			output, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(test.vars), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantError != nil {
+				require.Equal(t, test.wantError, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+				return
+			}
+			require.NoError(t, output.Error)
+			value := output.Value.(map[string]interface{})
+			require.Equal(t, test.wantResult, value["result"].(decimal.Decimal).String())
+			require.Equal(t, test.wantUsed, value["used"])
+			require.Equal(t, test.wantDropped, value["dropped"])
+		})
+	}
+}
+
+func TestAggregateTask_Unmarshal(t *testing.T) {
+	t.Parallel()
+
+	p, err := pipeline.Parse(`
+	ds1 [type=memo value=1];
+	ds2 [type=memo value=2];
+	ds3 [type=memo value=3];
+
+	ds1 -> answer;
+	ds2 -> answer;
+	ds3 -> answer;
+
+	answer [type=aggregate method=weightedMedian weights="[1, 2, 3]" outlierMADs=3 minQuorum=2 allowedFaults=1];
+`)
+	require.NoError(t, err)
+
+	var task *pipeline.AggregateTask
+	for _, tk := range p.Tasks {
+		if tk.Type() == pipeline.TaskTypeAggregate {
+			task = tk.(*pipeline.AggregateTask)
+		}
+	}
+	require.NotNil(t, task)
+	assert.Equal(t, "weightedMedian", task.Method)
+	assert.Equal(t, "[1, 2, 3]", task.Weights)
+	assert.Equal(t, "3", task.OutlierMADs)
+	assert.Equal(t, "2", task.MinQuorum)
+	assert.Equal(t, "1", task.AllowedFaults)
+}
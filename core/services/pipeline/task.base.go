@@ -18,9 +18,11 @@ type BaseTask struct {
 	Timeout   *time.Duration `mapstructure:"timeout"`
 	FailEarly bool           `mapstructure:"failEarly"`
 
-	Retries    null.Uint32   `mapstructure:"retries"`
-	MinBackoff time.Duration `mapstructure:"minBackoff"`
-	MaxBackoff time.Duration `mapstructure:"maxBackoff"`
+	Retries     null.Uint32   `mapstructure:"retries"`
+	MinBackoff  time.Duration `mapstructure:"minBackoff"`
+	MaxBackoff  time.Duration `mapstructure:"maxBackoff"`
+	RetryJitter bool          `mapstructure:"retryJitter"`
+	RetryOn     RetryOn       `mapstructure:"retryOn"`
 
 	Tags string `mapstructure:"tags" json:"-"`
 
@@ -80,6 +82,26 @@ func (t BaseTask) TaskMaxBackoff() time.Duration {
 	return time.Minute
 }
 
+func (t BaseTask) TaskRetryPolicy() RetryPolicy {
+	policy := RetryPolicy{
+		MaxAttempts:   t.TaskRetries(),
+		MinBackoff:    t.TaskMinBackoff(),
+		MaxBackoff:    t.TaskMaxBackoff(),
+		Jitter:        t.RetryJitter,
+		RetryableOnly: t.RetryOn == RetryOnRetryable,
+	}
+	// Tasks that opt into the retry policy default to a max backoff of a minute, while
+	// the backoff of existing specs that do not set maxBackoff is unchanged.
+	if t.RetryJitter || t.RetryOn != "" {
+		policy.MaxBackoff = time.Minute
+	}
+	// maxBackoff is honoured on its own by any task that retries.
+	if t.MaxBackoff > 0 && (t.TaskRetries() > 0 || t.RetryJitter || t.RetryOn != "") {
+		policy.MaxBackoff = t.MaxBackoff
+	}
+	return policy
+}
+
 func (t BaseTask) TaskTags() string {
 	return t.Tags
 }
@@ -2,11 +2,15 @@ package pipeline
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
 	"encoding/json"
+	"maps"
 	"net/http"
 	"net/url"
 	"path"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -64,11 +68,12 @@ type BridgeTask struct {
 	CacheTTL          string `json:"cacheTTL"`
 	Headers           string `json:"headers"`
 
-	specId       int32
-	orm          bridges.ORM
-	config       Config
-	bridgeConfig BridgeConfig
-	httpClient   *http.Client
+	specId        int32
+	orm           bridges.ORM
+	config        Config
+	bridgeConfig  BridgeConfig
+	httpClient    *http.Client
+	bridgeClients *bridgeClients
 }
 
 var _ Task = (*BridgeTask)(nil)
@@ -112,10 +117,15 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	overtimeCtx, cancel := overtimeContext(ctx)
 	defer cancel()
 
-	url, err := t.getBridgeURLFromName(overtimeCtx, name)
+	bt, err := t.getBridgeTypeFromName(overtimeCtx, name)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	url := URLParam(bt.URL)
+	client, err := t.bridgeClients.get(bt, t.httpClient)
+	if err != nil {
+		return Result{Error: errors.Wrapf(err, "bridge %s", bt.Name)}, runInfo
+	}
 
 	var metaMap MapParam
 
@@ -159,6 +169,12 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		"url", url.String(),
 	)
 
+	// Bridges with a cache policy share responses between all tasks sending
+	// the same request, which replaces the per task cacheTTL fallback.
+	if responseCache, isCache := t.orm.(bridges.ResponseCache); isCache && t.Async != "true" && bt.CachePolicy().Enabled() {
+		return t.runWithResponseCache(ctx, lggr, responseCache, bt, client, reqHeaders, requestData, requestDataJSON)
+	}
+
 	requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
 	defer cancel()
 
@@ -170,7 +186,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	}
 
 	var cachedResponse bool
-	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+	responseBytes, statusCode, headers, elapsed, err := makeBridgeRequest(requestCtx, lggr, bt, t.circuitBreaker(), reqHeaders, requestData, requestDataJSON, client, t.config.DefaultHTTPLimit())
 
 	// check for external adapter response object status
 	if code, ok := eautils.BestEffortExtractEAStatus(responseBytes); ok {
@@ -184,7 +200,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 
 		promBridgeErrors.WithLabelValues(t.Name).Inc()
 		if cacheTTL == 0 {
-			return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+			return Result{Error: err}, RunInfo{IsRetryable: isRetryableBridgeError(statusCode, err)}
 		}
 
 		var cacheErr error
@@ -197,7 +213,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 					"url", url.String(),
 				)
 			}
-			return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+			return Result{Error: err}, RunInfo{IsRetryable: isRetryableBridgeError(statusCode, err)}
 		}
 		promBridgeCacheHits.WithLabelValues(t.Name).Inc()
 		lggr.Debugw("Bridge task: request failed, falling back to cache",
@@ -248,12 +264,190 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	return result, runInfo
 }
 
-func (t *BridgeTask) getBridgeURLFromName(ctx context.Context, name StringParam) (URLParam, error) {
+// bridgeCallError is a failed bridge call made through the response cache,
+// with the status code that decides whether it is retryable.
+type bridgeCallError struct {
+	statusCode int
+	err        error
+}
+
+func (e *bridgeCallError) Error() string { return e.err.Error() }
+
+func (e *bridgeCallError) Unwrap() error { return e.err }
+
+func (t *BridgeTask) runWithResponseCache(ctx context.Context, lggr logger.Logger, responseCache bridges.ResponseCache, bt bridges.BridgeType, client *http.Client, reqHeaders []string, requestData MapParam, requestDataJSON []byte) (Result, RunInfo) {
+	url := URLParam(bt.URL)
+	httpLimit := t.config.DefaultHTTPLimit()
+	breaker := t.circuitBreaker()
+
+	// fetch may be called again in the background to refresh the response
+	fetch := func(ctx context.Context) ([]byte, error) {
+		requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
+		defer cancel()
+
+		responseBytes, statusCode, _, elapsed, err := makeBridgeRequest(requestCtx, lggr, bt, breaker, reqHeaders, requestData, requestDataJSON, client, httpLimit)
+		if code, ok := eautils.BestEffortExtractEAStatus(responseBytes); ok {
+			statusCode = code
+		}
+		if err != nil || statusCode != http.StatusOK {
+			if adapterErr := eautils.BestEffortExtractEAError(responseBytes); adapterErr != nil {
+				err = adapterErr
+			}
+			if err == nil {
+				err = errors.Errorf("got status code %d from bridge %s", statusCode, bt.Name)
+			}
+			promBridgeErrors.WithLabelValues(t.Name).Inc()
+			return nil, &bridgeCallError{statusCode: statusCode, err: err}
+		}
+
+		promBridgeLatency.WithLabelValues(t.Name).Set(elapsed.Seconds())
+		promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
+		promHTTPResponseBodySize.WithLabelValues(t.DotID()).Set(float64(len(responseBytes)))
+		return responseBytes, nil
+	}
+
+	// the run info differs between runs, so it is left out of the cache key
+	cacheRequest := maps.Clone(requestData)
+	delete(cacheRequest, "meta")
+	cacheRequestJSON, err := json.Marshal(cacheRequest)
+	if err != nil {
+		return Result{Error: err}, RunInfo{}
+	}
+
+	responseBytes, status, err := responseCache.FetchResponse(ctx, bt, cacheRequestJSON, fetch)
+	if err != nil {
+		var callErr *bridgeCallError
+		if errors.As(err, &callErr) {
+			return Result{Error: callErr.err}, RunInfo{IsRetryable: isRetryableBridgeError(callErr.statusCode, callErr.err)}
+		}
+		return Result{Error: err}, RunInfo{IsRetryable: isRetryableBridgeError(0, err)}
+	}
+	if status != bridges.CacheStatusMiss {
+		promBridgeCacheHits.WithLabelValues(t.Name).Inc()
+	}
+
+	result := Result{Value: string(responseBytes)}
+	lggr.Tracew("Bridge task: fetched answer",
+		"answer", result.Value,
+		"url", url.String(),
+		"dotID", t.DotID(),
+		"cacheStatus", status,
+	)
+	return result, RunInfo{}
+}
+
+func (t *BridgeTask) getBridgeTypeFromName(ctx context.Context, name StringParam) (bridges.BridgeType, error) {
 	bt, err := t.orm.FindBridge(ctx, bridges.BridgeName(name))
 	if err != nil {
-		return URLParam{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+		return bridges.BridgeType{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+	}
+	return bt, nil
+}
+
+// circuitBreaker returns the circuit breaker of the bridges, or nil if the
+// ORM does not track circuits.
+func (t *BridgeTask) circuitBreaker() bridges.CircuitBreaker {
+	breaker, _ := t.orm.(bridges.CircuitBreaker)
+	return breaker
+}
+
+// isRetryableBridgeError is isRetryableHTTPError, except for calls rejected by
+// an open circuit, which retries would not get through.
+func isRetryableBridgeError(statusCode int, err error) bool {
+	return !errors.Is(err, bridges.ErrCircuitOpen) && isRetryableHTTPError(statusCode, err)
+}
+
+// makeBridgeRequest POSTs requestData to the bridge, signing the request and
+// verifying the response as configured for the bridge. requestDataJSON must
+// be the encoding of requestData, which is deterministic. If breaker is not
+// nil, the request fails fast while the circuit of the bridge is open, and
+// server errors and timeouts count as failures of the bridge.
+func makeBridgeRequest(ctx context.Context, lggr logger.Logger, bt bridges.BridgeType, breaker bridges.CircuitBreaker, reqHeaders []string, requestData MapParam, requestDataJSON []byte, client *http.Client, httpLimit int64) ([]byte, int, http.Header, time.Duration, error) {
+	if breaker != nil {
+		if err := breaker.AllowRequest(bt.Name); err != nil {
+			return nil, 0, nil, 0, err
+		}
+	}
+	reqHeaders = slices.Concat(reqHeaders, bt.SignRequest(requestDataJSON, time.Now()))
+	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(ctx, lggr, "POST", URLParam(bt.URL), reqHeaders, requestData, client, httpLimit)
+	if breaker != nil {
+		var failure error
+		if isRetryableHTTPError(statusCode, err) {
+			failure = err
+		}
+		breaker.RecordResult(bt.Name, failure)
+	}
+	if err == nil && statusCode == http.StatusOK {
+		if err = bt.VerifyResponse(headers, responseBytes, time.Now()); err != nil {
+			return nil, statusCode, headers, elapsed, errors.Wrapf(err, "bridge %s", bt.Name)
+		}
+	}
+	return responseBytes, statusCode, headers, elapsed, err
+}
+
+// bridgeClients holds the HTTP clients of the bridges that use mTLS, so that
+// their connections are reused across runs.
+type bridgeClients struct {
+	mu      sync.Mutex
+	clients map[bridges.BridgeName]bridgeClient
+}
+
+type bridgeClient struct {
+	fingerprint [sha256.Size]byte
+	client      *http.Client
+}
+
+func newBridgeClients() *bridgeClients {
+	return &bridgeClients{clients: make(map[bridges.BridgeName]bridgeClient)}
+}
+
+// get returns the client to call bt with: base, or a client derived from base
+// that presents the client certificate of bt if it uses mTLS, which requires
+// the transport of base to be an *http.Transport. A nil bridgeClients builds a
+// new client on every call.
+func (c *bridgeClients) get(bt bridges.BridgeType, base *http.Client) (*http.Client, error) {
+	if bt.AuthMode != bridges.AuthModeMTLS {
+		return base, nil
+	}
+
+	fingerprint := sha256.Sum256([]byte(bt.TLSClientCert + "\x00" + bt.TLSClientKey + "\x00" + bt.TLSRootCA))
+	if c != nil {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if cached, ok := c.clients[bt.Name]; ok && cached.fingerprint == fingerprint {
+			return cached.client, nil
+		}
+	}
+
+	tlsConfig, err := bt.ClientTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	client := &http.Client{}
+	transport := http.DefaultTransport.(*http.Transport)
+	if base != nil {
+		*client = *base
+		switch tr := base.Transport.(type) {
+		case nil:
+		case *http.Transport:
+			transport = tr
+		default:
+			// The client certificate can only be presented by an *http.Transport, and replacing the transport of base
+			// would drop its settings, like the dialer restricting the hosts called
+			return nil, errors.Errorf("cannot present the client certificate of bridge %s with the transport %T of the HTTP client", bt.Name, base.Transport)
+		}
+	}
+	transport = transport.Clone()
+	transport.TLSClientConfig = tlsConfig
+	client.Transport = transport
+
+	if c != nil {
+		if cached, ok := c.clients[bt.Name]; ok {
+			cached.client.CloseIdleConnections()
+		}
+		c.clients[bt.Name] = bridgeClient{fingerprint: fingerprint, client: client}
 	}
-	return URLParam(bt.URL), nil
+	return client, nil
 }
 
 func withRunInfo(request MapParam, meta MapParam) MapParam {
@@ -2,7 +2,10 @@ package pipeline_test
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"io"
 	"math/big"
@@ -11,6 +14,7 @@ import (
 	"net/url"
 	"os"
 	"sort"
+	"strconv"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -19,12 +23,14 @@ import (
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	bridgesMocks "github.com/smartcontractkit/chainlink/v2/core/bridges/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
@@ -1166,6 +1172,218 @@ func TestBridgeTask_AdapterResponseStatusFailure(t *testing.T) {
 	require.False(t, runInfo.IsPending)
 }
 
+func TestBridgeTask_CachePolicy(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+
+	var calls atomic.Int32
+	s1 := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			err := json.NewEncoder(w).Encode(&adapterResponse{Data: adapterResponseData{Result: &decimal.Zero}})
+			require.NoError(t, err)
+		}))
+	defer s1.Close()
+
+	feedURL, err := url.ParseRequestURI(s1.URL)
+	require.NoError(t, err)
+
+	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: feedURL.String()})
+	_, err = db.ExecContext(ctx, `UPDATE bridge_types SET cache_ttl = $1 WHERE name = $2`, models.Interval(time.Minute), bridge.Name)
+	require.NoError(t, err)
+
+	orm := bridges.NewCache(bridges.NewORM(db), logger.TestLogger(t), bridges.DefaultUpsertInterval)
+	servicetest.Run(t, orm)
+
+	task := pipeline.BridgeTask{
+		BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+		Name:        bridge.Name.String(),
+		RequestData: btcUSDPairing,
+	}
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	trORM := pipeline.NewORM(db, logger.TestLogger(t), cfg.JobPipeline().MaxSuccessfulRuns())
+	specID, err := trORM.CreateSpec(ctx, pipeline.Pipeline{}, *models.NewInterval(5 * time.Minute))
+	require.NoError(t, err)
+	task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, specID, uuid.UUID{}, c)
+
+	for i := 0; i < 2; i++ {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"jobRun": map[string]interface{}{
+				"meta": map[string]interface{}{"run": i},
+			},
+		})
+		result, runInfo := task.Run(ctx, logger.TestLogger(t), vars, nil)
+		require.NoError(t, result.Error)
+		require.NotNil(t, result.Value)
+		require.False(t, runInfo.IsRetryable)
+	}
+	// the second run is served from the cache of the bridge, even though the
+	// run meta differs
+	assert.Equal(t, int32(1), calls.Load())
+
+	// requests with another body are cached separately
+	task.RequestData = ethUSDPairing
+	result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, result.Error)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestBridgeTask_HMACAuth(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	const secret = "0123456789abcdef0123456789abcdef"
+	response := []byte(`{"data":{"result":1}}`)
+
+	var signResponses atomic.Bool
+	signResponses.Store(true)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		ts, err := strconv.ParseInt(r.Header.Get(bridges.TimestampHeader), 10, 64)
+		assert.NoError(t, err)
+		assert.Equal(t, bridges.Sign(secret, ts, body), r.Header.Get(bridges.SignatureHeader))
+
+		if signResponses.Load() {
+			w.Header().Set(bridges.TimestampHeader, strconv.FormatInt(ts, 10))
+			w.Header().Set(bridges.SignatureHeader, bridges.Sign(secret, ts, response))
+		}
+		_, err = w.Write(response)
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	orm := bridgesMocks.NewORM(t)
+	orm.On("FindBridge", mock.Anything, bridges.BridgeName("signed")).Return(bridges.BridgeType{
+		Name:            "signed",
+		URL:             cltest.WebURL(t, server.URL),
+		AuthMode:        bridges.AuthModeHMAC,
+		HMACSecret:      secret,
+		VerifyResponses: true,
+	}, nil)
+
+	task := pipeline.BridgeTask{
+		BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+		Name:        "signed",
+		RequestData: ethUSDPairing,
+	}
+	task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, 0, uuid.UUID{}, clhttptest.NewTestLocalOnlyHTTPClient())
+
+	result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, result.Error)
+	assert.Equal(t, string(response), result.Value)
+
+	signResponses.Store(false)
+	result, _ = task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.ErrorIs(t, result.Error, bridges.ErrInvalidSignature)
+	assert.Nil(t, result.Value)
+}
+
+func TestBridgeTask_CircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	orm := bridgesMocks.NewORM(t)
+	orm.On("FindBridge", mock.Anything, bridges.BridgeName("failing")).Return(bridges.BridgeType{
+		Name: "failing",
+		URL:  cltest.WebURL(t, server.URL),
+	}, nil).Once()
+	cache := bridges.NewCache(orm, logger.TestLogger(t), bridges.DefaultUpsertInterval)
+
+	task := pipeline.BridgeTask{
+		BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+		Name:        "failing",
+		RequestData: ethUSDPairing,
+	}
+	task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), cache, 0, uuid.UUID{}, clhttptest.NewTestLocalOnlyHTTPClient())
+
+	for i := 0; i < bridges.CircuitFailureThreshold; i++ {
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.Error(t, result.Error)
+		assert.NotErrorIs(t, result.Error, bridges.ErrCircuitOpen)
+		assert.True(t, runInfo.IsRetryable)
+	}
+	require.Equal(t, bridges.CircuitOpen, cache.Circuits().Status("failing").State)
+
+	result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	var openErr *bridges.CircuitOpenError
+	require.ErrorAs(t, result.Error, &openErr)
+	assert.Equal(t, bridges.BridgeName("failing"), openErr.Name)
+	assert.False(t, runInfo.IsRetryable, "calls rejected by an open circuit are not retried")
+	assert.Equal(t, int32(bridges.CircuitFailureThreshold), calls.Load(), "the bridge is not called while the circuit is open")
+	assert.Error(t, cache.HealthReport()[bridges.CacheServiceName+".Circuit.failing"])
+}
+
+func TestBridgeTask_MTLSAuth(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	clientCert, clientKey := clhttptest.NewTLSCertificatePEM(t)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"data":{"result":1}}`))
+		assert.NoError(t, err)
+	}))
+	clientCAs := x509.NewCertPool()
+	require.True(t, clientCAs.AppendCertsFromPEM([]byte(clientCert)))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	server.StartTLS()
+	defer server.Close()
+	serverCA := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	bridge := bridges.BridgeType{
+		Name:          "mtls",
+		URL:           cltest.WebURL(t, server.URL),
+		AuthMode:      bridges.AuthModeMTLS,
+		TLSClientCert: clientCert,
+		TLSClientKey:  clientKey,
+		TLSRootCA:     serverCA,
+	}
+	orm := bridgesMocks.NewORM(t)
+	orm.On("FindBridge", mock.Anything, bridges.BridgeName("mtls")).Return(bridge, nil).Once()
+
+	task := pipeline.BridgeTask{
+		BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+		Name:        "mtls",
+		RequestData: ethUSDPairing,
+	}
+	task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, 0, uuid.UUID{}, clhttptest.NewTestLocalOnlyHTTPClient())
+
+	result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, result.Error)
+	assert.Equal(t, `{"data":{"result":1}}`, result.Value)
+
+	// the server rejects requests without the client certificate
+	otherCert, otherKey := clhttptest.NewTLSCertificatePEM(t)
+	bridge.TLSClientCert, bridge.TLSClientKey = otherCert, otherKey
+	orm.On("FindBridge", mock.Anything, bridges.BridgeName("mtls")).Return(bridge, nil).Once()
+
+	result, _ = task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.Error(t, result.Error)
+
+	// the client certificate cannot be added to a transport that is not an *http.Transport without dropping its settings
+	wrapped := &http.Client{Transport: roundTripperFunc(http.DefaultTransport.RoundTrip)}
+	task.HelperSetDependencies(cfg.JobPipeline(), cfg.WebServer(), orm, 0, uuid.UUID{}, wrapped)
+	orm.On("FindBridge", mock.Anything, bridges.BridgeName("mtls")).Return(bridge, nil).Once()
+
+	result, _ = task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.ErrorContains(t, result.Error, "cannot present the client certificate of bridge mtls")
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
 func TestBridgeTask_AdapterTimeout(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
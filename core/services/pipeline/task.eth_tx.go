@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"math/big"
 	"reflect"
+	"slices"
 	"strconv"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-viper/mapstructure/v2"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
 	"gopkg.in/guregu/null.v4"
@@ -17,6 +20,7 @@ import (
 	clnull "github.com/smartcontractkit/chainlink-common/pkg/utils/null"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -39,15 +43,33 @@ type ETHTxTask struct {
 	FailOnRevert    string `json:"failOnRevert"`
 	EVMChainID      string `json:"evmChainID" mapstructure:"evmChainID"`
 	TransmitChecker string `json:"transmitChecker"`
+	// PrivateSubmission, if set, sends the transaction through the private relay of the chain instead of the public mempool
+	PrivateSubmission string `json:"privateSubmission"`
+	// Deadline, if set, is the block number or RFC3339 time after which the transaction is cancelled if it is not
+	// included yet
+	Deadline string `json:"deadline"`
+	// BatchSize, if greater than 1, aggregates up to that many transactions of the job into a single one. Forwarded
+	// transactions are batched by their forwarder, the others only if BatchMulticall is set
+	BatchSize string `json:"batchSize"`
+	// BatchMulticall, if set, batches the transactions that are not forwarded by Multicall3, which becomes the caller of
+	// their target, so their target must not authenticate its caller
+	BatchMulticall string `json:"batchMulticall"`
 
 	forwardingAllowed bool
 	specGasLimit      *uint32
 	keyStore          ETHKeyStore
 	legacyChains      legacyevm.LegacyChainContainer
 	jobType           string
+	multicall3        *multicall3Deployments
 }
 
 type ETHKeyStore interface {
+	keySelector
+	EnabledAddressesForChain(ctx context.Context, chainID *big.Int) ([]common.Address, error)
+}
+
+// keySelector picks the key sending a transaction, like the keystore or the key pool of a chain.
+type keySelector interface {
 	GetRoundRobinAddress(ctx context.Context, chainID *big.Int, addrs ...common.Address) (common.Address, error)
 }
 
@@ -95,6 +117,10 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		maybeMinConfirmations MaybeUint64Param
 		transmitCheckerMap    MapParam
 		failOnRevert          BoolParam
+		privateSubmission     BoolParam
+		deadline              DeadlineParam
+		batchSize             Uint64Param
+		batchMulticall        BoolParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&fromAddrs, From(VarExpr(t.From, vars), JSONWithVarExprs(t.From, vars, false), NonemptyString(t.From), nil)), "from"),
@@ -105,6 +131,10 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		errors.Wrap(ResolveParam(&maybeMinConfirmations, From(VarExpr(t.MinConfirmations, vars), NonemptyString(t.MinConfirmations), "")), "minConfirmations"),
 		errors.Wrap(ResolveParam(&transmitCheckerMap, From(VarExpr(t.TransmitChecker, vars), JSONWithVarExprs(t.TransmitChecker, vars, false), MapParam{})), "transmitChecker"),
 		errors.Wrap(ResolveParam(&failOnRevert, From(NonemptyString(t.FailOnRevert), false)), "failOnRevert"),
+		errors.Wrap(ResolveParam(&privateSubmission, From(VarExpr(t.PrivateSubmission, vars), NonemptyString(t.PrivateSubmission), false)), "privateSubmission"),
+		errors.Wrap(ResolveParam(&deadline, From(VarExpr(t.Deadline, vars), NonemptyString(t.Deadline), "")), "deadline"),
+		errors.Wrap(ResolveParam(&batchSize, From(NonemptyString(t.BatchSize), 0)), "batchSize"),
+		errors.Wrap(ResolveParam(&batchMulticall, From(NonemptyString(t.BatchMulticall), false)), "batchMulticall"),
 	)
 	if err != nil {
 		return Result{Error: err}, RunInfo{}
@@ -123,16 +153,28 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		return Result{Error: err}, RunInfo{}
 	}
 
-	fromAddr, err := t.keyStore.GetRoundRobinAddress(ctx, chain.ID(), fromAddrs...)
+	// The treasury key of the chain only sends the top-ups of the other keys, so it is not picked when from is not set
+	if treasury := cfg.BalanceMonitor().TopUp().TreasuryAddress(); len(fromAddrs) == 0 && treasury != nil {
+		fromAddrs, err = t.addressesExcept(ctx, chain.ID(), treasury.Address())
+		if err != nil {
+			err = errors.Wrap(err, "ETHTxTask failed to get fromAddress")
+			lggr.Error(err)
+			return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while querying keystore: %v", err)}, retryableRunInfo()
+		}
+	}
+
+	// The key pool of the chain, if enabled, assigns the transaction to the least busy of the fromAddrs
+	var selector keySelector = t.keyStore
+	if pool := chain.KeyPool(); pool != nil {
+		selector = pool
+	}
+	fromAddr, err := selector.GetRoundRobinAddress(ctx, chain.ID(), fromAddrs...)
 	if err != nil {
 		err = errors.Wrap(err, "ETHTxTask failed to get fromAddress")
 		lggr.Error(err)
 		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while querying keystore: %v", err)}, retryableRunInfo()
 	}
 
-	// TODO(sc-55115): Allow job specs to pass in the strategy that they want
-	strategy := txmgrcommon.NewSendEveryStrategy()
-
 	var forwarderAddress common.Address
 	if t.forwardingAllowed {
 		var fwderr error
@@ -142,16 +184,26 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		}
 	}
 
+	forwarded := cfg.Transactions().ForwardersEnabled() && forwarderAddress != (common.Address{})
+	if batchSize > 1 && !forwarded {
+		if err = t.multicall3.check(ctx, chain, bool(batchMulticall)); err != nil {
+			return Result{Error: errors.Wrap(err, "batchSize")}, RunInfo{}
+		}
+	}
+	strategy := selectStrategy(lggr, vars, uint32(batchSize), common.Address(toAddr), forwarderAddress, forwarded)
+
 	txRequest := txmgr.TxRequest{
-		FromAddress:      fromAddr,
-		ToAddress:        common.Address(toAddr),
-		EncodedPayload:   []byte(data),
-		FeeLimit:         uint64(gasLimit),
-		Meta:             txMeta,
-		ForwarderAddress: forwarderAddress,
-		Strategy:         strategy,
-		Checker:          transmitChecker,
-		SignalCallback:   true,
+		FromAddress:       fromAddr,
+		ToAddress:         common.Address(toAddr),
+		EncodedPayload:    []byte(data),
+		FeeLimit:          uint64(gasLimit),
+		Meta:              txMeta,
+		ForwarderAddress:  forwarderAddress,
+		Strategy:          strategy,
+		Checker:           transmitChecker,
+		SignalCallback:    true,
+		PrivateSubmission: bool(privateSubmission),
+		Deadline:          txmgrtypes.TxDeadline{Time: deadline.Time, BlockNumber: deadline.BlockNumber},
 	}
 
 	if !isMinConfirmationSet {
@@ -175,6 +227,90 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 	return Result{}, RunInfo{}
 }
 
+// multicall3Deployments holds the chains Multicall3 is known to be deployed on, so that its code is only looked up once
+// per chain.
+type multicall3Deployments struct {
+	mu       sync.Mutex
+	deployed map[multicall3Deployment]struct{}
+}
+
+type multicall3Deployment struct {
+	chainID string
+	address common.Address
+}
+
+func newMulticall3Deployments() *multicall3Deployments {
+	return &multicall3Deployments{deployed: make(map[multicall3Deployment]struct{})}
+}
+
+// check returns an error unless the transactions of the job were opted in to batching by Multicall3 with
+// batchMulticall, and Multicall3 is deployed on chain. A nil multicall3Deployments looks the code of Multicall3 up on
+// every call.
+func (d *multicall3Deployments) check(ctx context.Context, chain legacyevm.Chain, batchMulticall bool) error {
+	if !batchMulticall {
+		return errors.New("batching transactions that are not forwarded requires batchMulticall, since Multicall3 becomes the caller of their target")
+	}
+	deployment := multicall3Deployment{chainID: chain.ID().String(), address: txmgr.Multicall3Address}
+	if d != nil {
+		d.mu.Lock()
+		_, ok := d.deployed[deployment]
+		d.mu.Unlock()
+		if ok {
+			return nil
+		}
+	}
+
+	code, err := chain.Client().CodeAt(ctx, deployment.address, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to get the code of Multicall3")
+	}
+	if len(code) == 0 {
+		return errors.Errorf("Multicall3 is not deployed at %s on chain %s", deployment.address, deployment.chainID)
+	}
+
+	if d != nil {
+		d.mu.Lock()
+		d.deployed[deployment] = struct{}{}
+		d.mu.Unlock()
+	}
+	return nil
+}
+
+// selectStrategy returns the strategy batching up to batchSize transactions of the job with the same from address, by
+// the forwarder they are sent through if forwarded, or else by Multicall3.
+func selectStrategy(lggr logger.Logger, vars Vars, batchSize uint32, toAddr, forwarderAddress common.Address, forwarded bool) txmgrtypes.TxStrategy {
+	if batchSize < 2 {
+		return txmgrcommon.NewSendEveryStrategy()
+	}
+	externalJobID, err := vars.Get("jobSpec.externalJobID")
+	if err != nil {
+		lggr.Warnw("Skipping batching for job without an external job ID, will fallback to default behavior", "err", err)
+		return txmgrcommon.NewSendEveryStrategy()
+	}
+	subject, ok := externalJobID.(uuid.UUID)
+	if !ok {
+		logger.Sugared(lggr).AssumptionViolationf("expected type uuid.UUID for vars.jobSpec.externalJobID; got: %T (value: %v)", externalJobID, externalJobID)
+		return txmgrcommon.NewSendEveryStrategy()
+	}
+	if forwarded {
+		return txmgr.NewForwarderBatchStrategy(subject, batchSize, forwarderAddress)
+	}
+	return txmgr.NewMulticall3BatchStrategy(subject, batchSize, []common.Address{toAddr})
+}
+
+// addressesExcept returns the enabled addresses of chainID, except excluded.
+func (t *ETHTxTask) addressesExcept(ctx context.Context, chainID *big.Int, excluded common.Address) ([]common.Address, error) {
+	addresses, err := t.keyStore.EnabledAddressesForChain(ctx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	addresses = slices.DeleteFunc(addresses, func(address common.Address) bool { return address == excluded })
+	if len(addresses) == 0 {
+		return nil, errors.Errorf("no sending keys available for chain %s other than the treasury key %s", chainID, excluded)
+	}
+	return addresses, nil
+}
+
 func decodeMeta(metaMap MapParam) (*txmgr.TxMeta, error) {
 	var txMeta txmgr.TxMeta
 	metaDecoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
@@ -0,0 +1,51 @@
+package pipeline
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	legacyevmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestMulticall3Deployments_Check(t *testing.T) {
+	t.Parallel()
+
+	newChain := func(t *testing.T, chainID int64, code []byte) (*legacyevmmocks.Chain, *evmclimocks.Client) {
+		client := evmclimocks.NewClient(t)
+		client.On("CodeAt", mock.Anything, txmgr.Multicall3Address, (*big.Int)(nil)).Return(code, nil).Once()
+		chain := legacyevmmocks.NewChain(t)
+		chain.On("ID").Return(big.NewInt(chainID))
+		chain.On("Client").Return(client)
+		return chain, client
+	}
+
+	t.Run("looks the code up once per chain", func(t *testing.T) {
+		d := newMulticall3Deployments()
+		chain1, _ := newChain(t, 1, []byte{0x60})
+		chain2, _ := newChain(t, 2, []byte{0x60})
+		for i := 0; i < 3; i++ {
+			require.NoError(t, d.check(testutils.Context(t), chain1, true))
+			require.NoError(t, d.check(testutils.Context(t), chain2, true))
+		}
+	})
+
+	t.Run("looks the code up again while Multicall3 is not deployed", func(t *testing.T) {
+		d := newMulticall3Deployments()
+		chain, client := newChain(t, 1, []byte{})
+		require.ErrorContains(t, d.check(testutils.Context(t), chain, true), "Multicall3 is not deployed")
+		client.On("CodeAt", mock.Anything, txmgr.Multicall3Address, (*big.Int)(nil)).Return([]byte{0x60}, nil).Once()
+		require.NoError(t, d.check(testutils.Context(t), chain, true))
+		require.NoError(t, d.check(testutils.Context(t), chain, true))
+	})
+
+	t.Run("requires batchMulticall", func(t *testing.T) {
+		d := newMulticall3Deployments()
+		require.ErrorContains(t, d.check(testutils.Context(t), legacyevmmocks.NewChain(t), false), "requires batchMulticall")
+	})
+}
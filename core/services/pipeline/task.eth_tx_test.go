@@ -1,9 +1,11 @@
 package pipeline_test
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -12,9 +14,11 @@ import (
 
 	clnull "github.com/smartcontractkit/chainlink-common/pkg/utils/null"
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/evmtest"
@@ -603,4 +607,121 @@ func TestETHTxTask(t *testing.T) {
 	}
 }
 
+func TestETHTxTask_BatchSize(t *testing.T) {
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+	externalJobID := uuid.New()
+	jobVars := pipeline.NewVarsFrom(map[string]interface{}{"jobSpec": map[string]interface{}{"externalJobID": externalJobID}})
+
+	tests := []struct {
+		name                  string
+		vars                  pipeline.Vars
+		batchMulticall        string
+		multicallCode         []byte
+		expectedStrategy      txmgrtypes.TxStrategy
+		expectedErrorContains string
+	}{
+		{"batches with Multicall3", jobVars, "true", []byte{0x60},
+			txmgr.NewMulticall3BatchStrategy(externalJobID, 10, []common.Address{to}), ""},
+		{"sends every tx without an external job ID", pipeline.NewVarsFrom(nil), "true", []byte{0x60}, txmgrcommon.NewSendEveryStrategy(), ""},
+		{"fails without batchMulticall", jobVars, "", nil, nil, "requires batchMulticall"},
+		{"fails if Multicall3 is not deployed", jobVars, "true", []byte{}, nil, "Multicall3 is not deployed"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.ETHTxTask{
+				BaseTask:         pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+				From:             `[ "0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c" ]`,
+				To:               to.String(),
+				Data:             "foobar",
+				GasLimit:         "12345",
+				MinConfirmations: "0",
+				EVMChainID:       "0",
+				BatchSize:        "10",
+				BatchMulticall:   test.batchMulticall,
+			}
+
+			keyStore := keystoremocks.NewEth(t)
+			txManager := txmmocks.NewMockEvmTxManager(t)
+			ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+			db := pgtest.NewSqlxDB(t)
+			cfg := configtest.NewGeneralConfig(t, nil)
+			legacyChains := evmtest.NewLegacyChains(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg,
+				TxManager: txManager, KeyStore: keyStore, Client: ethClient})
+
+			keyStore.On("GetRoundRobinAddress", mock.Anything, testutils.FixtureChainID, from).Return(from, nil)
+			if test.multicallCode != nil {
+				ethClient.On("CodeAt", mock.Anything, txmgr.Multicall3Address, (*big.Int)(nil)).Return(test.multicallCode, nil)
+			}
+			if test.expectedErrorContains == "" {
+				txManager.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(txRequest txmgr.TxRequest) bool {
+					return assert.Equal(t, test.expectedStrategy, txRequest.Strategy)
+				})).Return(txmgr.Tx{}, nil)
+			}
+			task.HelperSetDependencies(legacyChains, keyStore, nil, pipeline.DirectRequestJobType)
+
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, nil)
+			if test.expectedErrorContains != "" {
+				require.ErrorContains(t, result.Error, test.expectedErrorContains)
+				return
+			}
+			require.NoError(t, result.Error)
+			assert.Equal(t, pipeline.RunInfo{}, runInfo)
+		})
+	}
+}
+
+func TestETHTxTask_TreasuryKey(t *testing.T) {
+	treasury := testutils.NewAddress()
+	from := testutils.NewAddress()
+
+	tests := []struct {
+		name                  string
+		enabled               []common.Address
+		expectedErrorContains string
+	}{
+		{"sends from the other keys", []common.Address{treasury, from}, ""},
+		{"fails without other keys", []common.Address{treasury}, "no sending keys available"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.ETHTxTask{
+				BaseTask:         pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+				To:               "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+				Data:             "foobar",
+				GasLimit:         "12345",
+				MinConfirmations: "0",
+				EVMChainID:       "0",
+			}
+
+			keyStore := keystoremocks.NewEth(t)
+			txManager := txmmocks.NewMockEvmTxManager(t)
+			db := pgtest.NewSqlxDB(t)
+			cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+				c.EVM[0].BalanceMonitor.TopUp.TreasuryAddress = ptr(types.EIP55AddressFromAddress(treasury))
+			})
+			legacyChains := evmtest.NewLegacyChains(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg,
+				TxManager: txManager, KeyStore: keyStore})
+
+			keyStore.On("EnabledAddressesForChain", mock.Anything, testutils.FixtureChainID).Return(test.enabled, nil)
+			if test.expectedErrorContains == "" {
+				keyStore.On("GetRoundRobinAddress", mock.Anything, testutils.FixtureChainID, from).Return(from, nil)
+				txManager.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(txRequest txmgr.TxRequest) bool {
+					return txRequest.FromAddress == from
+				})).Return(txmgr.Tx{}, nil)
+			}
+			task.HelperSetDependencies(legacyChains, keyStore, nil, pipeline.DirectRequestJobType)
+
+			result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			if test.expectedErrorContains != "" {
+				require.ErrorContains(t, result.Error, test.expectedErrorContains)
+				return
+			}
+			require.NoError(t, result.Error)
+		})
+	}
+}
+
 func ptr[T any](t T) *T { return &t }
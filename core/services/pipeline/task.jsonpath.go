@@ -0,0 +1,177 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// JSONPathReduce names an aggregation applied to the values matched by a jsonpath task.
+type JSONPathReduce string
+
+const (
+	JSONPathReduceNone  JSONPathReduce = ""
+	JSONPathReduceSum   JSONPathReduce = "sum"
+	JSONPathReduceMean  JSONPathReduce = "mean"
+	JSONPathReduceMin   JSONPathReduce = "min"
+	JSONPathReduceMax   JSONPathReduce = "max"
+	JSONPathReduceCount JSONPathReduce = "count"
+	JSONPathReduceFirst JSONPathReduce = "first"
+	JSONPathReduceLast  JSONPathReduce = "last"
+)
+
+// Return types:
+//
+//	float64
+//	string
+//	bool
+//	map[string]interface{}
+//	[]interface{}
+//	decimal.Decimal (sum, mean, min, max and count reductions)
+//	nil
+type JSONPathTask struct {
+	BaseTask `mapstructure:",squash"`
+	Path     string `json:"path"`
+	Data     string `json:"data"`
+	// Reduce aggregates all matches into a single value, see JSONPathReduce
+	Reduce string `json:"reduce"`
+	// Default is returned when the path matches nothing. It is parsed as JSON if possible.
+	Default string `json:"default"`
+	// Lax when disabled will return an error if the path matches nothing and no default is set
+	// Lax when enabled will return nil with no error if the path matches nothing
+	Lax string
+}
+
+var _ Task = (*JSONPathTask)(nil)
+
+func (t *JSONPathTask) Type() TaskType {
+	return TaskTypeJSONPath
+}
+
+func (t *JSONPathTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		path   StringParam
+		data   BytesParam
+		reduce StringParam
+		lax    BoolParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&path, From(VarExpr(t.Path, vars), NonemptyString(t.Path))), "path"),
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), Input(inputs, 0))), "data"),
+		errors.Wrap(ResolveParam(&reduce, From(VarExpr(t.Reduce, vars), t.Reduce)), "reduce"),
+		errors.Wrap(ResolveParam(&lax, From(NonemptyString(t.Lax), false)), "lax"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	jsonPath, err := CompileJSONPath(string(path))
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "path")}, runInfo
+	}
+
+	var decoded interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err = d.Decode(&decoded); err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	matches := jsonPath.Find(decoded)
+	if len(matches) == 0 && JSONPathReduce(reduce) != JSONPathReduceCount {
+		switch {
+		case t.Default != "":
+			value, err := t.defaultValue(vars)
+			if err != nil {
+				return Result{Error: errors.Wrap(err, "default")}, runInfo
+			}
+			return Result{Value: value}, runInfo
+		case bool(lax):
+			return Result{Value: nil}, runInfo
+		default:
+			return Result{Error: errors.Wrapf(ErrKeypathNotFound, `could not resolve path %s in %s`, jsonPath, data)}, runInfo
+		}
+	}
+
+	var value interface{}
+	switch r := JSONPathReduce(reduce); r {
+	case JSONPathReduceNone:
+		if jsonPath.IsDefinite() {
+			value = matches[0]
+		} else {
+			value = matches
+		}
+	case JSONPathReduceFirst:
+		value = matches[0]
+	case JSONPathReduceLast:
+		value = matches[len(matches)-1]
+	case JSONPathReduceCount:
+		value = decimal.NewFromInt(int64(len(matches)))
+	case JSONPathReduceSum, JSONPathReduceMean, JSONPathReduceMin, JSONPathReduceMax:
+		value, err = reduceDecimals(r, matches)
+		if err != nil {
+			return Result{Error: err}, runInfo
+		}
+	default:
+		return Result{Error: errors.Wrapf(ErrBadInput, "unknown reduce %q", r)}, runInfo
+	}
+
+	value, err = jsonserializable.ReinterpretJSONNumbers(value)
+	if err != nil {
+		return Result{Error: multierr.Combine(ErrBadInput, err)}, runInfo
+	}
+
+	return Result{Value: value}, runInfo
+}
+
+// defaultValue resolves Default as a variable expression, then as JSON with
+// variable expressions, and falls back to the raw string.
+func (t *JSONPathTask) defaultValue(vars Vars) (interface{}, error) {
+	v, err := VarExpr(t.Default, vars)()
+	if !errors.Is(err, ErrParameterEmpty) {
+		return v, err
+	}
+	if v, err = JSONWithVarExprs(t.Default, vars, false)(); err == nil {
+		return v, nil
+	}
+	return t.Default, nil
+}
+
+func reduceDecimals(r JSONPathReduce, matches []interface{}) (decimal.Decimal, error) {
+	reinterpreted, err := jsonserializable.ReinterpretJSONNumbers(matches)
+	if err != nil {
+		return decimal.Decimal{}, errors.Wrapf(ErrBadInput, "%s: %v", r, err)
+	}
+	var values DecimalSliceParam
+	if err = values.UnmarshalPipelineParam(reinterpreted); err != nil {
+		return decimal.Decimal{}, errors.Wrapf(ErrBadInput, "%s: %v", r, err)
+	}
+
+	acc := values[0]
+	for _, v := range values[1:] {
+		switch r {
+		case JSONPathReduceSum, JSONPathReduceMean:
+			acc = acc.Add(v)
+		case JSONPathReduceMin:
+			acc = decimal.Min(acc, v)
+		case JSONPathReduceMax:
+			acc = decimal.Max(acc, v)
+		}
+	}
+	if r == JSONPathReduceMean {
+		acc = acc.Div(decimal.NewFromInt(int64(len(values))))
+	}
+	return acc, nil
+}
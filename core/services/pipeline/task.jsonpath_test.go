@@ -0,0 +1,209 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+const jsonPathTestPayload = `{
+	"data": {
+		"markets": [
+			{"symbol": "ETH", "venue": "a", "price": 3000.5, "volume": 10},
+			{"symbol": "BTC", "venue": "a", "price": 60000, "volume": 2},
+			{"symbol": "ETH", "venue": "b", "price": 3001.5, "volume": 20},
+			{"symbol": "ETH", "venue": "c", "price": 2990, "volume": 0, "stale": true}
+		]
+	}
+}`
+
+func TestJSONPathTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		data              string
+		path              string
+		reduce            string
+		defaultValue      string
+		lax               string
+		vars              map[string]interface{}
+		inputs            []pipeline.Result
+		wantData          interface{}
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{
+			name:     "definite path returns a single value",
+			path:     "$.data.markets[1].price",
+			inputs:   []pipeline.Result{{Value: jsonPathTestPayload}},
+			wantData: int64(60000),
+		},
+		{
+			name:     "negative index",
+			path:     "$['data']['markets'][-1].venue",
+			inputs:   []pipeline.Result{{Value: jsonPathTestPayload}},
+			wantData: "c",
+		},
+		{
+			name:     "projection returns all matches",
+			path:     "$.data.markets[*].symbol",
+			inputs:   []pipeline.Result{{Value: jsonPathTestPayload}},
+			wantData: []interface{}{"ETH", "BTC", "ETH", "ETH"},
+		},
+		{
+			name:     "filter",
+			path:     "$.data.markets[?(@.symbol == 'ETH' && @.volume > 0)].venue",
+			inputs:   []pipeline.Result{{Value: jsonPathTestPayload}},
+			wantData: []interface{}{"a", "b"},
+		},
+		{
+			name:     "filter on missing key",
+			path:     "$.data.markets[?(@.stale)].venue",
+			inputs:   []pipeline.Result{{Value: jsonPathTestPayload}},
+			wantData: []interface{}{"c"},
+		},
+		{
+			name:     "filter with parentheses and or",
+			path:     "$.data.markets[?((@.venue == 'a' || @.venue == 'c') && @.symbol != 'BTC')].price",
+			inputs:   []pipeline.Result{{Value: jsonPathTestPayload}},
+			wantData: []interface{}{3000.5, int64(2990)},
+		},
+		{
+			name:     "recursive descent",
+			path:     "$..volume",
+			inputs:   []pipeline.Result{{Value: jsonPathTestPayload}},
+			wantData: []interface{}{int64(10), int64(2), int64(20), int64(0)},
+		},
+		{
+			name:     "slice and union",
+			path:     "$.data.markets[0:2]['symbol','venue']",
+			inputs:   []pipeline.Result{{Value: jsonPathTestPayload}},
+			wantData: []interface{}{"ETH", "a", "BTC", "a"},
+		},
+		{
+			name:     "reduce mean",
+			path:     "$.data.markets[?(@.symbol == 'ETH' && @.volume > 0)].price",
+			reduce:   "mean",
+			inputs:   []pipeline.Result{{Value: jsonPathTestPayload}},
+			wantData: decimal.NewFromInt(3001),
+		},
+		{
+			name:     "reduce max",
+			path:     "$.data.markets[*].price",
+			reduce:   "max",
+			inputs:   []pipeline.Result{{Value: jsonPathTestPayload}},
+			wantData: decimal.NewFromInt(60000),
+		},
+		{
+			name:     "reduce count of nothing",
+			path:     "$.data.markets[?(@.symbol == 'SOL')]",
+			reduce:   "count",
+			inputs:   []pipeline.Result{{Value: jsonPathTestPayload}},
+			wantData: decimal.NewFromInt(0),
+		},
+		{
+			name:     "reduce first",
+			path:     "$.data.markets[?(@.symbol == 'ETH')].venue",
+			reduce:   "first",
+			inputs:   []pipeline.Result{{Value: jsonPathTestPayload}},
+			wantData: "a",
+		},
+		{
+			name:     "path and data from vars",
+			path:     "$(foo.path)",
+			data:     "$(foo.payload)",
+			vars:     map[string]interface{}{"foo": map[string]interface{}{"path": "$.data.markets[0].symbol", "payload": jsonPathTestPayload}},
+			wantData: "ETH",
+		},
+		{
+			name:         "default value when nothing matches",
+			path:         "$.data.markets[?(@.symbol == 'SOL')].price",
+			defaultValue: "0",
+			inputs:       []pipeline.Result{{Value: jsonPathTestPayload}},
+			wantData:     int64(0),
+		},
+		{
+			name:         "default value from vars",
+			path:         "$.data.missing",
+			defaultValue: "$(fallback)",
+			vars:         map[string]interface{}{"fallback": "n/a"},
+			inputs:       []pipeline.Result{{Value: jsonPathTestPayload}},
+			wantData:     "n/a",
+		},
+		{
+			name:     "lax returns nil when nothing matches",
+			path:     "$.data.missing",
+			lax:      "true",
+			inputs:   []pipeline.Result{{Value: jsonPathTestPayload}},
+			wantData: nil,
+		},
+		{
+			name:           "no match without lax or default",
+			path:           "$.data.missing",
+			inputs:         []pipeline.Result{{Value: jsonPathTestPayload}},
+			wantErrorCause: pipeline.ErrKeypathNotFound,
+		},
+		{
+			name:           "invalid path",
+			path:           "$.data[?(@.price >)]",
+			inputs:         []pipeline.Result{{Value: jsonPathTestPayload}},
+			wantErrorCause: pipeline.ErrWrongJSONPath,
+		},
+		{
+			name:           "reduce non-numeric values",
+			path:           "$.data.markets[*].symbol",
+			reduce:         "sum",
+			inputs:         []pipeline.Result{{Value: jsonPathTestPayload}},
+			wantErrorCause: pipeline.ErrBadInput,
+		},
+		{
+			name:              "unknown reduce",
+			path:              "$.data.markets[*].price",
+			reduce:            "median",
+			inputs:            []pipeline.Result{{Value: jsonPathTestPayload}},
+			wantErrorCause:    pipeline.ErrBadInput,
+			wantErrorContains: "unknown reduce",
+		},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			vars := pipeline.NewVarsFrom(test.vars)
+			task := pipeline.JSONPathTask{
+				BaseTask: pipeline.NewBaseTask(0, "json", nil, nil, 0),
+				Path:     test.path,
+				Data:     test.data,
+				Reduce:   test.reduce,
+				Default:  test.defaultValue,
+				Lax:      test.lax,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+				if test.wantErrorContains != "" {
+					require.Contains(t, result.Error.Error(), test.wantErrorContains)
+				}
+				require.Nil(t, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				if d, ok := test.wantData.(decimal.Decimal); ok {
+					require.Equal(t, d.String(), result.Value.(decimal.Decimal).String())
+				} else {
+					require.Equal(t, test.wantData, result.Value)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// SubpipelineTask includes a stored Fragment in the pipeline. It is a
+// placeholder that the runner replaces with the fragment's tasks (see
+// ParseWithFragments), and only fails when run without being expanded.
+//
+// Params is a JSON object whose values are substituted for $(params.<key>)
+// in the fragment. Version pins a fragment version, the latest when the job
+// runs is used if unset.
+type SubpipelineTask struct {
+	BaseTask `mapstructure:",squash"`
+	Name     string `json:"name"`
+	Version  string `json:"version"`
+	Params   string `json:"params"`
+}
+
+var _ Task = (*SubpipelineTask)(nil)
+
+func (t *SubpipelineTask) Type() TaskType {
+	return TaskTypeSubpipeline
+}
+
+func (t *SubpipelineTask) Run(_ context.Context, _ logger.Logger, _ Vars, _ []Result) (Result, RunInfo) {
+	return Result{Error: errors.Wrapf(ErrSubpipelineNotExpanded, "fragment %s", t.Name)}, RunInfo{}
+}
@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
@@ -728,6 +729,43 @@ func (p *JSONPathParam) UnmarshalPipelineParam(val interface{}) error {
 	return nil
 }
 
+// DeadlineParam is either a block number or an RFC3339 time. Both are nil if it is not set.
+type DeadlineParam struct {
+	BlockNumber *int64
+	Time        *time.Time
+}
+
+func (p *DeadlineParam) UnmarshalPipelineParam(val interface{}) error {
+	switch v := val.(type) {
+	case time.Time:
+		*p = DeadlineParam{Time: &v}
+		return nil
+	case string:
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			*p = DeadlineParam{Time: &t}
+			return nil
+		}
+	case nil:
+		*p = DeadlineParam{}
+		return nil
+	}
+	var n MaybeUint64Param
+	if err := n.UnmarshalPipelineParam(val); err != nil {
+		return errors.Wrapf(ErrBadInput, "expected block number or RFC3339 time, got %v", val)
+	}
+	blockNumber, isSet := n.Uint64()
+	if !isSet {
+		*p = DeadlineParam{}
+		return nil
+	}
+	if blockNumber > math.MaxInt64 {
+		return errors.Wrapf(ErrBadInput, "block number %d out of range", blockNumber)
+	}
+	b := int64(blockNumber)
+	*p = DeadlineParam{BlockNumber: &b}
+	return nil
+}
+
 type MaybeBigIntParam struct {
 	n *big.Int
 }
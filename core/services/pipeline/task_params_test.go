@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -285,6 +286,45 @@ func TestMaybeUint64Param_UnmarshalPipelineParam(t *testing.T) {
 	}
 }
 
+func TestDeadlineParam_UnmarshalPipelineParam(t *testing.T) {
+	t.Parallel()
+
+	blockNumber := int64(123)
+	deadline := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected pipeline.DeadlineParam
+		err      error
+	}{
+		// positive
+		{"block number string", "123", pipeline.DeadlineParam{BlockNumber: &blockNumber}, nil},
+		{"block number int64", int64(123), pipeline.DeadlineParam{BlockNumber: &blockNumber}, nil},
+		{"block number float64", float64(123), pipeline.DeadlineParam{BlockNumber: &blockNumber}, nil},
+		{"RFC3339 time string", "2024-01-02T03:04:05Z", pipeline.DeadlineParam{Time: &deadline}, nil},
+		{"time", deadline, pipeline.DeadlineParam{Time: &deadline}, nil},
+		{"empty string", "", pipeline.DeadlineParam{}, nil},
+		{"nil", nil, pipeline.DeadlineParam{}, nil},
+		// negative
+		{"bool", true, pipeline.DeadlineParam{}, pipeline.ErrBadInput},
+		{"negative block number", int64(-123), pipeline.DeadlineParam{}, pipeline.ErrBadInput},
+		{"out of range block number", uint64(math.MaxUint64), pipeline.DeadlineParam{}, pipeline.ErrBadInput},
+		{"other string", "tomorrow", pipeline.DeadlineParam{}, pipeline.ErrBadInput},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var p pipeline.DeadlineParam
+			err := p.UnmarshalPipelineParam(test.input)
+			require.Equal(t, test.err, errors.Cause(err))
+			if err == nil {
+				require.Equal(t, test.expected, p)
+			}
+		})
+	}
+}
+
 func TestMaybeBigIntParam_UnmarshalPipelineParam(t *testing.T) {
 	t.Parallel()
 
@@ -599,6 +599,7 @@ func processDWDynamicFields(eventName string, dataWords map[string]read.DataWord
 func ConfirmationsFromConfig(values map[string]int) (map[primitives.ConfidenceLevel]evmtypes.Confirmations, error) {
 	mappings := map[primitives.ConfidenceLevel]evmtypes.Confirmations{
 		primitives.Unconfirmed: evmtypes.Unconfirmed,
+		types.Safe:             evmtypes.Safe,
 		primitives.Finalized:   evmtypes.Finalized,
 	}
 
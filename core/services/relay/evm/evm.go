@@ -742,7 +742,7 @@ type configTransmitterOpts struct {
 
 // newOnChainContractTransmitter creates a new contract transmitter.
 func newOnChainContractTransmitter(ctx context.Context, lggr logger.Logger, rargs commontypes.RelayArgs, ethKeystore keystore.Eth, configWatcher *configWatcher, opts configTransmitterOpts, transmissionContractABI abi.ABI, ocrTransmitterOpts ...OCRTransmitterOption) (*contractTransmitter, error) {
-	transmitter, err := generateTransmitterFrom(ctx, rargs, ethKeystore, configWatcher, opts)
+	transmitter, err := generateTransmitterFrom(ctx, rargs, ethKeystore, configWatcher, opts, transmissionContractABI)
 	if err != nil {
 		return nil, err
 	}
@@ -759,7 +759,12 @@ func newOnChainContractTransmitter(ctx context.Context, lggr logger.Logger, rarg
 	)
 }
 
-func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, ethKeystore keystore.Eth, configWatcher *configWatcher, opts configTransmitterOpts) (Transmitter, error) {
+// sendingKeySelector assigns the transmissions of a transmitter to its sending keys.
+type sendingKeySelector interface {
+	GetRoundRobinAddress(ctx context.Context, chainID *big.Int, addresses ...common.Address) (address common.Address, err error)
+}
+
+func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, ethKeystore keystore.Eth, configWatcher *configWatcher, opts configTransmitterOpts, transmissionContractABI abi.ABI) (Transmitter, error) {
 	var relayConfig types.RelayConfig
 	if err := json.Unmarshal(rargs.RelayConfig, &relayConfig); err != nil {
 		return nil, err
@@ -796,7 +801,17 @@ func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, e
 
 	var checker txm.TransmitCheckerSpec
 	if relayConfig.SimulateTransactions {
-		checker.CheckerType = txm.TransmitCheckerTypeSimulate
+		// Decode the custom errors of the contract in the reverts of simulated transmissions
+		var err error
+		if checker, err = txm.NewSimulateCheckerSpec(transmissionContractABI); err != nil {
+			return nil, pkgerrors.Wrap(err, "failed to create simulate checker")
+		}
+	}
+
+	// The key pool of the chain, if enabled, assigns the transmissions to the least busy sending key
+	var keySelector sendingKeySelector = ethKeystore
+	if pool := configWatcher.chain.KeyPool(); pool != nil {
+		keySelector = pool
 	}
 
 	gasLimit := configWatcher.chain.Config().EVM().GasEstimator().LimitDefault()
@@ -822,7 +837,7 @@ func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, e
 			strategy,
 			checker,
 			configWatcher.chain.ID(),
-			ethKeystore,
+			keySelector,
 			relayConfig.DualTransmissionConfig,
 		)
 	case commontypes.CCIPExecution:
@@ -834,7 +849,7 @@ func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, e
 			strategy,
 			checker,
 			configWatcher.chain.ID(),
-			ethKeystore,
+			keySelector,
 		)
 	default:
 		transmitter, err = ocrcommon.NewTransmitter(
@@ -845,7 +860,7 @@ func generateTransmitterFrom(ctx context.Context, rargs commontypes.RelayArgs, e
 			strategy,
 			checker,
 			configWatcher.chain.ID(),
-			ethKeystore,
+			keySelector,
 		)
 	}
 	if err != nil {
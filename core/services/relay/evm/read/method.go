@@ -219,6 +219,15 @@ func (b *MethodBinding) blockAndConfirmationsFromConfidence(ctx context.Context,
 		return nil, 0, err
 	}
 
+	if confirmations == evmtypes.Safe {
+		safe, err := b.ht.LatestSafeBlock(ctx)
+		if err != nil {
+			return nil, 0, fmt.Errorf("%w: head tracker: %w", commontypes.ErrInternal, err)
+		}
+
+		return safe, confirmations, nil
+	}
+
 	latest, finalized, err := b.ht.LatestAndFinalizedBlock(ctx)
 	if err != nil {
 		return nil, 0, fmt.Errorf("%w: head tracker: %w", commontypes.ErrInternal, err)
@@ -16,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/codec"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -23,6 +24,10 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
+// Safe is the confidence level of reads at the latest safe block, between primitives.Unconfirmed and
+// primitives.Finalized. On chains without a safe block tag, it is the latest finalized block.
+const Safe primitives.ConfidenceLevel = "safe"
+
 type ChainWriterConfig struct {
 	Contracts   map[string]*ContractConfig
 	MaxGasPrice *assets.Wei
@@ -266,6 +266,15 @@ func (lsn *listenerV2) getLatestHead() uint64 {
 	return lsn.latestHeadNumber
 }
 
+// keySelector returns the key pool of the chain if enabled, which assigns the fulfillments to the least busy of the
+// fromAddresses, and the keystore otherwise.
+func (lsn *listenerV2) keySelector() vrfcommon.GethKeyStore {
+	if pool := lsn.chain.KeyPool(); pool != nil {
+		return pool
+	}
+	return lsn.gethks
+}
+
 // Close complies with job.Service
 func (lsn *listenerV2) Close() error {
 	return lsn.StopOnce("VRFListenerV2", func() error {
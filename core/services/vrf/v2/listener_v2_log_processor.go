@@ -387,7 +387,7 @@ func (lsn *listenerV2) processRequestsPerSubBatchHelper(
 				"blockHash", p.req.req.Raw().BlockHash,
 			)
 			fromAddresses := lsn.fromAddresses()
-			fromAddress, err := lsn.gethks.GetRoundRobinAddress(ctx, lsn.chainID, fromAddresses...)
+			fromAddress, err := lsn.keySelector().GetRoundRobinAddress(ctx, lsn.chainID, fromAddresses...)
 			if err != nil {
 				l.Errorw("Couldn't get next from address", "err", err)
 				continue
@@ -600,11 +600,12 @@ func (lsn *listenerV2) enqueueForceFulfillment(
 	subID := p.req.req.SubID()
 	requestTxHash := p.req.req.Raw().TxHash
 	return lsn.chain.TxManager().CreateTransaction(ctx, txmgr.TxRequest{
-		FromAddress:    fromAddress,
-		ToAddress:      lsn.vrfOwner.Address(),
-		EncodedPayload: txData,
-		FeeLimit:       estimateGasLimit,
-		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+		FromAddress:       fromAddress,
+		ToAddress:         lsn.vrfOwner.Address(),
+		EncodedPayload:    txData,
+		FeeLimit:          estimateGasLimit,
+		Strategy:          txmgrcommon.NewSendEveryStrategy(),
+		PrivateSubmission: lsn.job.VRFSpec.PrivateSubmission,
 		Meta: &txmgr.TxMeta{
 			RequestID:     &requestID,
 			SubID:         ptr(subID.Uint64()),
@@ -715,7 +716,7 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 				"blockNumber", p.req.req.Raw().BlockNumber,
 				"blockHash", p.req.req.Raw().BlockHash,
 			)
-			fromAddress, err := lsn.gethks.GetRoundRobinAddress(ctx, lsn.chainID, fromAddresses...)
+			fromAddress, err := lsn.keySelector().GetRoundRobinAddress(ctx, lsn.chainID, fromAddresses...)
 			if err != nil {
 				l.Errorw("Couldn't get next from address", "err", err)
 				continue
@@ -821,7 +822,8 @@ func (lsn *listenerV2) processRequestsPerSubHelper(
 						GlobalSubID:   txMetaGlobalSubID,
 						RequestTxHash: &requestTxHash,
 					},
-					Strategy: txmgrcommon.NewSendEveryStrategy(),
+					Strategy:          txmgrcommon.NewSendEveryStrategy(),
+					PrivateSubmission: lsn.job.VRFSpec.PrivateSubmission,
 					Checker: txmgr.TransmitCheckerSpec{
 						CheckerType:           lsn.transmitCheckerType(),
 						VRFCoordinatorAddress: &coordinatorAddress,
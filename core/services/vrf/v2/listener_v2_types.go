@@ -237,11 +237,12 @@ func (lsn *listenerV2) processBatch(
 			reqIDHashes = append(reqIDHashes, common.BytesToHash(reqID.Bytes()))
 		}
 		ethTX, err = lsn.chain.TxManager().CreateTransaction(ctx, txmgr.TxRequest{
-			FromAddress:    fromAddress,
-			ToAddress:      lsn.batchCoordinator.Address(),
-			EncodedPayload: payload,
-			FeeLimit:       uint64(totalGasLimitBumped),
-			Strategy:       txmgrcommon.NewSendEveryStrategy(),
+			FromAddress:       fromAddress,
+			ToAddress:         lsn.batchCoordinator.Address(),
+			EncodedPayload:    payload,
+			FeeLimit:          uint64(totalGasLimitBumped),
+			Strategy:          txmgrcommon.NewSendEveryStrategy(),
+			PrivateSubmission: lsn.job.VRFSpec.PrivateSubmission,
 			Meta: &txmgr.TxMeta{
 				RequestIDs:      reqIDHashes,
 				MaxLink:         &maxLink,
@@ -652,7 +652,7 @@ func (lsn *listenerV2) enqueueForceFulfillmentForRevertedTxn(
 	reqCommitment := revertedTxn.Commitment
 
 	fromAddresses := lsn.fromAddresses()
-	fromAddress, err := lsn.gethks.GetRoundRobinAddress(ctx, lsn.chainID, fromAddresses...)
+	fromAddress, err := lsn.keySelector().GetRoundRobinAddress(ctx, lsn.chainID, fromAddresses...)
 	if err != nil {
 		return txmgr.Tx{}, errors.Wrap(err, "failed_to_get_vrf_listener_from_address")
 	}
@@ -701,11 +701,12 @@ func (lsn *listenerV2) enqueueForceFulfillmentForRevertedTxn(
 	forceFulfiled := true
 	forceFulfillmentAttempt := revertedTxn.DBReceipt.ForceFulfillmentAttempt + 1
 	etx, err = lsn.chain.TxManager().CreateTransaction(ctx, txmgr.TxRequest{
-		FromAddress:    fromAddress,
-		ToAddress:      lsn.vrfOwner.Address(),
-		EncodedPayload: txData,
-		FeeLimit:       estimateGasLimit,
-		Strategy:       txmgrcommon.NewSendEveryStrategy(),
+		FromAddress:       fromAddress,
+		ToAddress:         lsn.vrfOwner.Address(),
+		EncodedPayload:    txData,
+		FeeLimit:          estimateGasLimit,
+		Strategy:          txmgrcommon.NewSendEveryStrategy(),
+		PrivateSubmission: lsn.job.VRFSpec.PrivateSubmission,
 		Meta: &txmgr.TxMeta{
 			RequestID:               &reqID,
 			SubID:                   &revertedTxn.DBReceipt.SubID,
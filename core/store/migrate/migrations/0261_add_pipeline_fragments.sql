@@ -0,0 +1,15 @@
+-- +goose Up
+-- Named DOT fragments that pipelines include with the subpipeline task. Every
+-- change to a fragment is stored as a new version.
+CREATE TABLE pipeline_fragments (
+    id BIGSERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    version INT NOT NULL,
+    source TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CONSTRAINT pipeline_fragments_name_version_key UNIQUE (name, version),
+    CONSTRAINT chk_pipeline_fragments_version CHECK (version > 0)
+);
+
+-- +goose Down
+DROP TABLE pipeline_fragments;
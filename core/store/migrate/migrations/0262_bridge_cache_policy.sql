@@ -0,0 +1,26 @@
+-- +goose Up
+-- Per-bridge cache policy, durations are stored in nanoseconds like other
+-- models.Interval columns.
+ALTER TABLE bridge_types
+    ADD COLUMN cache_ttl BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN cache_stale_while_revalidate BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN cache_stale_if_error BIGINT NOT NULL DEFAULT 0;
+
+-- Responses of bridges with a cache policy, shared by all tasks that send the
+-- same request body to the same bridge.
+CREATE TABLE bridge_response_cache (
+    bridge_name TEXT NOT NULL REFERENCES bridge_types(name) ON DELETE CASCADE DEFERRABLE,
+    request_hash BYTEA NOT NULL,
+    value BYTEA NOT NULL,
+    finished_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CONSTRAINT bridge_response_cache_pkey PRIMARY KEY (bridge_name, request_hash)
+);
+
+CREATE INDEX idx_bridge_response_cache_finished_at ON bridge_response_cache USING btree (finished_at);
+
+-- +goose Down
+DROP TABLE bridge_response_cache;
+ALTER TABLE bridge_types
+    DROP COLUMN cache_ttl,
+    DROP COLUMN cache_stale_while_revalidate,
+    DROP COLUMN cache_stale_if_error;
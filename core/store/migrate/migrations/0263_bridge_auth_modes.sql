@@ -0,0 +1,22 @@
+-- +goose Up
+-- How requests to a bridge are authenticated, on top of the outgoing token.
+-- hmac_secret and tls_client_key are encrypted by the node with a key derived
+-- from the keystore password.
+ALTER TABLE bridge_types
+    ADD COLUMN auth_mode TEXT NOT NULL DEFAULT 'token',
+    ADD COLUMN hmac_secret TEXT NOT NULL DEFAULT '',
+    ADD COLUMN tls_client_cert TEXT NOT NULL DEFAULT '',
+    ADD COLUMN tls_client_key TEXT NOT NULL DEFAULT '',
+    ADD COLUMN tls_root_ca TEXT NOT NULL DEFAULT '',
+    ADD COLUMN verify_responses BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD CONSTRAINT chk_bridge_types_auth_mode CHECK (auth_mode IN ('token', 'hmac', 'mtls'));
+
+-- +goose Down
+ALTER TABLE bridge_types
+    DROP CONSTRAINT chk_bridge_types_auth_mode,
+    DROP COLUMN auth_mode,
+    DROP COLUMN hmac_secret,
+    DROP COLUMN tls_client_cert,
+    DROP COLUMN tls_client_key,
+    DROP COLUMN tls_root_ca,
+    DROP COLUMN verify_responses;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- Progress of the parallel backfills of the logs of a filter, so that they resume after a restart.
+-- Every block before next_block has been backfilled, later blocks may have been backfilled out of order.
+CREATE TABLE evm.log_poller_backfills (
+    evm_chain_id NUMERIC(78, 0) NOT NULL,
+    filter_name TEXT NOT NULL,
+    from_block BIGINT NOT NULL CHECK (from_block > 0),
+    to_block BIGINT NOT NULL CHECK (to_block >= from_block),
+    next_block BIGINT NOT NULL CHECK (next_block >= from_block AND next_block <= to_block + 1),
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    completed_at TIMESTAMPTZ,
+    PRIMARY KEY (evm_chain_id, filter_name)
+);
+
+-- +goose Down
+DROP TABLE evm.log_poller_backfills;
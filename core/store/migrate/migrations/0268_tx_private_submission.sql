@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE evm.txes ADD COLUMN private_submission boolean NOT NULL DEFAULT false;
+CREATE INDEX idx_txes_private_submission_unconfirmed ON evm.txes(evm_chain_id, id) WHERE private_submission AND state = 'unconfirmed'::evm.txes_state;
+
+-- +goose Down
+DROP INDEX IF EXISTS evm.idx_txes_private_submission_unconfirmed;
+ALTER TABLE evm.txes DROP COLUMN private_submission;
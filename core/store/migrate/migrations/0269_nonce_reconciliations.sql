@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE evm.nonce_reconciliations (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id NUMERIC(78,0) NOT NULL,
+    address BYTEA NOT NULL,
+    action TEXT NOT NULL CHECK (action IN ('resync', 'fill_gap')),
+    nonce BIGINT NOT NULL,
+    local_nonce BIGINT NOT NULL,
+    mined_nonce BIGINT NOT NULL,
+    pending_nonce BIGINT NOT NULL,
+    tx_hash BYTEA,
+    created_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX idx_nonce_reconciliations_created_at ON evm.nonce_reconciliations(created_at DESC, id DESC);
+
+-- +goose Down
+DROP TABLE evm.nonce_reconciliations;
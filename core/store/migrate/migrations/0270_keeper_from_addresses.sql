@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE keeper_specs ADD COLUMN from_addresses bytea[] DEFAULT '{}' NOT NULL;
+
+-- +goose Down
+ALTER TABLE keeper_specs DROP COLUMN from_addresses;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE evm.key_top_ups (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id NUMERIC(78,0) NOT NULL,
+    treasury_address BYTEA NOT NULL,
+    address BYTEA NOT NULL,
+    balance NUMERIC(78,0) NOT NULL,
+    amount NUMERIC(78,0) NOT NULL,
+    tx_id BIGINT REFERENCES evm.txes(id) ON DELETE SET NULL,
+    dry_run BOOLEAN NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX idx_key_top_ups_chain_treasury_created_at ON evm.key_top_ups(evm_chain_id, treasury_address, created_at);
+CREATE INDEX idx_key_top_ups_chain_address ON evm.key_top_ups(evm_chain_id, address);
+
+-- +goose Down
+DROP TABLE evm.key_top_ups;
@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE evm.reorgs (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id NUMERIC(78,0) NOT NULL,
+    depth BIGINT NOT NULL,
+    old_head_hash BYTEA NOT NULL,
+    new_head_hash BYTEA NOT NULL,
+    from_block BIGINT NOT NULL,
+    to_block BIGINT NOT NULL,
+    detected_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX idx_reorgs_evm_chain_id_detected_at ON evm.reorgs(evm_chain_id, detected_at DESC, id DESC);
+CREATE INDEX idx_reorgs_detected_at ON evm.reorgs(detected_at DESC, id DESC);
+
+-- +goose Down
+DROP TABLE evm.reorgs;
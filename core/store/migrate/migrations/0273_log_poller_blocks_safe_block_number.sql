@@ -0,0 +1,13 @@
+-- +goose Up
+-- safe_block_number is the latest safe block when the block was saved. Blocks saved before this migration, or on chains
+-- without a safe tag, fall back to finalized_block_number, as a finalized block is safe.
+ALTER TABLE evm.log_poller_blocks
+    ADD COLUMN safe_block_number
+        bigint not null
+        default 0
+        check (safe_block_number >= 0);
+
+
+-- +goose Down
+ALTER TABLE evm.log_poller_blocks
+    DROP COLUMN safe_block_number;
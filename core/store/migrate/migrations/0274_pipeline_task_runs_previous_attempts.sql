@@ -0,0 +1,8 @@
+-- +goose Up
+-- previous_attempts holds the failed attempts of a retried task run, so that they survive the resumption of the run.
+ALTER TABLE pipeline_task_runs
+    ADD COLUMN previous_attempts jsonb;
+
+-- +goose Down
+ALTER TABLE pipeline_task_runs
+    DROP COLUMN previous_attempts;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- fragments holds the pipeline fragment versions that the subpipeline tasks of a spec were resolved to when the spec was
+-- created. Runs look the fragments up again, and only expand the subpipelines from these when the lookup fails.
+ALTER TABLE pipeline_specs
+    ADD COLUMN fragments jsonb;
+
+-- +goose Down
+ALTER TABLE pipeline_specs
+    DROP COLUMN fragments;
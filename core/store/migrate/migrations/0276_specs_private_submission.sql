@@ -0,0 +1,13 @@
+-- +goose Up
+-- private_submission makes keeper and VRF jobs send their transactions to the private relay of their chain instead of
+-- the public mempool.
+ALTER TABLE keeper_specs
+    ADD COLUMN private_submission boolean NOT NULL DEFAULT FALSE;
+ALTER TABLE vrf_specs
+    ADD COLUMN private_submission boolean NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE keeper_specs
+    DROP COLUMN private_submission;
+ALTER TABLE vrf_specs
+    DROP COLUMN private_submission;
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/jackc/pgconn"
+	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
@@ -50,6 +51,15 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest) error {
 		bt.MinimumContractPayment.Cmp(assets.NewLinkFromJuels(0)) < 0 {
 		fe.Add("MinimumContractPayment must be positive")
 	}
+	if bt.CacheTTL < 0 || bt.CacheStaleWhileRevalidate < 0 || bt.CacheStaleIfError < 0 {
+		fe.Add("CacheTTL, CacheStaleWhileRevalidate and CacheStaleIfError must not be negative")
+	}
+	if bt.CacheStaleWhileRevalidate > 0 && bt.CacheTTL == 0 {
+		fe.Add("CacheStaleWhileRevalidate requires a CacheTTL")
+	}
+	for _, err := range multierr.Errors(bt.ValidateAuth()) {
+		fe.Add(err.Error())
+	}
 	return fe.CoerceEmptyToNil()
 }
 
@@ -104,6 +114,7 @@ func (btc *BridgeTypesController) Create(c *gin.Context) {
 		"bridgeConfirmations":          bta.Confirmations,
 		"bridgeMinimumContractPayment": bta.MinimumContractPayment,
 		"bridgeURL":                    bta.URL,
+		"bridgeAuthMode":               bt.AuthMode,
 	})
 
 	jsonAPIResponse(c, resource, "bridge")
@@ -114,9 +125,12 @@ func (btc *BridgeTypesController) Index(c *gin.Context, size, page, offset int)
 	ctx := c.Request.Context()
 	bridges, count, err := btc.App.BridgeORM().BridgeTypes(ctx, offset, size)
 
+	circuits := btc.App.BridgeCircuits()
 	var resources []presenters.BridgeResource
 	for _, bridge := range bridges {
-		resources = append(resources, *presenters.NewBridgeResource(bridge))
+		resource := presenters.NewBridgeResource(bridge)
+		resource.Circuit = presenters.NewBridgeCircuitResource(circuits.Status(bridge.Name))
+		resources = append(resources, *resource)
 	}
 
 	paginatedResponse(c, "Bridges", size, page, resources, count, err)
@@ -143,7 +157,9 @@ func (btc *BridgeTypesController) Show(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
+	resource := presenters.NewBridgeResource(bt)
+	resource.Circuit = presenters.NewBridgeCircuitResource(btc.App.BridgeCircuits().Status(bt.Name))
+	jsonAPIResponse(c, resource, "bridge")
 }
 
 // Update can change the restricted attributes for a bridge
@@ -173,6 +189,7 @@ func (btc *BridgeTypesController) Update(c *gin.Context) {
 		jsonAPIError(c, http.StatusUnprocessableEntity, err)
 		return
 	}
+	btr.KeepAuth(bt)
 	if err := ValidateBridgeType(btr); err != nil {
 		jsonAPIError(c, http.StatusBadRequest, err)
 		return
@@ -181,12 +198,15 @@ func (btc *BridgeTypesController) Update(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	// the bridge may have been fixed, let the next call through
+	btc.App.BridgeCircuits().Reset(bt.Name)
 
 	btc.App.GetAuditLogger().Audit(audit.BridgeUpdated, map[string]interface{}{
 		"bridgeName":                   bt.Name,
 		"bridgeConfirmations":          bt.Confirmations,
 		"bridgeMinimumContractPayment": bt.MinimumContractPayment,
 		"bridgeURL":                    bt.URL,
+		"bridgeAuthMode":               bt.AuthMode,
 	})
 
 	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
@@ -226,6 +246,7 @@ func (btc *BridgeTypesController) Destroy(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("failed to delete bridge: %+v", err))
 		return
 	}
+	btc.App.BridgeCircuits().Reset(bt.Name)
 
 	btc.App.GetAuditLogger().Audit(audit.BridgeDeleted, map[string]interface{}{"name": name})
 
@@ -2,6 +2,7 @@ package web_test
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -100,6 +101,24 @@ func TestValidateBridgeType(t *testing.T) {
 				URL:  cltest.WebURL(t, "https://denergy.eth"),
 			},
 			nil,
+		},
+		{
+			"hmac auth without secret",
+			bridges.BridgeTypeRequest{
+				Name:     "signedadapter",
+				URL:      cltest.WebURL(t, "https://denergy.eth"),
+				AuthMode: bridges.AuthModeHMAC,
+			},
+			models.NewJSONAPIErrorsWith("HMACSecret must be at least 16 characters"),
+		},
+		{
+			"verified responses without hmac auth",
+			bridges.BridgeTypeRequest{
+				Name:            "signedadapter",
+				URL:             cltest.WebURL(t, "https://denergy.eth"),
+				VerifyResponses: ptr(true),
+			},
+			models.NewJSONAPIErrorsWith("VerifyResponses requires AuthMode hmac"),
 		}}
 
 	for _, test := range tests {
@@ -268,6 +287,51 @@ func TestBridgeTypesController_Update_Success(t *testing.T) {
 	assert.Equal(t, cltest.WebURL(t, "http://yourbridge"), ubt.URL)
 }
 
+func TestBridgeTypesController_Update_KeepsAuth(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	const secret = "0123456789abcdef0123456789abcdef"
+	bridgeName := testutils.RandomizeName("signed")
+	bt := &bridges.BridgeType{
+		Name:            bridges.MustParseBridgeName(bridgeName),
+		URL:             cltest.WebURL(t, "http://mybridge"),
+		AuthMode:        bridges.AuthModeHMAC,
+		HMACSecret:      secret,
+		VerifyResponses: true,
+	}
+	ctx := testutils.Context(t)
+	require.NoError(t, app.BridgeORM().CreateBridgeType(ctx, bt))
+
+	// the auth settings are kept when the update omits them
+	body := fmt.Sprintf(`{"name": "%s","url":"http://yourbridge"}`, bridgeName)
+	resp, cleanup := client.Patch("/v2/bridge_types/"+bridgeName, bytes.NewBufferString(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	ubt, err := app.BridgeORM().FindBridge(ctx, bt.Name)
+	require.NoError(t, err)
+	assert.Equal(t, cltest.WebURL(t, "http://yourbridge"), ubt.URL)
+	assert.Equal(t, bridges.AuthModeHMAC, ubt.AuthMode)
+	assert.Equal(t, secret, ubt.HMACSecret)
+	assert.True(t, ubt.VerifyResponses)
+
+	// and dropped when the auth mode is changed
+	body = fmt.Sprintf(`{"name": "%s","url":"http://yourbridge","authMode":"token"}`, bridgeName)
+	resp, cleanup = client.Patch("/v2/bridge_types/"+bridgeName, bytes.NewBufferString(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	ubt, err = app.BridgeORM().FindBridge(ctx, bt.Name)
+	require.NoError(t, err)
+	assert.Equal(t, bridges.AuthModeToken, ubt.AuthMode)
+	assert.Empty(t, ubt.HMACSecret)
+	assert.False(t, ubt.VerifyResponses)
+}
+
 func TestBridgeController_Show(t *testing.T) {
 	t.Parallel()
 
@@ -283,6 +347,9 @@ func TestBridgeController_Show(t *testing.T) {
 	}
 	ctx := testutils.Context(t)
 	require.NoError(t, app.BridgeORM().CreateBridgeType(ctx, bt))
+	for i := 0; i < bridges.CircuitFailureThreshold; i++ {
+		app.BridgeCircuits().RecordResult(bt.Name, errors.New("bridge down"))
+	}
 
 	resp, cleanup := client.Get("/v2/bridge_types/" + bt.Name.String())
 	t.Cleanup(cleanup)
@@ -293,6 +360,9 @@ func TestBridgeController_Show(t *testing.T) {
 	assert.Equal(t, bt.Name.String(), resource.Name, "should have the same name")
 	assert.Equal(t, bt.URL.String(), resource.URL, "should have the same URL")
 	assert.Equal(t, bt.Confirmations, resource.Confirmations, "should have the same Confirmations")
+	require.NotNil(t, resource.Circuit)
+	assert.Equal(t, bridges.CircuitOpen, resource.Circuit.State, "should have the state of the circuit")
+	assert.Equal(t, "bridge down", resource.Circuit.LastError)
 
 	resp, cleanup = client.Get("/v2/bridge_types/nosuchbridge")
 	t.Cleanup(cleanup)
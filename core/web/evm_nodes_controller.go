@@ -1,6 +1,8 @@
 package web
 
 import (
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
@@ -10,5 +12,25 @@ func NewEVMNodesController(app chainlink.Application) NodesController {
 	scopedNodeStatuser := NewNetworkScopedNodeStatuser(app.GetRelayers(), relay.NetworkEVM)
 
 	return newNodesController[presenters.EVMNodeResource](
-		scopedNodeStatuser, ErrEVMNotEnabled, presenters.NewEVMNodeResource, app.GetAuditLogger())
+		scopedNodeStatuser, ErrEVMNotEnabled, newEVMNodeResource(app), app.GetAuditLogger())
+}
+
+// newEVMNodeResource returns a constructor of EVMNodeResources, which includes the score of the nodes of the chains
+// running in app.
+func newEVMNodeResource(app chainlink.Application) func(types.NodeStatus) presenters.EVMNodeResource {
+	return func(status types.NodeStatus) presenters.EVMNodeResource {
+		r := presenters.NewEVMNodeResource(status)
+		chains := app.GetRelayers().LegacyEVMChains()
+		if chains == nil {
+			return r
+		}
+		chain, err := chains.Get(status.ChainID)
+		if err != nil {
+			return r
+		}
+		if score, ok := chain.Client().NodeScores()[status.Name]; ok {
+			r.Score = presenters.NewEVMNodeScore(score)
+		}
+		return r
+	}
 }
@@ -0,0 +1,26 @@
+package web
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// NonceReconciliationsController displays the actions taken to reconcile the nonces of the EVM keys with the chain.
+type NonceReconciliationsController struct {
+	App chainlink.Application
+}
+
+// Index returns paginated nonce reconciliations
+// Example:
+//
+//	"<application>/v2/transactions/evm/reconciliations"
+func (nrc *NonceReconciliationsController) Index(c *gin.Context, size, page, offset int) {
+	rs, count, err := nrc.App.TxmStorageService().NonceReconciliations(c, offset, size)
+	resources := make([]presenters.NonceReconciliationResource, len(rs))
+	for i, r := range rs {
+		resources[i] = presenters.NewNonceReconciliationResource(r)
+	}
+	paginatedResponse(c, "evm_nonce_reconciliations", size, page, resources, count, err)
+}
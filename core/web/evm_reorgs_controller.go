@@ -0,0 +1,39 @@
+package web
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// ReorgsController displays the reorgs of the canonical chains detected by the head trackers of the EVM chains.
+type ReorgsController struct {
+	App chainlink.Application
+}
+
+// Index returns paginated reorgs, of the chain given by the optional evmChainID query parameter
+// Example:
+//
+//	"<application>/v2/reorgs?evmChainID=1"
+func (rc *ReorgsController) Index(c *gin.Context, size, page, offset int) {
+	var chainID *big.Int
+	if s := c.Query("evmChainID"); s != "" {
+		var ok bool
+		chainID, ok = new(big.Int).SetString(s, 10)
+		if !ok {
+			jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("invalid evmChainID: %s", s))
+			return
+		}
+	}
+	reorgs, count, err := rc.App.ReorgORM().Reorgs(c, chainID, offset, size)
+	resources := make([]presenters.ReorgResource, len(reorgs))
+	for i, r := range reorgs {
+		resources[i] = presenters.NewReorgResource(r)
+	}
+	paginatedResponse(c, "evm_reorgs", size, page, resources, count, err)
+}
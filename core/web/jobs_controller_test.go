@@ -37,6 +37,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/tomlutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
@@ -545,6 +546,51 @@ func TestJobsController_FailToCreate_EmptyJsonAttribute(t *testing.T) {
 	require.Contains(t, string(b), "syntax is not supported. Please use \\\"{}\\\" instead")
 }
 
+func TestJobsController_Simulate(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(nil)
+
+	nameAndExternalJobID := uuid.New()
+	body, err := json.Marshal(web.SimulateJobRequest{
+		TOML: fmt.Sprintf(`
+type            = "webhook"
+schemaVersion   = 1
+externalJobID   = "%s"
+name            = "%s"
+observationSource   = """
+    fetch          [type=http method=GET url="https://example.com/price"];
+    parse_request  [type=jsonparse path="data,result"];
+    multiply       [type=multiply times="100"];
+
+    fetch -> parse_request -> multiply;
+"""
+`, nameAndExternalJobID, nameAndExternalJobID),
+		Fixtures: pipeline.SimulationFixtures{
+			URLs: map[string]pipeline.SimulationFixture{
+				"https://example.com/price": {Value: `{"data":{"result":1.5}}`},
+			},
+		},
+	})
+	require.NoError(t, err)
+	response, cleanup := client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+	defer cleanup()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+
+	run := presenters.PipelineRunResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &run))
+	require.Len(t, run.TaskRuns, 3)
+	require.Empty(t, run.AllErrors)
+	require.Equal(t, []*string{ptr("150")}, run.Outputs)
+
+	// nothing is persisted
+	jobs, count, err := app.JobORM().FindJobs(testutils.Context(t), 0, 10)
+	require.NoError(t, err)
+	require.Zero(t, count)
+	require.Empty(t, jobs)
+}
+
 func TestJobsController_Index_HappyPath(t *testing.T) {
 	_, client, ocrJobSpecFromFile, _, ereJobSpecFromFile, _ := setupJobSpecsControllerTestsWithJobs(t)
 
@@ -0,0 +1,127 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+type LogPollerBackfillsController struct {
+	App chainlink.Application
+}
+
+// Index lists the progress of the LogPoller backfills of a chain
+// Example:
+//
+//	"<application>/v2/log_poller/backfills?evmChainID=1"
+func (lbc *LogPollerBackfillsController) Index(c *gin.Context) {
+	chain, err := getChain(lbc.App.GetRelayers().LegacyEVMChains(), c.Query("evmChainID"))
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) || errors.Is(err, ErrEmptyChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	backfills, err := chain.LogPoller().Backfills(c.Request.Context())
+	if err != nil {
+		if errors.Is(err, logpoller.ErrDisabled) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	resources := make([]presenters.LogPollerBackfillResource, 0, len(backfills))
+	for _, backfill := range backfills {
+		resources = append(resources, presenters.NewLogPollerBackfillResource(backfill))
+	}
+	jsonAPIResponse(c, resources, "log_poller_backfills")
+}
+
+// StartLogPollerBackfillRequest is a request to backfill the logs of a LogPoller filter from a block.
+type StartLogPollerBackfillRequest struct {
+	FilterName string `json:"filterName"`
+	FromBlock  int64  `json:"fromBlock"`
+}
+
+// Create starts the backfill of the logs of a LogPoller filter of a chain, which resumes after a restart
+// Example:
+//
+//	"<application>/v2/log_poller/backfills?evmChainID=1"
+func (lbc *LogPollerBackfillsController) Create(c *gin.Context) {
+	request := &StartLogPollerBackfillRequest{}
+	if err := c.ShouldBindJSON(request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.FilterName == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("missing 'filterName'"))
+		return
+	}
+	if request.FromBlock <= 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("block number must be positive: %v", request.FromBlock))
+		return
+	}
+
+	chain, err := getChain(lbc.App.GetRelayers().LegacyEVMChains(), c.Query("evmChainID"))
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) || errors.Is(err, ErrEmptyChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	if err = chain.LogPoller().BackfillFilter(c.Request.Context(), request.FilterName, request.FromBlock); err != nil {
+		switch {
+		case errors.Is(err, logpoller.ErrDisabled), errors.Is(err, logpoller.ErrFilterNotRegistered):
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		case errors.Is(err, logpoller.ErrBackfillInProgress):
+			jsonAPIError(c, http.StatusConflict, err)
+		default:
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	response := StartLogPollerBackfillResponse{
+		Message:    "Backfill started",
+		EVMChainID: big.New(chain.ID()),
+		FilterName: request.FilterName,
+	}
+	jsonAPIResponseWithStatus(c, &response, "response", http.StatusAccepted)
+}
+
+type StartLogPollerBackfillResponse struct {
+	Message    string   `json:"message"`
+	EVMChainID *big.Big `json:"evmChainID"`
+	FilterName string   `json:"filterName"`
+}
+
+// GetID returns the jsonapi ID.
+func (s StartLogPollerBackfillResponse) GetID() string {
+	return s.FilterName
+}
+
+// GetName returns the collection name for jsonapi.
+func (StartLogPollerBackfillResponse) GetName() string {
+	return "log_poller_backfills"
+}
+
+// SetID is used to conform to the UnmarshallIdentifier interface for
+// deserializing from jsonapi documents.
+func (*StartLogPollerBackfillResponse) SetID(string) error {
+	return nil
+}
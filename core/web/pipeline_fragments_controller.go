@@ -0,0 +1,145 @@
+package web
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// CreatePipelineFragmentRequest is the request body to store a new version of a fragment.
+type CreatePipelineFragmentRequest struct {
+	Name   string `json:"name"`
+	Source string `json:"source"`
+}
+
+// PipelineFragmentsController manages the pipeline fragments that jobs include
+// with subpipeline tasks.
+type PipelineFragmentsController struct {
+	App chainlink.Application
+}
+
+// Index lists the latest version of every fragment.
+// Example:
+// "GET <application>/pipeline/fragments"
+func (pfc *PipelineFragmentsController) Index(c *gin.Context) {
+	fragments, err := pfc.App.PipelineORM().FindFragments(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineFragmentResources(fragments), "pipelineFragments")
+}
+
+// Show returns a fragment, the latest version unless the version query param is set.
+// Example:
+// "GET <application>/pipeline/fragments/:Name?version=2"
+func (pfc *PipelineFragmentsController) Show(c *gin.Context) {
+	var version int64
+	if v := c.Query("version"); v != "" {
+		var err error
+		version, err = strconv.ParseInt(v, 10, 32)
+		if err != nil || version < 1 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("invalid version %q", v))
+			return
+		}
+	}
+
+	fragment, err := pfc.App.PipelineORM().FindFragment(c.Request.Context(), c.Param("Name"), int32(version))
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline fragment not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineFragmentResource(fragment), "pipelineFragment")
+}
+
+// Create stores a fragment. If a fragment with the same name exists, a new
+// version is created, which all jobs that do not pin a version pick up on
+// their next run.
+// Example:
+// "POST <application>/pipeline/fragments"
+func (pfc *PipelineFragmentsController) Create(c *gin.Context) {
+	ctx := c.Request.Context()
+	request := CreatePipelineFragmentRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	orm := pfc.App.PipelineORM()
+	if err := pipeline.ValidateFragment(ctx, pipeline.Fragment{Name: request.Name, Source: request.Source}, orm); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	fragment, err := orm.CreateFragment(ctx, request.Name, request.Source)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	pfc.App.GetAuditLogger().Audit(audit.PipelineFragmentCreated, map[string]interface{}{
+		"name":    fragment.Name,
+		"version": fragment.Version,
+	})
+
+	jsonAPIResponse(c, presenters.NewPipelineFragmentResource(fragment), "pipelineFragment")
+}
+
+// Destroy deletes all versions of a fragment that no job or other fragment uses.
+// Example:
+// "DELETE <application>/pipeline/fragments/:Name"
+func (pfc *PipelineFragmentsController) Destroy(c *gin.Context) {
+	ctx := c.Request.Context()
+	name := c.Param("Name")
+
+	orm := pfc.App.PipelineORM()
+	fragment, err := orm.FindFragment(ctx, name, 0)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline fragment not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jobsUsingFragment, err := pfc.App.JobORM().FindJobIDsWithFragment(ctx, name)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("error searching for associated v2 jobs: %+v", err))
+		return
+	}
+	if len(jobsUsingFragment) > 0 {
+		jsonAPIError(c, http.StatusConflict, fmt.Errorf("can't remove the pipeline fragment because jobs %v are associated with it", jobsUsingFragment))
+		return
+	}
+	fragmentsUsingFragment, err := orm.FindFragmentsReferencing(ctx, name)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("error searching for associated pipeline fragments: %+v", err))
+		return
+	}
+	if len(fragmentsUsingFragment) > 0 {
+		jsonAPIError(c, http.StatusConflict, fmt.Errorf("can't remove the pipeline fragment because fragments %v include it", fragmentsUsingFragment))
+		return
+	}
+	if err = orm.DeleteFragment(ctx, name); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("failed to delete pipeline fragment: %+v", err))
+		return
+	}
+
+	pfc.App.GetAuditLogger().Audit(audit.PipelineFragmentDeleted, map[string]interface{}{"name": name})
+
+	jsonAPIResponse(c, presenters.NewPipelineFragmentResource(fragment), "pipelineFragment")
+}
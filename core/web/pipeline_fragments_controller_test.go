@@ -0,0 +1,111 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestPipelineFragmentsController(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	create := func(name, source string) *http.Response {
+		body, err := json.Marshal(web.CreatePipelineFragmentRequest{Name: name, Source: source})
+		require.NoError(t, err)
+		resp, cleanup := client.Post("/v2/pipeline/fragments", bytes.NewBuffer(body))
+		t.Cleanup(cleanup)
+		return resp
+	}
+
+	resp := create("price", `v [type=memo value="$(params.price)"];`)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var fragment presenters.PipelineFragmentResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &fragment))
+	assert.Equal(t, "price", fragment.Name)
+	assert.Equal(t, int32(1), fragment.Version)
+
+	resp = create("price", `v [type=memo value="$(params.price)" index=0];`)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &fragment))
+	assert.Equal(t, int32(2), fragment.Version)
+
+	t.Run("rejects invalid fragments", func(t *testing.T) {
+		resp := create("loop", `v [type=subpipeline name=loop];`)
+		cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+
+		resp = create("bad name", `v [type=memo value=1];`)
+		cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+
+		resp = create("outputs", `a [type=memo value=1]; b [type=memo value=2];`)
+		cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+	})
+
+	t.Run("shows the latest or a given version", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/pipeline/fragments/price")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &fragment))
+		assert.Equal(t, int32(2), fragment.Version)
+
+		resp, cleanup = client.Get("/v2/pipeline/fragments/price?version=1")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &fragment))
+		assert.Equal(t, int32(1), fragment.Version)
+		assert.Equal(t, `v [type=memo value="$(params.price)"];`, fragment.Source)
+
+		resp, cleanup = client.Get("/v2/pipeline/fragments/nosuchfragment")
+		t.Cleanup(cleanup)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("lists latest versions", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/pipeline/fragments")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+		var fragments []presenters.PipelineFragmentResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &fragments))
+		require.Len(t, fragments, 1)
+		assert.Equal(t, int32(2), fragments[0].Version)
+	})
+
+	t.Run("refuses to delete fragments included by other fragments", func(t *testing.T) {
+		resp := create("feed", `p [type=subpipeline name=price params=<{"price": 1}>];`)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		resp, cleanup := client.Delete("/v2/pipeline/fragments/price")
+		t.Cleanup(cleanup)
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+		resp, cleanup = client.Delete("/v2/pipeline/fragments/feed")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+	})
+
+	t.Run("deletes all versions", func(t *testing.T) {
+		resp, cleanup := client.Delete("/v2/pipeline/fragments/price")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		resp, cleanup = client.Get("/v2/pipeline/fragments/price?version=1")
+		t.Cleanup(cleanup)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		resp, cleanup = client.Delete("/v2/pipeline/fragments/price")
+		t.Cleanup(cleanup)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 )
 
 // BridgeResource represents a Bridge JSONAPI resource.
@@ -14,10 +15,18 @@ type BridgeResource struct {
 	URL           string `json:"url"`
 	Confirmations uint32 `json:"confirmations"`
 	// The IncomingToken is only provided when creating a Bridge
-	IncomingToken          string       `json:"incomingToken,omitempty"`
-	OutgoingToken          string       `json:"outgoingToken"`
-	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
-	CreatedAt              time.Time    `json:"createdAt"`
+	IncomingToken             string          `json:"incomingToken,omitempty"`
+	OutgoingToken             string          `json:"outgoingToken"`
+	MinimumContractPayment    *assets.Link    `json:"minimumContractPayment"`
+	CacheTTL                  models.Interval `json:"cacheTTL"`
+	CacheStaleWhileRevalidate models.Interval `json:"cacheStaleWhileRevalidate"`
+	CacheStaleIfError         models.Interval `json:"cacheStaleIfError"`
+	// Secrets of the auth mode are never provided
+	AuthMode        bridges.AuthMode `json:"authMode"`
+	VerifyResponses bool             `json:"verifyResponses"`
+	CreatedAt       time.Time        `json:"createdAt"`
+	// The Circuit is only provided when showing or listing Bridges
+	Circuit *BridgeCircuitResource `json:"circuit,omitempty"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -29,12 +38,42 @@ func (r BridgeResource) GetName() string {
 func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
 	return &BridgeResource{
 		// Uses the name as the id...Should change this to the id
-		JAID:                   NewJAID(b.Name.String()),
-		Name:                   b.Name.String(),
-		URL:                    b.URL.String(),
-		Confirmations:          b.Confirmations,
-		OutgoingToken:          b.OutgoingToken,
-		MinimumContractPayment: b.MinimumContractPayment,
-		CreatedAt:              b.CreatedAt,
+		JAID:                      NewJAID(b.Name.String()),
+		Name:                      b.Name.String(),
+		URL:                       b.URL.String(),
+		Confirmations:             b.Confirmations,
+		OutgoingToken:             b.OutgoingToken,
+		MinimumContractPayment:    b.MinimumContractPayment,
+		CacheTTL:                  b.CacheTTL,
+		CacheStaleWhileRevalidate: b.CacheStaleWhileRevalidate,
+		CacheStaleIfError:         b.CacheStaleIfError,
+		AuthMode:                  b.AuthMode.OrDefault(),
+		VerifyResponses:           b.VerifyResponses,
+		CreatedAt:                 b.CreatedAt,
 	}
 }
+
+// BridgeCircuitResource represents the circuit breaker of a Bridge.
+type BridgeCircuitResource struct {
+	State               bridges.CircuitState `json:"state"`
+	ConsecutiveFailures int                  `json:"consecutiveFailures"`
+	OpenedAt            *time.Time           `json:"openedAt"`
+	RetryAt             *time.Time           `json:"retryAt"`
+	LastError           string               `json:"lastError"`
+}
+
+// NewBridgeCircuitResource constructs a new BridgeCircuitResource
+func NewBridgeCircuitResource(s bridges.CircuitStatus) *BridgeCircuitResource {
+	r := &BridgeCircuitResource{
+		State:               s.State,
+		ConsecutiveFailures: s.ConsecutiveFailures,
+		LastError:           s.LastError,
+	}
+	if !s.OpenedAt.IsZero() {
+		r.OpenedAt = &s.OpenedAt
+	}
+	if !s.RetryAt.IsZero() {
+		r.RetryAt = &s.RetryAt
+	}
+	return r
+}
@@ -27,6 +27,10 @@ func TestBridgeResource(t *testing.T) {
 		Confirmations:          1,
 		OutgoingToken:          "vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 		MinimumContractPayment: assets.NewLinkFromJuels(1),
+		CacheTTL:               models.Interval(30 * time.Second),
+		AuthMode:               bridges.AuthModeHMAC,
+		HMACSecret:             "0123456789abcdef0123456789abcdef",
+		VerifyResponses:        true,
 		CreatedAt:              timestamp,
 	}
 
@@ -46,6 +50,11 @@ func TestBridgeResource(t *testing.T) {
 			"confirmations":1,
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 			"minimumContractPayment":"1",
+			"cacheTTL":"30s",
+			"cacheStaleWhileRevalidate":"0s",
+			"cacheStaleIfError":"0s",
+			"authMode":"hmac",
+			"verifyResponses":true,
 			"createdAt":"2000-01-01T00:00:00Z"
 		}
 	}
@@ -71,10 +80,58 @@ func TestBridgeResource(t *testing.T) {
 			"incomingToken": "cd+OfGXy3UHEDAlD0y27F6/rJE14X1UI",
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 			"minimumContractPayment":"1",
+			"cacheTTL":"30s",
+			"cacheStaleWhileRevalidate":"0s",
+			"cacheStaleIfError":"0s",
+			"authMode":"hmac",
+			"verifyResponses":true,
 			"createdAt":"2000-01-01T00:00:00Z"
 		}
 	}
 }
+`
+
+	assert.JSONEq(t, expected, string(b))
+
+	// Test insertion of the Circuit
+	r.IncomingToken = ""
+	r.Circuit = NewBridgeCircuitResource(bridges.CircuitStatus{
+		State:               bridges.CircuitOpen,
+		ConsecutiveFailures: 5,
+		OpenedAt:            timestamp,
+		RetryAt:             timestamp.Add(bridges.CircuitOpenTimeout),
+		LastError:           "bridge down",
+	})
+	b, err = jsonapi.Marshal(r)
+	require.NoError(t, err)
+
+	expected = `
+{
+	"data": {
+		"type":"bridges",
+		"id":"test",
+		"attributes":{
+			"name":"test",
+			"url":"https://bridge.example.com/api",
+			"confirmations":1,
+			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
+			"minimumContractPayment":"1",
+			"cacheTTL":"30s",
+			"cacheStaleWhileRevalidate":"0s",
+			"cacheStaleIfError":"0s",
+			"authMode":"hmac",
+			"verifyResponses":true,
+			"createdAt":"2000-01-01T00:00:00Z",
+			"circuit":{
+				"state":"open",
+				"consecutiveFailures":5,
+				"openedAt":"2000-01-01T00:00:00Z",
+				"retryAt":"2000-01-01T00:00:10Z",
+				"lastError":"bridge down"
+			}
+		}
+	}
+}
 `
 
 	assert.JSONEq(t, expected, string(b))
@@ -1,6 +1,12 @@
 package presenters
 
-import "github.com/smartcontractkit/chainlink-common/pkg/types"
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+)
 
 // EVMChainResource is an EVM chain JSONAPI resource.
 type EVMChainResource struct {
@@ -24,6 +30,15 @@ func NewEVMChainResource(chain types.ChainStatus) EVMChainResource {
 // EVMNodeResource is an EVM node JSONAPI resource.
 type EVMNodeResource struct {
 	NodeResource
+	Score *EVMNodeScore `json:"score,omitempty"`
+}
+
+// EVMNodeScore is the rolling latency and error rate of the calls made to an EVM node.
+type EVMNodeScore struct {
+	Latency   string  `json:"latency"`
+	ErrorRate float64 `json:"errorRate"`
+	Samples   uint64  `json:"samples"`
+	Score     float64 `json:"score"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -33,7 +48,7 @@ func (r EVMNodeResource) GetName() string {
 
 // NewEVMNodeResource returns a new EVMNodeResource for node.
 func NewEVMNodeResource(node types.NodeStatus) EVMNodeResource {
-	return EVMNodeResource{NodeResource{
+	return EVMNodeResource{NodeResource: NodeResource{
 		JAID:    NewPrefixedJAID(node.Name, node.ChainID),
 		ChainID: node.ChainID,
 		Name:    node.Name,
@@ -41,3 +56,13 @@ func NewEVMNodeResource(node types.NodeStatus) EVMNodeResource {
 		Config:  node.Config,
 	}}
 }
+
+// NewEVMNodeScore returns a new EVMNodeScore for score.
+func NewEVMNodeScore(score commonclient.NodeScore) *EVMNodeScore {
+	return &EVMNodeScore{
+		Latency:   score.Latency.Round(time.Microsecond).String(),
+		ErrorRate: score.ErrorRate,
+		Samples:   score.Samples,
+		Score:     score.Score(),
+	}
+}
@@ -218,11 +218,12 @@ func NewPipelineSpec(spec *pipeline.Spec) PipelineSpec {
 
 // KeeperSpec defines the spec details of a Keeper Job
 type KeeperSpec struct {
-	ContractAddress types.EIP55Address `json:"contractAddress"`
-	FromAddress     types.EIP55Address `json:"fromAddress"`
-	CreatedAt       time.Time          `json:"createdAt"`
-	UpdatedAt       time.Time          `json:"updatedAt"`
-	EVMChainID      *big.Big           `json:"evmChainID"`
+	ContractAddress types.EIP55Address   `json:"contractAddress"`
+	FromAddress     types.EIP55Address   `json:"fromAddress"`
+	FromAddresses   []types.EIP55Address `json:"fromAddresses"`
+	CreatedAt       time.Time            `json:"createdAt"`
+	UpdatedAt       time.Time            `json:"updatedAt"`
+	EVMChainID      *big.Big             `json:"evmChainID"`
 }
 
 // NewKeeperSpec generates a new KeeperSpec from a job.KeeperSpec
@@ -230,6 +231,7 @@ func NewKeeperSpec(spec *job.KeeperSpec) *KeeperSpec {
 	return &KeeperSpec{
 		ContractAddress: spec.ContractAddress,
 		FromAddress:     spec.FromAddress,
+		FromAddresses:   spec.FromAddresses,
 		CreatedAt:       spec.CreatedAt,
 		UpdatedAt:       spec.UpdatedAt,
 		EVMChainID:      spec.EVMChainID,
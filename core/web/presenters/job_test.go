@@ -344,6 +344,7 @@ func TestJob(t *testing.T) {
 						"keeperSpec": {
 							"contractAddress": "%s",
 							"fromAddress": "%s",
+							"fromAddresses": null,
 							"createdAt":"2000-01-01T00:00:00Z",
 							"updatedAt":"2000-01-01T00:00:00Z",
 							"evmChainID": "42"
@@ -1118,6 +1119,7 @@ func TestJob(t *testing.T) {
 						"keeperSpec": {
 							"contractAddress": "%s",
 							"fromAddress": "%s",
+							"fromAddresses": null,
 							"createdAt":"2000-01-01T00:00:00Z",
 							"updatedAt":"2000-01-01T00:00:00Z",
 							"evmChainID": "42"
@@ -0,0 +1,46 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+// LogPollerBackfillResource is the progress of a LogPoller backfill JSONAPI resource.
+type LogPollerBackfillResource struct {
+	JAID
+	EVMChainID  big.Big    `json:"evmChainID"`
+	FilterName  string     `json:"filterName"`
+	FromBlock   int64      `json:"fromBlock"`
+	ToBlock     int64      `json:"toBlock"`
+	NextBlock   int64      `json:"nextBlock"`
+	LastError   string     `json:"lastError"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+	CompletedAt *time.Time `json:"completedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r LogPollerBackfillResource) GetName() string {
+	return "log_poller_backfills"
+}
+
+// NewLogPollerBackfillResource returns a new LogPollerBackfillResource for backfill.
+func NewLogPollerBackfillResource(backfill logpoller.Backfill) LogPollerBackfillResource {
+	r := LogPollerBackfillResource{
+		JAID:        NewJAID(backfill.FilterName),
+		FilterName:  backfill.FilterName,
+		FromBlock:   backfill.FromBlock,
+		ToBlock:     backfill.ToBlock,
+		NextBlock:   backfill.NextBlock,
+		LastError:   backfill.LastError,
+		CreatedAt:   backfill.CreatedAt,
+		UpdatedAt:   backfill.UpdatedAt,
+		CompletedAt: backfill.CompletedAt,
+	}
+	if backfill.EvmChainId != nil {
+		r.EVMChainID = *backfill.EvmChainId
+	}
+	return r
+}
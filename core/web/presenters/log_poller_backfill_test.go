@@ -0,0 +1,67 @@
+package presenters
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+func TestLogPollerBackfillResource(t *testing.T) {
+	var (
+		chainID   = big.NewI(4)
+		createdAt = time.Now()
+		updatedAt = time.Now().Add(time.Second)
+	)
+	backfill := logpoller.Backfill{
+		EvmChainId: chainID,
+		FilterName: "filter",
+		FromBlock:  10,
+		ToBlock:    100,
+		NextBlock:  50,
+		LastError:  "boom",
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+	}
+
+	r := NewLogPollerBackfillResource(backfill)
+	assert.Equal(t, "filter", r.ID)
+	assert.Equal(t, *chainID, r.EVMChainID)
+	assert.Equal(t, int64(50), r.NextBlock)
+	assert.Nil(t, r.CompletedAt)
+
+	b, err := jsonapi.Marshal(r)
+	require.NoError(t, err)
+
+	createdAtMarshalled, err := createdAt.MarshalText()
+	require.NoError(t, err)
+	updatedAtMarshalled, err := updatedAt.MarshalText()
+	require.NoError(t, err)
+
+	expected := fmt.Sprintf(`
+	{
+	   "data":{
+		  "type":"log_poller_backfills",
+		  "id":"filter",
+		  "attributes":{
+			 "evmChainID":"%s",
+			 "filterName":"filter",
+			 "fromBlock":10,
+			 "toBlock":100,
+			 "nextBlock":50,
+			 "lastError":"boom",
+			 "createdAt":"%s",
+			 "updatedAt":"%s",
+			 "completedAt":null
+		  }
+	   }
+	}
+	`, chainID.String(), string(createdAtMarshalled), string(updatedAtMarshalled))
+	assert.JSONEq(t, expected, string(b))
+}
@@ -3,12 +3,15 @@ package presenters
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 )
 
 func TestNodeResource(t *testing.T) {
@@ -90,3 +93,25 @@ func TestNodeResource(t *testing.T) {
 		assert.JSONEq(t, expected, string(b))
 	}
 }
+
+func TestEVMNodeResource_Score(t *testing.T) {
+	r := NewEVMNodeResource(types.NodeStatus{ChainID: "1", Name: "node", Config: "cfg", State: "Alive"})
+	r.Score = NewEVMNodeScore(commonclient.NodeScore{Latency: 100*time.Millisecond + 7, ErrorRate: 0.5, Samples: 10})
+
+	b, err := jsonapi.Marshal(r)
+	require.NoError(t, err)
+	assert.JSONEq(t, `
+	{
+	  "data":{
+		  "type":"evm_node",
+		  "id":"1/node",
+		  "attributes":{
+			 "chainID":"1",
+			 "name":"node",
+			 "config":"cfg",
+			 "state":"Alive",
+			 "score":{"latency":"100ms","errorRate":0.5,"samples":10,"score":0.200000014}
+		  }
+	  }
+	}`, string(b))
+}
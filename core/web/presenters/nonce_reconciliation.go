@@ -0,0 +1,46 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+// NonceReconciliationResource is an action of the nonce reconciler of an EVM chain JSONAPI resource.
+type NonceReconciliationResource struct {
+	JAID
+	EVMChainID   big.Big        `json:"evmChainID"`
+	Address      common.Address `json:"address"`
+	Action       string         `json:"action"`
+	Nonce        string         `json:"nonce"`
+	LocalNonce   string         `json:"localNonce"`
+	MinedNonce   string         `json:"minedNonce"`
+	PendingNonce string         `json:"pendingNonce"`
+	TxHash       *common.Hash   `json:"txHash"`
+	CreatedAt    time.Time      `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r NonceReconciliationResource) GetName() string {
+	return "evm_nonce_reconciliations"
+}
+
+// NewNonceReconciliationResource returns a new NonceReconciliationResource for r.
+func NewNonceReconciliationResource(r txmgr.NonceReconciliation) NonceReconciliationResource {
+	return NonceReconciliationResource{
+		JAID:         NewJAIDInt64(r.ID),
+		EVMChainID:   r.EVMChainID,
+		Address:      r.Address,
+		Action:       string(r.Action),
+		Nonce:        strconv.FormatInt(int64(r.Nonce), 10),
+		LocalNonce:   strconv.FormatInt(int64(r.LocalNonce), 10),
+		MinedNonce:   strconv.FormatInt(int64(r.MinedNonce), 10),
+		PendingNonce: strconv.FormatInt(int64(r.PendingNonce), 10),
+		TxHash:       r.TxHash,
+		CreatedAt:    r.CreatedAt,
+	}
+}
@@ -0,0 +1,65 @@
+package presenters
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+func TestNonceReconciliationResource(t *testing.T) {
+	var (
+		chainID   = big.NewI(4)
+		address   = common.HexToAddress("0x0000000000000000000000000000000000000001")
+		txHash    = common.HexToHash("0x02")
+		createdAt = time.Now()
+	)
+	r := NewNonceReconciliationResource(txmgr.NonceReconciliation{
+		ID:           7,
+		EVMChainID:   *chainID,
+		Address:      address,
+		Action:       txmgr.NonceReconciliationFillGap,
+		Nonce:        9,
+		LocalNonce:   11,
+		MinedNonce:   8,
+		PendingNonce: 9,
+		TxHash:       &txHash,
+		CreatedAt:    createdAt,
+	})
+	assert.Equal(t, "7", r.ID)
+	assert.Equal(t, "fill_gap", r.Action)
+
+	b, err := jsonapi.Marshal(r)
+	require.NoError(t, err)
+
+	createdAtMarshalled, err := createdAt.MarshalText()
+	require.NoError(t, err)
+
+	expected := fmt.Sprintf(`
+	{
+	   "data":{
+		  "type":"evm_nonce_reconciliations",
+		  "id":"7",
+		  "attributes":{
+			 "evmChainID":"4",
+			 "address":"%s",
+			 "action":"fill_gap",
+			 "nonce":"9",
+			 "localNonce":"11",
+			 "minedNonce":"8",
+			 "pendingNonce":"9",
+			 "txHash":"%s",
+			 "createdAt":"%s"
+		  }
+	   }
+	}
+	`, address.Hex(), txHash.Hex(), string(createdAtMarshalled))
+	assert.JSONEq(t, expected, string(b))
+}
@@ -0,0 +1,42 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// PipelineFragmentResource represents a pipeline Fragment JSONAPI resource.
+type PipelineFragmentResource struct {
+	JAID
+	Name      string    `json:"name"`
+	Version   int32     `json:"version"`
+	Source    string    `json:"source"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineFragmentResource) GetName() string {
+	return "pipelineFragments"
+}
+
+// NewPipelineFragmentResource constructs a new PipelineFragmentResource
+func NewPipelineFragmentResource(f pipeline.Fragment) *PipelineFragmentResource {
+	return &PipelineFragmentResource{
+		// Uses the name as the id, as it is how fragments are referenced
+		JAID:      NewJAID(f.Name),
+		Name:      f.Name,
+		Version:   f.Version,
+		Source:    f.Source,
+		CreatedAt: f.CreatedAt,
+	}
+}
+
+// NewPipelineFragmentResources constructs a slice of PipelineFragmentResources
+func NewPipelineFragmentResources(fragments []pipeline.Fragment) []PipelineFragmentResource {
+	rs := []PipelineFragmentResource{}
+	for _, f := range fragments {
+		rs = append(rs, *NewPipelineFragmentResource(f))
+	}
+	return rs
+}
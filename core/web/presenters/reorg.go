@@ -0,0 +1,42 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+// ReorgResource is a reorg of the canonical chain of an EVM chain JSONAPI resource.
+type ReorgResource struct {
+	JAID
+	EVMChainID  big.Big     `json:"evmChainID"`
+	Depth       int64       `json:"depth"`
+	OldHeadHash common.Hash `json:"oldHeadHash"`
+	NewHeadHash common.Hash `json:"newHeadHash"`
+	FromBlock   string      `json:"fromBlock"`
+	ToBlock     string      `json:"toBlock"`
+	DetectedAt  time.Time   `json:"detectedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ReorgResource) GetName() string {
+	return "evm_reorgs"
+}
+
+// NewReorgResource returns a new ReorgResource for r.
+func NewReorgResource(r headtracker.Reorg) ReorgResource {
+	return ReorgResource{
+		JAID:        NewJAIDInt64(r.ID),
+		EVMChainID:  r.EVMChainID,
+		Depth:       r.Depth,
+		OldHeadHash: r.OldHeadHash,
+		NewHeadHash: r.NewHeadHash,
+		FromBlock:   strconv.FormatInt(r.FromBlock, 10),
+		ToBlock:     strconv.FormatInt(r.ToBlock, 10),
+		DetectedAt:  r.DetectedAt,
+	}
+}
@@ -0,0 +1,60 @@
+package presenters
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+func TestReorgResource(t *testing.T) {
+	var (
+		chainID     = big.NewI(4)
+		oldHeadHash = common.HexToHash("0x01")
+		newHeadHash = common.HexToHash("0x02")
+		detectedAt  = time.Now()
+	)
+	r := NewReorgResource(headtracker.Reorg{
+		ID:          7,
+		EVMChainID:  *chainID,
+		Depth:       3,
+		OldHeadHash: oldHeadHash,
+		NewHeadHash: newHeadHash,
+		FromBlock:   10,
+		ToBlock:     12,
+		DetectedAt:  detectedAt,
+	})
+	assert.Equal(t, "7", r.ID)
+
+	b, err := jsonapi.Marshal(r)
+	require.NoError(t, err)
+
+	detectedAtMarshalled, err := detectedAt.MarshalText()
+	require.NoError(t, err)
+
+	expected := fmt.Sprintf(`
+	{
+	   "data":{
+		  "type":"evm_reorgs",
+		  "id":"7",
+		  "attributes":{
+			 "evmChainID":"4",
+			 "depth":3,
+			 "oldHeadHash":"%s",
+			 "newHeadHash":"%s",
+			 "fromBlock":"10",
+			 "toBlock":"12",
+			 "detectedAt":"%s"
+		  }
+	   }
+	}
+	`, oldHeadHash.Hex(), newHeadHash.Hex(), string(detectedAtMarshalled))
+	assert.JSONEq(t, expected, string(b))
+}
@@ -54,6 +54,33 @@ func (r *BridgeResolver) MinimumContractPayment() string {
 	return r.bridge.MinimumContractPayment.String()
 }
 
+// CacheTTL resolves how long the bridge's responses are served from the cache.
+func (r *BridgeResolver) CacheTTL() string {
+	return r.bridge.CacheTTL.Duration().String()
+}
+
+// CacheStaleWhileRevalidate resolves how long after the TTL the bridge's
+// responses are served while they are refreshed.
+func (r *BridgeResolver) CacheStaleWhileRevalidate() string {
+	return r.bridge.CacheStaleWhileRevalidate.Duration().String()
+}
+
+// CacheStaleIfError resolves how long the bridge's responses are served when
+// it fails.
+func (r *BridgeResolver) CacheStaleIfError() string {
+	return r.bridge.CacheStaleIfError.Duration().String()
+}
+
+// AuthMode resolves how the requests to the bridge are authenticated.
+func (r *BridgeResolver) AuthMode() string {
+	return string(r.bridge.AuthMode.OrDefault())
+}
+
+// VerifyResponses resolves whether the bridge's responses must be signed.
+func (r *BridgeResolver) VerifyResponses() bool {
+	return r.bridge.VerifyResponses
+}
+
 // CreatedAt resolves the bridge's created at field.
 func (r *BridgeResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.bridge.CreatedAt}
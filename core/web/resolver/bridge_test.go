@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
@@ -257,6 +258,8 @@ func Test_UpdateBridge(t *testing.T) {
 							confirmations
 							outgoingToken
 							minimumContractPayment
+							authMode
+							verifyResponses
 							createdAt
 						}
 					}
@@ -306,6 +309,7 @@ func Test_UpdateBridge(t *testing.T) {
 					URL:                    models.WebURL(*newBridgeURL),
 					Confirmations:          2,
 					MinimumContractPayment: assets.NewLinkFromJuels(2),
+					VerifyResponses:        ptr.To(false),
 				}
 
 				f.Mocks.bridgeORM.On("UpdateBridgeType", mock.Anything, mock.IsType(&bridges.BridgeType{}), btr).
@@ -333,6 +337,66 @@ func Test_UpdateBridge(t *testing.T) {
 						"confirmations": 2,
 						"outgoingToken": "outgoingToken",
 						"minimumContractPayment": "2",
+						"authMode": "token",
+						"verifyResponses": false,
+						"createdAt": "2021-01-01T00:00:00Z"
+					}
+				}
+			}`,
+		},
+		{
+			name:          "keeps the auth secrets",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				bridge := bridges.BridgeType{
+					Name:       name,
+					URL:        models.WebURL(*bridgeURL),
+					AuthMode:   bridges.AuthModeHMAC,
+					HMACSecret: "0123456789abcdef0123456789abcdef",
+					CreatedAt:  f.Timestamp(),
+				}
+
+				f.App.On("BridgeORM").Return(f.Mocks.bridgeORM)
+				f.Mocks.bridgeORM.On("FindBridge", mock.Anything, name).Return(bridge, nil)
+
+				btr := &bridges.BridgeTypeRequest{
+					Name:                   name,
+					URL:                    models.WebURL(*bridgeURL),
+					MinimumContractPayment: assets.NewLinkFromJuels(1),
+					AuthMode:               bridges.AuthModeHMAC,
+					HMACSecret:             "0123456789abcdef0123456789abcdef",
+					VerifyResponses:        ptr.To(true),
+				}
+
+				f.Mocks.bridgeORM.On("UpdateBridgeType", mock.Anything, mock.IsType(&bridges.BridgeType{}), btr).
+					Run(func(args mock.Arguments) {
+						arg := args.Get(1).(*bridges.BridgeType)
+						arg.VerifyResponses = true
+					}).
+					Return(nil)
+			},
+			query: mutation,
+			variables: map[string]interface{}{
+				"id": "bridge1",
+				"input": map[string]interface{}{
+					"name":                   "bridge1",
+					"url":                    "https://external.adapter",
+					"confirmations":          0,
+					"minimumContractPayment": "1",
+					"verifyResponses":        true,
+				},
+			},
+			result: `{
+				"updateBridge": {
+					"bridge": {
+						"id": "bridge1",
+						"name": "bridge1",
+						"url": "https://external.adapter",
+						"confirmations": 0,
+						"outgoingToken": "",
+						"minimumContractPayment": "0",
+						"authMode": "hmac",
+						"verifyResponses": true,
 						"createdAt": "2021-01-01T00:00:00Z"
 					}
 				}
@@ -5,12 +5,14 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/graph-gophers/graphql-go"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 )
 
@@ -85,6 +87,75 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest) error {
 		bt.MinimumContractPayment.Cmp(assets.NewLinkFromJuels(0)) < 0 {
 		return errors.New("MinimumContractPayment must be positive")
 	}
+	if bt.CacheTTL < 0 || bt.CacheStaleWhileRevalidate < 0 || bt.CacheStaleIfError < 0 {
+		return errors.New("cache durations must not be negative")
+	}
+	if bt.CacheStaleWhileRevalidate > 0 && bt.CacheTTL == 0 {
+		return errors.New("cacheStaleWhileRevalidate requires a cacheTTL")
+	}
+	if err := bt.ValidateAuth(); err != nil {
+		return err
+	}
 
 	return nil
 }
+
+// setBridgeCachePolicy sets the cache policy of btr from the optional
+// durations of a bridge input, keeping those of current that are unset.
+func setBridgeCachePolicy(btr *bridges.BridgeTypeRequest, ttl, staleWhileRevalidate, staleIfError *string, current bridges.BridgeType) (err error) {
+	if btr.CacheTTL, err = parseBridgeCacheInterval("cacheTTL", ttl, current.CacheTTL); err != nil {
+		return err
+	}
+	if btr.CacheStaleWhileRevalidate, err = parseBridgeCacheInterval("cacheStaleWhileRevalidate", staleWhileRevalidate, current.CacheStaleWhileRevalidate); err != nil {
+		return err
+	}
+	btr.CacheStaleIfError, err = parseBridgeCacheInterval("cacheStaleIfError", staleIfError, current.CacheStaleIfError)
+	return err
+}
+
+// bridgeAuthInput holds the optional auth fields of a bridge input.
+type bridgeAuthInput struct {
+	AuthMode        *string
+	HMACSecret      *string
+	TLSClientCert   *string
+	TLSClientKey    *string
+	TLSRootCA       *string
+	VerifyResponses *bool
+}
+
+// setBridgeAuth sets the auth settings of btr from a bridge input, keeping
+// those of current that are unset, see bridges.BridgeTypeRequest.KeepAuth.
+func setBridgeAuth(btr *bridges.BridgeTypeRequest, input bridgeAuthInput, current bridges.BridgeType) {
+	if input.AuthMode != nil {
+		btr.AuthMode = bridges.AuthMode(*input.AuthMode)
+	}
+	btr.VerifyResponses = input.VerifyResponses
+	for _, field := range []struct {
+		dst *string
+		src *string
+	}{
+		{&btr.HMACSecret, input.HMACSecret},
+		{&btr.TLSClientCert, input.TLSClientCert},
+		{&btr.TLSClientKey, input.TLSClientKey},
+		{&btr.TLSRootCA, input.TLSRootCA},
+	} {
+		if field.src != nil {
+			*field.dst = *field.src
+		}
+	}
+	btr.KeepAuth(current)
+}
+
+// parseBridgeCacheInterval parses an optional cache duration of a bridge
+// input, keeping current if it is unset.
+func parseBridgeCacheInterval(field string, value *string, current models.Interval) (models.Interval, error) {
+	if value == nil {
+		return current, nil
+	}
+	d, err := time.ParseDuration(*value)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid %s", field)
+	}
+
+	return models.Interval(d), nil
+}
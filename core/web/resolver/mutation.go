@@ -38,6 +38,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/standardcapabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
@@ -55,10 +56,30 @@ type Resolver struct {
 }
 
 type createBridgeInput struct {
-	Name                   string
-	URL                    string
-	Confirmations          int32
-	MinimumContractPayment string
+	Name                      string
+	URL                       string
+	Confirmations             int32
+	MinimumContractPayment    string
+	CacheTTL                  *string
+	CacheStaleWhileRevalidate *string
+	CacheStaleIfError         *string
+	AuthMode                  *string
+	HMACSecret                *string
+	TLSClientCert             *string
+	TLSClientKey              *string
+	TLSRootCA                 *string
+	VerifyResponses           *bool
+}
+
+func (i createBridgeInput) bridgeAuth() bridgeAuthInput {
+	return bridgeAuthInput{
+		AuthMode:        i.AuthMode,
+		HMACSecret:      i.HMACSecret,
+		TLSClientCert:   i.TLSClientCert,
+		TLSClientKey:    i.TLSClientKey,
+		TLSRootCA:       i.TLSRootCA,
+		VerifyResponses: i.VerifyResponses,
+	}
 }
 
 // CreateBridge creates a new bridge.
@@ -86,6 +107,10 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 		Confirmations:          uint32(args.Input.Confirmations),
 		MinimumContractPayment: minContractPayment,
 	}
+	if err := setBridgeCachePolicy(btr, args.Input.CacheTTL, args.Input.CacheStaleWhileRevalidate, args.Input.CacheStaleIfError, bridges.BridgeType{}); err != nil {
+		return nil, err
+	}
+	setBridgeAuth(btr, args.Input.bridgeAuth(), bridges.BridgeType{})
 
 	bta, bt, err := bridges.NewBridgeType(btr)
 	if err != nil {
@@ -448,10 +473,30 @@ func (r *Resolver) CreateFeedsManager(ctx context.Context, args struct {
 }
 
 type updateBridgeInput struct {
-	Name                   string
-	URL                    string
-	Confirmations          int32
-	MinimumContractPayment string
+	Name                      string
+	URL                       string
+	Confirmations             int32
+	MinimumContractPayment    string
+	CacheTTL                  *string
+	CacheStaleWhileRevalidate *string
+	CacheStaleIfError         *string
+	AuthMode                  *string
+	HMACSecret                *string
+	TLSClientCert             *string
+	TLSClientKey              *string
+	TLSRootCA                 *string
+	VerifyResponses           *bool
+}
+
+func (i updateBridgeInput) bridgeAuth() bridgeAuthInput {
+	return bridgeAuthInput{
+		AuthMode:        i.AuthMode,
+		HMACSecret:      i.HMACSecret,
+		TLSClientCert:   i.TLSClientCert,
+		TLSClientKey:    i.TLSClientKey,
+		TLSRootCA:       i.TLSRootCA,
+		VerifyResponses: i.VerifyResponses,
+	}
 }
 
 func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
@@ -497,7 +542,11 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	// Update the bridge
+	// Update the bridge, keeping the cache policy fields that are not set
+	if err := setBridgeCachePolicy(btr, args.Input.CacheTTL, args.Input.CacheStaleWhileRevalidate, args.Input.CacheStaleIfError, bridge); err != nil {
+		return nil, err
+	}
+	setBridgeAuth(btr, args.Input.bridgeAuth(), bridge)
 	if err := ValidateBridgeType(btr); err != nil {
 		return nil, err
 	}
@@ -689,6 +738,84 @@ func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 	return NewDeleteBridgePayload(&bt, nil), nil
 }
 
+type createPipelineFragmentInput struct {
+	Name   string
+	Source string
+}
+
+// CreatePipelineFragment stores a pipeline fragment. Storing a fragment under
+// an existing name creates a new version of it.
+func (r *Resolver) CreatePipelineFragment(ctx context.Context, args struct {
+	Input createPipelineFragmentInput
+}) (*CreatePipelineFragmentPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	orm := r.App.PipelineORM()
+	if err := pipeline.ValidateFragment(ctx, pipeline.Fragment{Name: args.Input.Name, Source: args.Input.Source}, orm); err != nil {
+		return NewCreatePipelineFragmentPayload(nil, map[string]string{
+			"source": err.Error(),
+		}), nil
+	}
+
+	fragment, err := orm.CreateFragment(ctx, args.Input.Name, args.Input.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.PipelineFragmentCreated, map[string]interface{}{
+		"name":    fragment.Name,
+		"version": fragment.Version,
+	})
+
+	return NewCreatePipelineFragmentPayload(&fragment, nil), nil
+}
+
+// DeletePipelineFragment deletes all versions of a pipeline fragment that no
+// job uses.
+func (r *Resolver) DeletePipelineFragment(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*DeletePipelineFragmentPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	name := string(args.ID)
+	orm := r.App.PipelineORM()
+	fragment, err := orm.FindFragment(ctx, name, 0)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewDeletePipelineFragmentPayload(nil, nil, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	jobsUsingFragment, err := r.App.JobORM().FindJobIDsWithFragment(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(jobsUsingFragment) > 0 {
+		return NewDeletePipelineFragmentPayload(&fragment, jobsUsingFragment, nil, nil), nil
+	}
+
+	fragmentsUsingFragment, err := orm.FindFragmentsReferencing(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(fragmentsUsingFragment) > 0 {
+		return NewDeletePipelineFragmentPayload(&fragment, nil, fragmentsUsingFragment, nil), nil
+	}
+
+	if err = orm.DeleteFragment(ctx, name); err != nil {
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.PipelineFragmentDeleted, map[string]interface{}{"name": name})
+	return NewDeletePipelineFragmentPayload(&fragment, nil, nil, nil), nil
+}
+
 func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolver, error) {
 	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
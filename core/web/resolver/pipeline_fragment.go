@@ -0,0 +1,197 @@
+package resolver
+
+import (
+	"fmt"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// PipelineFragmentResolver resolves the PipelineFragment type.
+type PipelineFragmentResolver struct {
+	fragment pipeline.Fragment
+}
+
+func NewPipelineFragment(fragment pipeline.Fragment) *PipelineFragmentResolver {
+	return &PipelineFragmentResolver{fragment: fragment}
+}
+
+func NewPipelineFragments(fragments []pipeline.Fragment) []*PipelineFragmentResolver {
+	var resolvers []*PipelineFragmentResolver
+	for _, f := range fragments {
+		resolvers = append(resolvers, NewPipelineFragment(f))
+	}
+
+	return resolvers
+}
+
+// ID resolves the fragment's name as the id, since all versions share it.
+func (r *PipelineFragmentResolver) ID() graphql.ID {
+	return graphql.ID(r.fragment.Name)
+}
+
+// Name resolves the fragment's name.
+func (r *PipelineFragmentResolver) Name() string {
+	return r.fragment.Name
+}
+
+// Version resolves the fragment's version.
+func (r *PipelineFragmentResolver) Version() int32 {
+	return r.fragment.Version
+}
+
+// Source resolves the fragment's DOT source.
+func (r *PipelineFragmentResolver) Source() string {
+	return r.fragment.Source
+}
+
+// CreatedAt resolves the fragment's created at field.
+func (r *PipelineFragmentResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.fragment.CreatedAt}
+}
+
+// PipelineFragmentPayloadResolver resolves a single pipeline fragment response
+type PipelineFragmentPayloadResolver struct {
+	fragment pipeline.Fragment
+	NotFoundErrorUnionType
+}
+
+func NewPipelineFragmentPayload(fragment pipeline.Fragment, err error) *PipelineFragmentPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "pipeline fragment not found"}
+
+	return &PipelineFragmentPayloadResolver{fragment: fragment, NotFoundErrorUnionType: e}
+}
+
+// ToPipelineFragment implements the PipelineFragment union type of the payload
+func (r *PipelineFragmentPayloadResolver) ToPipelineFragment() (*PipelineFragmentResolver, bool) {
+	if r.err == nil {
+		return NewPipelineFragment(r.fragment), true
+	}
+
+	return nil, false
+}
+
+// PipelineFragmentsPayloadResolver resolves the latest version of all pipeline fragments
+type PipelineFragmentsPayloadResolver struct {
+	fragments []pipeline.Fragment
+}
+
+func NewPipelineFragmentsPayload(fragments []pipeline.Fragment) *PipelineFragmentsPayloadResolver {
+	return &PipelineFragmentsPayloadResolver{fragments: fragments}
+}
+
+// Results returns the pipeline fragments.
+func (r *PipelineFragmentsPayloadResolver) Results() []*PipelineFragmentResolver {
+	return NewPipelineFragments(r.fragments)
+}
+
+// -- CreatePipelineFragment mutation --
+
+type CreatePipelineFragmentPayloadResolver struct {
+	fragment  *pipeline.Fragment
+	inputErrs map[string]string
+}
+
+func NewCreatePipelineFragmentPayload(fragment *pipeline.Fragment, inputErrs map[string]string) *CreatePipelineFragmentPayloadResolver {
+	return &CreatePipelineFragmentPayloadResolver{fragment: fragment, inputErrs: inputErrs}
+}
+
+func (r *CreatePipelineFragmentPayloadResolver) ToCreatePipelineFragmentSuccess() (*CreatePipelineFragmentSuccessResolver, bool) {
+	if r.inputErrs != nil {
+		return nil, false
+	}
+
+	return NewCreatePipelineFragmentSuccess(*r.fragment), true
+}
+
+func (r *CreatePipelineFragmentPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs == nil {
+		return nil, false
+	}
+
+	var errs []*InputErrorResolver
+
+	for path, message := range r.inputErrs {
+		errs = append(errs, NewInputError(path, message))
+	}
+
+	return NewInputErrors(errs), true
+}
+
+type CreatePipelineFragmentSuccessResolver struct {
+	fragment pipeline.Fragment
+}
+
+func NewCreatePipelineFragmentSuccess(fragment pipeline.Fragment) *CreatePipelineFragmentSuccessResolver {
+	return &CreatePipelineFragmentSuccessResolver{fragment: fragment}
+}
+
+// PipelineFragment resolves the created fragment.
+func (r *CreatePipelineFragmentSuccessResolver) PipelineFragment() *PipelineFragmentResolver {
+	return NewPipelineFragment(r.fragment)
+}
+
+// -- DeletePipelineFragment mutation --
+
+type DeletePipelineFragmentPayloadResolver struct {
+	fragment      *pipeline.Fragment
+	jobIDs        []int32
+	fragmentNames []string
+	NotFoundErrorUnionType
+}
+
+func NewDeletePipelineFragmentPayload(fragment *pipeline.Fragment, jobIDs []int32, fragmentNames []string, err error) *DeletePipelineFragmentPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "pipeline fragment not found"}
+
+	return &DeletePipelineFragmentPayloadResolver{fragment: fragment, jobIDs: jobIDs, fragmentNames: fragmentNames, NotFoundErrorUnionType: e}
+}
+
+func (r *DeletePipelineFragmentPayloadResolver) ToDeletePipelineFragmentSuccess() (*DeletePipelineFragmentSuccessResolver, bool) {
+	if r.fragment != nil && len(r.jobIDs) == 0 && len(r.fragmentNames) == 0 {
+		return NewDeletePipelineFragmentSuccess(*r.fragment), true
+	}
+
+	return nil, false
+}
+
+func (r *DeletePipelineFragmentPayloadResolver) ToDeletePipelineFragmentConflictError() (*DeletePipelineFragmentConflictErrorResolver, bool) {
+	if len(r.jobIDs) > 0 || len(r.fragmentNames) > 0 {
+		return NewDeletePipelineFragmentConflictError(r.jobIDs, r.fragmentNames), true
+	}
+
+	return nil, false
+}
+
+type DeletePipelineFragmentSuccessResolver struct {
+	fragment pipeline.Fragment
+}
+
+func NewDeletePipelineFragmentSuccess(fragment pipeline.Fragment) *DeletePipelineFragmentSuccessResolver {
+	return &DeletePipelineFragmentSuccessResolver{fragment: fragment}
+}
+
+// PipelineFragment resolves the deleted fragment.
+func (r *DeletePipelineFragmentSuccessResolver) PipelineFragment() *PipelineFragmentResolver {
+	return NewPipelineFragment(r.fragment)
+}
+
+type DeletePipelineFragmentConflictErrorResolver struct {
+	jobIDs        []int32
+	fragmentNames []string
+}
+
+func NewDeletePipelineFragmentConflictError(jobIDs []int32, fragmentNames []string) *DeletePipelineFragmentConflictErrorResolver {
+	return &DeletePipelineFragmentConflictErrorResolver{jobIDs: jobIDs, fragmentNames: fragmentNames}
+}
+
+func (r *DeletePipelineFragmentConflictErrorResolver) Message() string {
+	if len(r.jobIDs) == 0 {
+		return fmt.Sprintf("pipeline fragment is included by fragments %v", r.fragmentNames)
+	}
+	return fmt.Sprintf("pipeline fragment is used by jobs %v", r.jobIDs)
+}
+
+func (r *DeletePipelineFragmentConflictErrorResolver) Code() ErrorCode {
+	return ErrorCodeStatusConflict
+}
@@ -0,0 +1,298 @@
+package resolver
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func Test_PipelineFragments(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetPipelineFragments {
+			pipelineFragments {
+				results {
+					id
+					name
+					version
+					source
+					createdAt
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "pipelineFragments"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("PipelineORM").Return(f.Mocks.pipelineORM)
+				f.Mocks.pipelineORM.On("FindFragments", mock.Anything).Return([]pipeline.Fragment{
+					{ID: 2, Name: "price", Version: 2, Source: `v [type=memo value=1];`, CreatedAt: f.Timestamp()},
+				}, nil)
+			},
+			query: query,
+			result: `
+			{
+				"pipelineFragments": {
+					"results": [{
+						"id": "price",
+						"name": "price",
+						"version": 2,
+						"source": "v [type=memo value=1];",
+						"createdAt": "2021-01-01T00:00:00Z"
+					}]
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func Test_PipelineFragment(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetPipelineFragment($version: Int) {
+			pipelineFragment(id: "price", version: $version) {
+				... on PipelineFragment {
+					name
+					version
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "pipelineFragment"),
+		{
+			name:          "latest version",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("PipelineORM").Return(f.Mocks.pipelineORM)
+				f.Mocks.pipelineORM.On("FindFragment", mock.Anything, "price", int32(0)).Return(pipeline.Fragment{Name: "price", Version: 2}, nil)
+			},
+			query:  query,
+			result: `{"pipelineFragment": {"name": "price", "version": 2}}`,
+		},
+		{
+			name:          "pinned version",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("PipelineORM").Return(f.Mocks.pipelineORM)
+				f.Mocks.pipelineORM.On("FindFragment", mock.Anything, "price", int32(1)).Return(pipeline.Fragment{Name: "price", Version: 1}, nil)
+			},
+			query:     query,
+			variables: map[string]interface{}{"version": 1},
+			result:    `{"pipelineFragment": {"name": "price", "version": 1}}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("PipelineORM").Return(f.Mocks.pipelineORM)
+				f.Mocks.pipelineORM.On("FindFragment", mock.Anything, "price", int32(0)).Return(pipeline.Fragment{}, sql.ErrNoRows)
+			},
+			query: query,
+			result: `
+			{
+				"pipelineFragment": {
+					"message": "pipeline fragment not found",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func Test_CreatePipelineFragmentMutation(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation CreatePipelineFragment($input: CreatePipelineFragmentInput!) {
+			createPipelineFragment(input: $input) {
+				... on CreatePipelineFragmentSuccess {
+					pipelineFragment {
+						name
+						version
+					}
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"name":   "price",
+			"source": `v [type=memo value="$(params.price)"];`,
+		},
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "createPipelineFragment"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("PipelineORM").Return(f.Mocks.pipelineORM)
+				f.Mocks.pipelineORM.On("CreateFragment", mock.Anything, "price", `v [type=memo value="$(params.price)"];`).Return(pipeline.Fragment{Name: "price", Version: 3}, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"createPipelineFragment": {
+					"pipelineFragment": {
+						"name": "price",
+						"version": 3
+					}
+				}
+			}`,
+		},
+		{
+			name:          "invalid fragment",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("PipelineORM").Return(f.Mocks.pipelineORM)
+			},
+			query: mutation,
+			variables: map[string]interface{}{
+				"input": map[string]interface{}{
+					"name":   "loop",
+					"source": `v [type=subpipeline name=loop];`,
+				},
+			},
+			result: `
+			{
+				"createPipelineFragment": {
+					"errors": [{
+						"path": "source",
+						"message": "subpipeline v: loop -> loop: subpipeline cycle detected",
+						"code": "INVALID_INPUT"
+					}]
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func Test_DeletePipelineFragmentMutation(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation DeletePipelineFragment($id: ID!) {
+			deletePipelineFragment(id: $id) {
+				... on DeletePipelineFragmentSuccess {
+					pipelineFragment {
+						name
+					}
+				}
+				... on DeletePipelineFragmentConflictError {
+					message
+					code
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+	variables := map[string]interface{}{"id": "price"}
+	fragment := pipeline.Fragment{Name: "price", Version: 1}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "deletePipelineFragment"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("PipelineORM").Return(f.Mocks.pipelineORM)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.pipelineORM.On("FindFragment", mock.Anything, "price", int32(0)).Return(fragment, nil)
+				f.Mocks.jobORM.On("FindJobIDsWithFragment", mock.Anything, "price").Return([]int32{}, nil)
+				f.Mocks.pipelineORM.On("FindFragmentsReferencing", mock.Anything, "price").Return(nil, nil)
+				f.Mocks.pipelineORM.On("DeleteFragment", mock.Anything, "price").Return(nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result:    `{"deletePipelineFragment": {"pipelineFragment": {"name": "price"}}}`,
+		},
+		{
+			name:          "conflict",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("PipelineORM").Return(f.Mocks.pipelineORM)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.pipelineORM.On("FindFragment", mock.Anything, "price", int32(0)).Return(fragment, nil)
+				f.Mocks.jobORM.On("FindJobIDsWithFragment", mock.Anything, "price").Return([]int32{1, 2}, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"deletePipelineFragment": {
+					"message": "pipeline fragment is used by jobs [1 2]",
+					"code": "STATUS_CONFLICT"
+				}
+			}`,
+		},
+		{
+			name:          "conflict with fragments",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("PipelineORM").Return(f.Mocks.pipelineORM)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.pipelineORM.On("FindFragment", mock.Anything, "price", int32(0)).Return(fragment, nil)
+				f.Mocks.jobORM.On("FindJobIDsWithFragment", mock.Anything, "price").Return([]int32{}, nil)
+				f.Mocks.pipelineORM.On("FindFragmentsReferencing", mock.Anything, "price").Return([]string{"feed"}, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"deletePipelineFragment": {
+					"message": "pipeline fragment is included by fragments [feed]",
+					"code": "STATUS_CONFLICT"
+				}
+			}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("PipelineORM").Return(f.Mocks.pipelineORM)
+				f.Mocks.pipelineORM.On("FindFragment", mock.Anything, "price", int32(0)).Return(pipeline.Fragment{}, sql.ErrNoRows)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"deletePipelineFragment": {
+					"message": "pipeline fragment not found",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
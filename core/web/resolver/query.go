@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"math/big"
 	"sort"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -318,6 +319,47 @@ func (r *Resolver) P2PKeys(ctx context.Context) (*P2PKeysPayloadResolver, error)
 	return NewP2PKeysPayload(p2pKeys), nil
 }
 
+// PipelineFragment retrieves a pipeline fragment by name, the latest version
+// unless a version is given.
+func (r *Resolver) PipelineFragment(ctx context.Context, args struct {
+	ID      graphql.ID
+	Version *int32
+}) (*PipelineFragmentPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	var version int32
+	if args.Version != nil {
+		version = *args.Version
+	}
+
+	fragment, err := r.App.PipelineORM().FindFragment(ctx, string(args.ID), version)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewPipelineFragmentPayload(fragment, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewPipelineFragmentPayload(fragment, nil), nil
+}
+
+// PipelineFragments retrieves the latest version of all pipeline fragments.
+func (r *Resolver) PipelineFragments(ctx context.Context) (*PipelineFragmentsPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	fragments, err := r.App.PipelineORM().FindFragments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPipelineFragmentsPayload(fragments), nil
+}
+
 // VRFKeys fetches all VRF keys.
 func (r *Resolver) VRFKeys(ctx context.Context) (*VRFKeysPayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
@@ -565,6 +607,34 @@ func (r *Resolver) EthTransactionsAttempts(ctx context.Context, args struct {
 	return NewEthTransactionsAttemptsPayload(attempts, int32(count)), nil
 }
 
+func (r *Resolver) Reorgs(ctx context.Context, args struct {
+	EvmChainID *graphql.ID
+	Offset     *int32
+	Limit      *int32
+}) (*ReorgsPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	var chainID *big.Int
+	if args.EvmChainID != nil {
+		var ok bool
+		chainID, ok = new(big.Int).SetString(string(*args.EvmChainID), 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid evmChainID: %s", *args.EvmChainID)
+		}
+	}
+	offset := pageOffset(args.Offset)
+	limit := pageLimit(args.Limit)
+
+	reorgs, count, err := r.App.ReorgORM().Reorgs(ctx, chainID, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewReorgsPayload(reorgs, int32(count)), nil
+}
+
 func (r *Resolver) GlobalLogLevel(ctx context.Context) (*GlobalLogLevelPayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
 		return nil, err
@@ -0,0 +1,77 @@
+package resolver
+
+import (
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
+)
+
+type ReorgResolver struct {
+	reorg headtracker.Reorg
+}
+
+func NewReorg(reorg headtracker.Reorg) *ReorgResolver {
+	return &ReorgResolver{reorg: reorg}
+}
+
+func NewReorgs(results []headtracker.Reorg) []*ReorgResolver {
+	var resolver []*ReorgResolver
+
+	for _, reorg := range results {
+		resolver = append(resolver, NewReorg(reorg))
+	}
+
+	return resolver
+}
+
+func (r *ReorgResolver) ID() graphql.ID {
+	return graphql.ID(stringutils.FromInt64(r.reorg.ID))
+}
+
+func (r *ReorgResolver) EvmChainID() graphql.ID {
+	return graphql.ID(r.reorg.EVMChainID.String())
+}
+
+func (r *ReorgResolver) Depth() int32 {
+	return int32(r.reorg.Depth)
+}
+
+func (r *ReorgResolver) OldHeadHash() string {
+	return r.reorg.OldHeadHash.String()
+}
+
+func (r *ReorgResolver) NewHeadHash() string {
+	return r.reorg.NewHeadHash.String()
+}
+
+func (r *ReorgResolver) FromBlock() string {
+	return stringutils.FromInt64(r.reorg.FromBlock)
+}
+
+func (r *ReorgResolver) ToBlock() string {
+	return stringutils.FromInt64(r.reorg.ToBlock)
+}
+
+func (r *ReorgResolver) DetectedAt() graphql.Time {
+	return graphql.Time{Time: r.reorg.DetectedAt}
+}
+
+// -- Reorgs Query --
+
+type ReorgsPayloadResolver struct {
+	results []headtracker.Reorg
+	total   int32
+}
+
+func NewReorgsPayload(results []headtracker.Reorg, total int32) *ReorgsPayloadResolver {
+	return &ReorgsPayloadResolver{results: results, total: total}
+}
+
+func (r *ReorgsPayloadResolver) Results() []*ReorgResolver {
+	return NewReorgs(r.results)
+}
+
+func (r *ReorgsPayloadResolver) Metadata() *PaginationMetadataResolver {
+	return NewPaginationMetadata(r.total)
+}
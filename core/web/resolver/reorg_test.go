@@ -0,0 +1,119 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+func TestResolver_Reorgs(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetReorgs($evmChainID: ID) {
+			reorgs(evmChainID: $evmChainID) {
+				results {
+					id
+					evmChainID
+					depth
+					oldHeadHash
+					newHeadHash
+					fromBlock
+					toBlock
+					detectedAt
+				}
+				metadata {
+					total
+				}
+			}
+		}`
+	oldHash := common.HexToHash("0x1")
+	newHash := common.HexToHash("0x2")
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "reorgs"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.reorgORM.On("Reorgs", mock.Anything, big.NewInt(42), PageDefaultOffset, PageDefaultLimit).Return([]headtracker.Reorg{
+					{
+						ID:          1,
+						EVMChainID:  *ubig.NewI(42),
+						Depth:       2,
+						OldHeadHash: oldHash,
+						NewHeadHash: newHash,
+						FromBlock:   9,
+						ToBlock:     11,
+						DetectedAt:  f.Timestamp(),
+					},
+				}, 1, nil)
+				f.App.On("ReorgORM").Return(f.Mocks.reorgORM)
+			},
+			query:     query,
+			variables: map[string]interface{}{"evmChainID": "42"},
+			result: `
+				{
+					"reorgs": {
+						"results": [{
+							"id": "1",
+							"evmChainID": "42",
+							"depth": 2,
+							"oldHeadHash": "0x0000000000000000000000000000000000000000000000000000000000000001",
+							"newHeadHash": "0x0000000000000000000000000000000000000000000000000000000000000002",
+							"fromBlock": "9",
+							"toBlock": "11",
+							"detectedAt": "2021-01-01T00:00:00Z"
+						}],
+						"metadata": {
+							"total": 1
+						}
+					}
+				}`,
+		},
+		{
+			name:          "invalid chain ID",
+			authenticated: true,
+			query:         query,
+			variables:     map[string]interface{}{"evmChainID": "foo"},
+			result:        `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: errors.New("invalid evmChainID: foo"),
+					Path:          []interface{}{"reorgs"},
+					Message:       "invalid evmChainID: foo",
+				},
+			},
+		},
+		{
+			name:          "generic error",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.reorgORM.On("Reorgs", mock.Anything, (*big.Int)(nil), PageDefaultOffset, PageDefaultLimit).Return(nil, 0, gError)
+				f.App.On("ReorgORM").Return(f.Mocks.reorgORM)
+			},
+			query:  query,
+			result: `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"reorgs"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
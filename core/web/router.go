@@ -285,6 +285,8 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 		txs := TransactionsController{app}
 		authv2.GET("/transactions/evm", paginatedRequest(txs.Index))
+		nrc := NonceReconciliationsController{app}
+		authv2.GET("/transactions/evm/reconciliations", paginatedRequest(nrc.Index))
 		authv2.GET("/transactions/evm/:TxHash", txs.Show)
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
@@ -293,6 +295,12 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/replay_from_block/:number", auth.RequiresRunRole(rc.ReplayFromBlock))
 		lcaC := LCAController{app}
 		authv2.GET("/find_lca", auth.RequiresRunRole(lcaC.FindLCA))
+		reorgsC := ReorgsController{app}
+		authv2.GET("/reorgs", paginatedRequest(reorgsC.Index))
+
+		lbc := LogPollerBackfillsController{app}
+		authv2.GET("/log_poller/backfills", lbc.Index)
+		authv2.POST("/log_poller/backfills", auth.RequiresRunRole(lbc.Create))
 
 		csakc := CSAKeysController{app}
 		authv2.GET("/keys/csa", csakc.Index)
@@ -370,6 +378,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
 		authv2.GET("/jobs/:ID", jc.Show)
 		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
+		authv2.POST("/jobs/simulate", auth.RequiresRunRole(jc.Simulate))
 		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
 		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
 
@@ -385,6 +394,13 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		// PipelineJobSpecErrorsController
 		authv2.DELETE("/pipeline/job_spec_errors/:ID", auth.RequiresEditRole(psec.Destroy))
 
+		// PipelineFragmentsController
+		pfc := PipelineFragmentsController{app}
+		authv2.GET("/pipeline/fragments", pfc.Index)
+		authv2.POST("/pipeline/fragments", auth.RequiresEditRole(pfc.Create))
+		authv2.GET("/pipeline/fragments/:Name", pfc.Show)
+		authv2.DELETE("/pipeline/fragments/:Name", auth.RequiresEditRole(pfc.Destroy))
+
 		lgc := LogController{app}
 		authv2.GET("/log", lgc.Get)
 		authv2.PATCH("/log", auth.RequiresAdminRole(lgc.Patch))